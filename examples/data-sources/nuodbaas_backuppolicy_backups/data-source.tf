@@ -0,0 +1,15 @@
+# Data source that returns the backups created by a backup policy, along with
+# the retention cycles that each backup is retained as
+data "nuodbaas_backuppolicy_backups" "policy_backups" {
+  organization = nuodbaas_backuppolicy.pol.organization
+  policy       = nuodbaas_backuppolicy.pol.name
+}
+
+# Data source that returns the backups created by a backup policy satisfying label requirements
+data "nuodbaas_backuppolicy_backups" "label_policy_backups" {
+  organization = nuodbaas_backuppolicy.pol.organization
+  policy       = nuodbaas_backuppolicy.pol.name
+  filter = {
+    labels = ["withkey", "key=expected", "key!=unexpected", "!withoutkey"]
+  }
+}
@@ -0,0 +1,25 @@
+# Data source that returns the databases selected by a backup policy
+data "nuodbaas_backuppolicy_databases" "policy_databases" {
+  organization = nuodbaas_backuppolicy.pol.organization
+  policy       = nuodbaas_backuppolicy.pol.name
+}
+
+# Data source that returns the databases selected by a backup policy satisfying label requirements
+data "nuodbaas_backuppolicy_databases" "label_policy_databases" {
+  organization = nuodbaas_backuppolicy.pol.organization
+  policy       = nuodbaas_backuppolicy.pol.name
+  filter = {
+    labels = ["withkey", "key=expected", "key!=unexpected", "!withoutkey"]
+  }
+}
+
+# Check that a database is backed up by the backup policy
+check "database_backed_up" {
+  assert {
+    condition = contains([
+      for db in data.nuodbaas_backuppolicy_databases.policy_databases.databases :
+      "${db.organization}/${db.project}/${db.name}"
+    ], "${nuodbaas_database.db.organization}/${nuodbaas_database.db.project}/${nuodbaas_database.db.name}")
+    error_message = "Database ${nuodbaas_database.db.name} is not backed up by backup policy ${nuodbaas_backuppolicy.pol.name}"
+  }
+}
@@ -0,0 +1,5 @@
+# Data source that returns the attributes of a specific user
+data "nuodbaas_user" "user_details" {
+  organization = nuodbaas_user.user.organization
+  name         = nuodbaas_user.user.name
+}
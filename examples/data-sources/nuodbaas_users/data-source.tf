@@ -0,0 +1,16 @@
+# Data source that returns the fully-qualified names of all users
+data "nuodbaas_users" "user_list" {}
+
+# Data source that returns the fully-qualified names of users within an organization
+data "nuodbaas_users" "org_user_list" {
+  filter = {
+    organization = "org"
+  }
+}
+
+# Data source that returns the fully-qualified names of users satisfying label requirements
+data "nuodbaas_users" "label_user_list" {
+  filter = {
+    labels = ["withkey", "key=expected", "key!=unexpected", "!withoutkey"]
+  }
+}
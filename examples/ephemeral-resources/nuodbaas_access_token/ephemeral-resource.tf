@@ -0,0 +1,13 @@
+# An access token that expires in 30 minutes, which is only allowed to read
+# and update databases in project org/proj
+ephemeral "nuodbaas_access_token" "deployer" {
+  limit_allow = ["read:org/proj", "update:org/proj"]
+  extra_deny  = ["delete:org/proj"]
+  expires_in  = "30m"
+}
+
+# A provider configuration that uses the access token
+provider "nuodbaas" {
+  alias = "deployer"
+  token = ephemeral.nuodbaas_access_token.deployer.token
+}
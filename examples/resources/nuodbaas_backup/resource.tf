@@ -13,3 +13,13 @@ resource "nuodbaas_backup" "backup" {
     backup_plugin = "embedded.cp.nuodb.com"
   }
 }
+
+# An on-demand backup of a database, with a name generated by the server
+resource "nuodbaas_backup" "on_demand" {
+  organization = nuodbaas_database.db.organization
+  project      = nuodbaas_database.db.project
+  database     = nuodbaas_database.db.name
+  labels = {
+    purpose = "pre-migration"
+  }
+}
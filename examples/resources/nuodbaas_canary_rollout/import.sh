@@ -0,0 +1,2 @@
+# An existing canary rollout can be imported by specifying its name
+terraform import nuodbaas_canary_rollout.upgrade upgrade
@@ -0,0 +1,20 @@
+# A canary rollout that gradually updates the NuoDB product version of the
+# databases matching the selector
+resource "nuodbaas_canary_rollout" "upgrade" {
+  name = "upgrade"
+  spec = {
+    rollout_template = {
+      name = nuodbaas_canary_rollout_template.gradual.name
+    }
+    selector = {
+      match_labels = {
+        canary = "true"
+      }
+    }
+    patch = jsonencode({
+      spec = {
+        productVersion = "6.0"
+      }
+    })
+  }
+}
@@ -0,0 +1,42 @@
+# A canary rollout template that promotes a change to a single target
+# resource, waits for it to become ready, and then promotes the change to
+# the remaining target resources
+resource "nuodbaas_canary_rollout_template" "gradual" {
+  name        = "gradual"
+  description = "Promote to one target before all others"
+  spec = {
+    analysis = [
+      {
+        name = "ready"
+        check_status_condition = {
+          type    = "Ready"
+          status  = "True"
+          timeout = "10m"
+        }
+      }
+    ]
+    steps = [
+      {
+        promote_to = {
+          limit_count = 1
+          rollback = {
+            strategy = "Step"
+          }
+        }
+      },
+      {
+        pause = {
+          duration = "5m"
+        }
+      },
+      {
+        promote_to = {
+          limit_percentage = 100
+          rollback = {
+            strategy = "Failed"
+          }
+        }
+      }
+    ]
+  }
+}
@@ -24,4 +24,9 @@ resource "nuodbaas_database" "db" {
       capacityType = "spot"
     }
   }
+  # Allow more time for this database to become ready than the timeouts
+  # configured in the provider
+  timeouts {
+    create = "20m"
+  }
 }
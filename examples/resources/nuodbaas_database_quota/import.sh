@@ -0,0 +1,2 @@
+# An existing database quota can be imported by specifying its name
+terraform import nuodbaas_database_quota.quota org-quota
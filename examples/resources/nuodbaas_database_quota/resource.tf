@@ -0,0 +1,19 @@
+# A database quota that limits the number of databases in each project
+# of an organization
+resource "nuodbaas_database_quota" "quota" {
+  name        = "org-quota"
+  description = "Limit databases in each project of org"
+  spec = {
+    hard = {
+      databases = "3"
+    }
+    scope = {
+      group_by_labels = ["cp.nuodb.com/project"]
+      field_selector = {
+        match_fields = {
+          "organization" = "org"
+        }
+      }
+    }
+  }
+}
@@ -0,0 +1,2 @@
+# An existing Helm feature can be imported by specifying its name
+terraform import nuodbaas_helm_feature.feature admin-replicas
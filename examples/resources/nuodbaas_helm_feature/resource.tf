@@ -0,0 +1,19 @@
+# A Helm feature that sets the number of admin replicas, which can be
+# referenced by service tiers
+resource "nuodbaas_helm_feature" "feature" {
+  name = "admin-replicas"
+  spec = {
+    parameters = {
+      replicas = {
+        default     = "1"
+        description = "The number of admin replicas"
+        json_schema = jsonencode({ type = "integer", minimum = 1 })
+      }
+    }
+    values = jsonencode({
+      admin = {
+        replicas = "<< .meta.params.replicas >>"
+      }
+    })
+  }
+}
@@ -0,0 +1,2 @@
+# An existing role template can be imported by specifying its name
+terraform import nuodbaas_role_template.developer developer
@@ -0,0 +1,34 @@
+# A role template that grants read access to all resources in an organization
+# and full access to a single project, which is specified by a parameter
+resource "nuodbaas_role_template" "developer" {
+  name        = "developer"
+  description = "Developer role"
+  spec = {
+    allow = [
+      {
+        verb     = "read"
+        resource = "{organization}"
+      },
+      {
+        verb     = "all"
+        resource = "{organization}/{project}"
+      }
+    ]
+  }
+}
+
+# A user that is assigned the role template
+resource "nuodbaas_user" "dev" {
+  organization = "org"
+  name         = "dev"
+  password     = "secret"
+  access_rule  = {}
+  roles = [
+    {
+      name = nuodbaas_role_template.developer.name
+      params = {
+        project = "proj"
+      }
+    }
+  ]
+}
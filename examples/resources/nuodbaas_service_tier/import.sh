@@ -0,0 +1,2 @@
+# An existing service tier can be imported by specifying its name
+terraform import nuodbaas_service_tier.tier n0.custom
@@ -0,0 +1,13 @@
+# A service tier that enables Helm features for the projects and
+# databases that use it
+resource "nuodbaas_service_tier" "tier" {
+  name        = "n0.custom"
+  description = "Custom service tier"
+  spec = {
+    features = [
+      {
+        name = "minimal-resources"
+      }
+    ]
+  }
+}
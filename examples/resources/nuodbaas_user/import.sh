@@ -0,0 +1,3 @@
+# An existing user can be imported by specifying the organization
+# and user name, separated by "/"
+terraform import nuodbaas_user.user org/user
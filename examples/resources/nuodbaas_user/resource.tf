@@ -0,0 +1,31 @@
+# A user with minimal configuration
+resource "nuodbaas_user" "basic" {
+  organization = "org"
+  name         = "basic"
+  password     = "secret"
+  access_rule = {
+    allow = ["all:org"]
+  }
+}
+
+# A user with explicit configuration for various attributes
+resource "nuodbaas_user" "user" {
+  organization = "org"
+  name         = "user"
+  password     = "secret"
+  labels = {
+    team = "dev"
+  }
+  access_rule = {
+    allow = ["read:org", "all:org/proj"]
+    deny  = ["delete:org/proj"]
+  }
+  roles = [
+    {
+      name = "project-admin"
+      params = {
+        project = "proj"
+      }
+    }
+  ]
+}
@@ -0,0 +1,349 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tmaxmax/go-sse"
+)
+
+const (
+	// EVENT_BUFFER_SIZE is the number of events that can be queued for a
+	// subscriber, beyond which the queued events are replaced by a RESYNC
+	// event, so that a slow subscriber does not block delivery of events to
+	// other subscribers.
+	EVENT_BUFFER_SIZE = 16
+
+	// EVENT_STREAM_RECONNECT_DELAY is the delay before reconnecting an
+	// event stream that was closed due to an error.
+	EVENT_STREAM_RECONNECT_DELAY = 1 * time.Second
+
+	// EVENT_STREAM_RECONNECT_MAX_INTERVAL is the maximum delay between
+	// attempts to re-establish an event stream connection that was lost.
+	EVENT_STREAM_RECONNECT_MAX_INTERVAL = 10 * time.Second
+
+	// DEFAULT_HEARTBEAT_TIMEOUT is the default maximum time to wait for an
+	// event or heartbeat on an event stream before closing it.
+	DEFAULT_HEARTBEAT_TIMEOUT = 1 * time.Minute
+)
+
+// EventHub multiplexes event streams on individual resources over a single
+// SSE connection per collection of resources, so that the number of
+// connections does not grow with the number of resources being awaited.
+// Connections are opened when the first subscriber for a collection arrives
+// and closed when the last one leaves.
+type EventHub struct {
+	providerConfig ProviderConfig
+	lock           sync.Mutex
+	streams        map[string]*collectionStream
+}
+
+func NewEventHub(providerConfig ProviderConfig) *EventHub {
+	return &EventHub{
+		providerConfig: providerConfig,
+		streams:        make(map[string]*collectionStream),
+	}
+}
+
+// collectionStream is an SSE connection that streams events on all
+// resources in a collection.
+type collectionStream struct {
+	cancel context.CancelFunc
+	// done is closed when the connection is closed, after which err
+	// contains the error that caused it to be closed, if any
+	done chan struct{}
+	err  error
+	// subscribers contains the subscriptions by resource name relative to
+	// the collection path
+	subscribers map[string]map[*subscription]struct{}
+	refs        int
+}
+
+type subscription struct {
+	lock sync.Mutex
+	// events contains the events queued for the subscriber
+	events []sse.Event
+	// resync is whether the subscriber should read the current state of the
+	// resource before handling the queued events, because events on it
+	// were discarded
+	resync bool
+	// ready is signalled when events are queued
+	ready chan struct{}
+}
+
+// queue queues an event for the subscriber without blocking. If the queue is
+// full, or the event is a RESYNC event without data, the queued events are
+// discarded in favor of a single RESYNC event.
+func (sub *subscription) queue(event sse.Event) {
+	sub.lock.Lock()
+	if len(sub.events) >= EVENT_BUFFER_SIZE || (event.Type == SSE_EVENT_RESYNC && event.Data == "") {
+		sub.events = nil
+		sub.resync = true
+	} else {
+		sub.events = append(sub.events, event)
+	}
+	sub.lock.Unlock()
+	select {
+	case sub.ready <- struct{}{}:
+	default:
+	}
+}
+
+// take removes and returns the queued events and whether a RESYNC event
+// should be delivered before them.
+func (sub *subscription) take() ([]sse.Event, bool) {
+	sub.lock.Lock()
+	defer sub.lock.Unlock()
+	events, resync := sub.events, sub.resync
+	sub.events = nil
+	sub.resync = false
+	return events, resync
+}
+
+// splitEventPath splits the path of the event stream for a resource into the
+// path of the event stream for the organization-scoped collection that it
+// belongs to and the name of the resource relative to it, which is used to
+// route events on the collection stream to subscribers. For example,
+// `events/databases/org/proj/db` is split into `events/databases/org` and
+// `proj/db`.
+func splitEventPath(path string) (string, string, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 4 {
+		return "", "", false
+	}
+	return strings.Join(segments[:3], "/"), strings.Join(segments[3:], "/"), true
+}
+
+// getResourceName returns the name of the resource in the supplied event
+// data relative to the organization-scoped collection that it belongs to, in
+// the same format as splitEventPath(). The ID of events is not used, since it
+// carries over from the previous event if an event has no ID.
+func getResourceName(data string) (string, bool) {
+	var resource struct {
+		Project  string `json:"project"`
+		Database string `json:"database"`
+		Name     string `json:"name"`
+	}
+	if err := json.Unmarshal([]byte(data), &resource); err != nil || resource.Name == "" {
+		return "", false
+	}
+	var segments []string
+	for _, segment := range []string{resource.Project, resource.Database, resource.Name} {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return strings.Join(segments, "/"), true
+}
+
+// ConsumeEvents consumes events on the resource with the supplied event path
+// using the supplied callback until the context is done or the connection is
+// closed, like ProviderConfig.ConsumeEvents(). Events are received from the
+// stream on the collection that the resource belongs to, which is shared
+// with all other subscribers for resources in the collection.
+//
+// When the subscriber joins, the callback is invoked with a RESYNC event
+// without data, since events on the resource may have been delivered before
+// it joined, indicating that the current state of the resource should be
+// read.
+func (hub *EventHub) ConsumeEvents(ctx context.Context, path string, callback func(sse.Event)) error {
+	collection, name, ok := splitEventPath(path)
+	if !ok {
+		return hub.consumeEvents(ctx, path, callback)
+	}
+	stream, sub := hub.subscribe(ctx, collection, name)
+	defer hub.unsubscribe(ctx, collection, name, stream, sub)
+	callback(sse.Event{Type: SSE_EVENT_RESYNC, LastEventID: name})
+	for {
+		select {
+		case <-sub.ready:
+			events, resync := sub.take()
+			if resync {
+				callback(sse.Event{Type: SSE_EVENT_RESYNC, LastEventID: name})
+			}
+			for _, event := range events {
+				callback(event)
+			}
+		case <-stream.done:
+			return stream.err
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (hub *EventHub) subscribe(ctx context.Context, collection, name string) (*collectionStream, *subscription) {
+	hub.lock.Lock()
+	defer hub.lock.Unlock()
+	stream, ok := hub.streams[collection]
+	if !ok {
+		stream = hub.connect(ctx, collection)
+		hub.streams[collection] = stream
+	}
+	sub := &subscription{
+		ready: make(chan struct{}, 1),
+	}
+	if stream.subscribers[name] == nil {
+		stream.subscribers[name] = make(map[*subscription]struct{})
+	}
+	stream.subscribers[name][sub] = struct{}{}
+	stream.refs++
+	return stream, sub
+}
+
+func (hub *EventHub) unsubscribe(ctx context.Context, collection, name string, stream *collectionStream, sub *subscription) {
+	hub.lock.Lock()
+	defer hub.lock.Unlock()
+	delete(stream.subscribers[name], sub)
+	if len(stream.subscribers[name]) == 0 {
+		delete(stream.subscribers, name)
+	}
+	stream.refs--
+	// Close connection if there are no more subscribers
+	if stream.refs == 0 {
+		tflog.Debug(ctx, "Closing idle event stream", map[string]any{"path": collection})
+		stream.cancel()
+		if hub.streams[collection] == stream {
+			delete(hub.streams, collection)
+		}
+	}
+}
+
+// connect opens a connection to stream events on all resources in a
+// collection, including resources that are created after it is opened. The
+// connection is not bound to the context of the subscriber that caused it
+// to be opened, and is closed when all subscribers have left.
+//
+// If the connection is closed due to an error after it was established, it
+// is reopened, and subscribers read the current state of their resources when
+// they receive the RESYNC event that is delivered once it is re-established.
+// Otherwise, or if the connection stalled, subscribers are notified of the
+// error so that they can fall back to polling.
+func (hub *EventHub) connect(ctx context.Context, collection string) *collectionStream {
+	streamCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	stream := &collectionStream{
+		cancel:      cancel,
+		done:        make(chan struct{}),
+		subscribers: make(map[string]map[*subscription]struct{}),
+	}
+	tflog.Debug(ctx, "Opening event stream", map[string]any{"path": collection})
+	go func() {
+		var err error
+		for {
+			// The callback is invoked synchronously by ConsumeEvents(), and
+			// at least once with a RESYNC event if the connection was
+			// established, so whether it was invoked does not need to be
+			// synchronized
+			connected := false
+			err = hub.consumeEvents(streamCtx, collection+"?watchAll=true", func(event sse.Event) {
+				connected = true
+				hub.dispatch(ctx, stream, event)
+			})
+			var stalledErr *stalledStreamError
+			if errors.As(err, &stalledErr) || streamCtx.Err() != nil || !connected {
+				break
+			}
+			tflog.Info(ctx, "Reconnecting event stream after error",
+				map[string]any{"path": collection, "error": err})
+			select {
+			case <-time.After(EVENT_STREAM_RECONNECT_DELAY):
+			case <-streamCtx.Done():
+			}
+		}
+		hub.lock.Lock()
+		stream.err = err
+		// Remove stream so that the next subscriber opens a new connection
+		if hub.streams[collection] == stream {
+			delete(hub.streams, collection)
+		}
+		hub.lock.Unlock()
+		close(stream.done)
+	}()
+	return stream
+}
+
+// stalledStreamError is returned when an event stream is closed because no
+// events or heartbeats were received on it within the heartbeat timeout.
+type stalledStreamError struct {
+	timeout time.Duration
+}
+
+func (e *stalledStreamError) Error() string {
+	return fmt.Sprintf("No events or heartbeats received on event stream within %s", e.timeout)
+}
+
+// consumeEvents consumes events like ProviderConfig.ConsumeEvents(), but
+// closes the connection if no events or heartbeats are received within the
+// heartbeat timeout. This detects connections that are held open without
+// delivering events, for example by a proxy that buffers responses, which
+// would otherwise never be closed. The watchdog is stopped while the callback
+// is invoked, so that only the time spent waiting for events on the
+// connection counts towards the timeout.
+func (hub *EventHub) consumeEvents(ctx context.Context, path string, callback func(sse.Event)) error {
+	timeout := hub.providerConfig.GetHeartbeatTimeout()
+	if timeout == 0 {
+		return hub.providerConfig.ConsumeEvents(ctx, path, callback)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var lock sync.Mutex
+	stalled := false
+	watchdog := time.AfterFunc(timeout, func() {
+		lock.Lock()
+		defer lock.Unlock()
+		stalled = true
+		cancel()
+	})
+	defer watchdog.Stop()
+	err := hub.providerConfig.ConsumeEvents(ctx, path, func(event sse.Event) {
+		watchdog.Stop()
+		callback(event)
+		watchdog.Reset(timeout)
+	})
+	lock.Lock()
+	defer lock.Unlock()
+	if stalled {
+		err = &stalledStreamError{timeout: timeout}
+		tflog.Warn(ctx, "Closing stalled event stream", map[string]any{"path": path, "error": err.Error()})
+	}
+	return err
+}
+
+// dispatch queues an event for the subscribers of the resource that it is
+// on, which is determined from the event data. Events that cannot be
+// attributed to a resource are dropped. Heartbeat events and RESYNC events
+// without data, which indicate that events may have been missed, are queued for
+// all subscribers. This never blocks, so that events are read from the
+// connection regardless of how fast subscribers handle them.
+func (hub *EventHub) dispatch(ctx context.Context, stream *collectionStream, event sse.Event) {
+	hub.lock.Lock()
+	defer hub.lock.Unlock()
+	if event.Type == SSE_EVENT_HEARTBEAT || (event.Type == SSE_EVENT_RESYNC && event.Data == "") {
+		for _, resourceSubs := range stream.subscribers {
+			for sub := range resourceSubs {
+				sub.queue(event)
+			}
+		}
+		return
+	}
+	name, ok := getResourceName(event.Data)
+	if !ok {
+		tflog.Debug(ctx, "Dropping event that cannot be attributed to a resource",
+			map[string]any{"event": event.Type, "data": event.Data})
+		return
+	}
+	for sub := range stream.subscribers[name] {
+		sub.queue(event)
+	}
+}
@@ -26,7 +26,7 @@ type GenericDataSource struct {
 	TypeName                string
 	Description             string
 	GetDataSourceAttributes func() (map[string]schema.Attribute, error)
-	SchemaOverride          *schema.Schema
+	GetSchemaOverride       func() (*schema.Schema, error)
 	Build                   func() DataSourceState
 }
 
@@ -38,14 +38,29 @@ type DataSourceState interface {
 	Read(ctx context.Context, client openapi.ClientInterface) error
 }
 
+// ListDataSourceState is implemented by data sources that list resources,
+// which are requested in pages.
+type ListDataSourceState interface {
+	DataSourceState
+
+	// SetPageSize sets the maximum number of items to request per page.
+	SetPageSize(pageSize int32)
+}
+
 func (d *GenericDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + d.TypeName
 }
 
 func (d *GenericDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	// If explicit schema is supplied, return that
-	if d.SchemaOverride != nil {
-		resp.Schema = *d.SchemaOverride
+	if d.GetSchemaOverride != nil {
+		schemaOverride, err := d.GetSchemaOverride()
+		if err != nil {
+			resp.Diagnostics.AddError("Schema Creation Error", err.Error())
+			return
+		}
+		resp.Schema = *schemaOverride
+		resp.Schema.Attributes = WithProviderDefaults(schemaOverride.Attributes)
 		return
 	}
 	// Otherwise, build schema from OpenAPI specification
@@ -57,7 +72,7 @@ func (d *GenericDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 	resp.Schema = schema.Schema{
 		Description:         d.Description,
 		MarkdownDescription: d.Description,
-		Attributes:          attributes,
+		Attributes:          WithProviderDefaults(attributes),
 	}
 }
 
@@ -66,12 +81,19 @@ func (d *GenericDataSource) Configure(ctx context.Context, req datasource.Config
 }
 
 func (d *GenericDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	// Read data source attributes from config
+	// Read data source attributes from config, supplying attributes that
+	// were not specified using the defaults in the provider configuration
+	resp.State.Raw = req.Config.Raw
+	d.client.applyProviderDefaults(ctx, &resp.Diagnostics, req.Config, resp.State.SetAttribute)
 	state := d.Build()
-	if !ReadResource(ctx, &resp.Diagnostics, req.Config.Get, state) {
+	if !ReadResource(ctx, &resp.Diagnostics, resp.State.Get, state) {
 		return
 	}
-	// Read actual data from provider
+	// Read actual data from provider, requesting list results in pages of
+	// the size in the provider configuration
+	if listState, ok := state.(ListDataSourceState); ok {
+		listState.SetPageSize(d.client.ProviderConfig.GetListPageSize())
+	}
 	err := state.Read(ctx, d.client.Client)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read "+d.TypeName, err.Error())
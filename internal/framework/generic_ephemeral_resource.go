@@ -0,0 +1,67 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package framework
+
+import (
+	"context"
+
+	"github.com/nuodb/terraform-provider-nuodbaas/openapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+)
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure = &GenericEphemeralResource{}
+)
+
+// GenericEphemeralResource is an EphemeralResource implementation that handles
+// all interactions with the Terraform API and delegates interaction with the
+// provider API to EphemeralResourceState. Ephemeral resources are never
+// persisted in the Terraform plan or state.
+type GenericEphemeralResource struct {
+	client          *ProviderClient
+	TypeName        string
+	EphemeralSchema *schema.Schema
+	Build           func() EphemeralResourceState
+}
+
+// EphemeralResourceState handles interactions with the provider API.
+type EphemeralResourceState interface {
+	State
+
+	// Open creates the ephemeral resource in the backend and populates the
+	// local state with the result.
+	Open(ctx context.Context, client openapi.ClientInterface) error
+}
+
+func (r *GenericEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.TypeName
+}
+
+func (r *GenericEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = *r.EphemeralSchema
+}
+
+func (r *GenericEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.client = getClient(&resp.Diagnostics, req.ProviderData)
+}
+
+func (r *GenericEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	// Read ephemeral resource attributes from config
+	state := r.Build()
+	if !ReadResource(ctx, &resp.Diagnostics, req.Config.Get, state) {
+		return
+	}
+	// Create ephemeral resource using provider
+	err := state.Open(ctx, r.client.Client)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to open "+r.TypeName, err.Error())
+		return
+	}
+	// Return result to Terraform, which does not persist it
+	resp.Diagnostics.Append(resp.Result.Set(ctx, state)...)
+}
@@ -33,6 +33,7 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &GenericResource{}
 	_ resource.ResourceWithImportState = &GenericResource{}
+	_ resource.ResourceWithModifyPlan  = &GenericResource{}
 )
 
 type ProviderConfig interface {
@@ -51,11 +52,43 @@ type ProviderConfig interface {
 	// GetSkipVerify returns whether certificate verification should be skipped.
 	GetSkipVerify() bool
 
+	// GetDefaultOrganization returns the organization to use for resources
+	// and data sources that do not specify one.
+	GetDefaultOrganization() string
+
+	// GetDefaultProject returns the project to use for resources and data
+	// sources that do not specify one.
+	GetDefaultProject() string
+
+	// GetDefaultLabels returns the labels to apply to all resources that
+	// have labels, in addition to the labels configured for each resource.
+	GetDefaultLabels() map[string]string
+
+	// GetListPageSize returns the maximum number of items to request per
+	// page when listing resources.
+	GetListPageSize() int32
+
+	// GetHeartbeatTimeout returns the maximum time to wait for an event or
+	// heartbeat on an event stream before closing it, or 0 if event streams
+	// should not be closed due to inactivity.
+	GetHeartbeatTimeout() time.Duration
+
+	// GetPollingBackoff returns the delay between polls of resources that
+	// are awaited without an event stream.
+	GetPollingBackoff() PollingBackoff
+
 	// CreateClient creates a REST API client.
 	CreateClient() (openapi.ClientInterface, error)
 
+	// Login obtains new credentials for clients created using the provider
+	// configuration, if the credentials are managed by the provider.
+	Login(ctx context.Context) error
+
 	// ConsumeEvents creates an SSE connection and consumes events using the
-	// supplied callback until the connection is closed.
+	// supplied callback until the connection is closed. Whenever the
+	// connection is established, including when it is re-established after
+	// being lost, the callback is invoked with a RESYNC event without data to
+	// indicate that events may have been missed.
 	ConsumeEvents(ctx context.Context, path string, callback func(sse.Event)) error
 }
 
@@ -63,10 +96,16 @@ type ProviderClient struct {
 	ProviderConfig ProviderConfig
 	Client         openapi.ClientInterface
 	timeouts       map[string]map[string]time.Duration
+	events         *EventHub
 }
 
 func NewProviderClient(providerConfig ProviderConfig, client openapi.ClientInterface, timeouts map[string]map[string]time.Duration) *ProviderClient {
-	return &ProviderClient{ProviderConfig: providerConfig, Client: client, timeouts: timeouts}
+	return &ProviderClient{
+		ProviderConfig: providerConfig,
+		Client:         client,
+		timeouts:       timeouts,
+		events:         NewEventHub(providerConfig),
+	}
 }
 
 // GenericResource is a Resource implementation that handles all interactions
@@ -115,7 +154,9 @@ type ResourceState interface {
 	// Populate the local state with the resource ID.
 	SetId(id string) error
 
-	// Get the path to obtain an event stream for the resource.
+	// Get the path to obtain an event stream for the resource. If the empty
+	// string is returned, then the resource does not support event streams
+	// and polling is used to await readiness.
 	GetEventPath() string
 }
 
@@ -133,7 +174,10 @@ func (r *GenericResource) Schema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema.Schema{
 		Description:         r.Description,
 		MarkdownDescription: r.Description,
-		Attributes:          attributes,
+		Attributes:          WithDefaultLabels(WithProviderDefaults(attributes)),
+		Blocks: map[string]schema.Block{
+			TIMEOUTS_BLOCK: TimeoutsBlock(),
+		},
 	}
 }
 
@@ -154,7 +198,30 @@ func (r *GenericResource) Configure(ctx context.Context, req resource.ConfigureR
 	r.client = getClient(&resp.Diagnostics, req.ProviderData)
 }
 
-func (r *GenericResource) finalizeCreateOrUpdate(ctx context.Context, state ResourceState, operation string, diags *diag.Diagnostics, tfstate *tfsdk.State) {
+func (r *GenericResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Do nothing on resource destroy or if the provider has not been
+	// configured yet, e.g. because its configuration is not known
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+	// Supply attributes that were not specified using the defaults in the
+	// provider configuration
+	r.client.applyProviderDefaults(ctx, &resp.Diagnostics, req.Config, resp.Plan.SetAttribute)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requiresReplaceForProviderDefaults(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Merge default labels in the provider configuration with the labels
+	// of the resource
+	if hasLabelsAll(req.Plan.Schema.Type()) {
+		r.planLabelsAll(ctx, req, resp)
+	}
+}
+
+func (r *GenericResource) finalizeCreateOrUpdate(ctx context.Context, state ResourceState, data resourceData, operation string, diags *diag.Diagnostics, tfstate *tfsdk.State) {
 	// Get resource state after create or update
 	err := state.Read(ctx, r.client.Client)
 	if err != nil {
@@ -164,24 +231,25 @@ func (r *GenericResource) finalizeCreateOrUpdate(ctx context.Context, state Reso
 	// Save resource into Terraform state before waiting for it to become
 	// ready. This allows Terraform to manage the resource even if the
 	// readiness check times out.
-	diags.Append(tfstate.Set(ctx, state)...)
+	r.writeResourceState(ctx, diags, tfstate, state, data)
 	if diags.HasError() {
 		return
 	}
 	// Wait for resource to become ready
-	err = r.AwaitReady(ctx, state, operation)
+	err = r.AwaitReady(ctx, state, operation, data.timeouts)
 	if err != nil {
 		diags.AddError("Unable to achieve desired state for "+r.TypeName, err.Error())
 		return
 	}
 	// Save resource into Terraform state again now that it is ready
-	diags.Append(tfstate.Set(ctx, state)...)
+	r.writeResourceState(ctx, diags, tfstate, state, data)
 }
 
 func (r *GenericResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Read desired resource state from Terraform
 	state := r.Build()
-	if !ReadResource(ctx, &resp.Diagnostics, req.Plan.Get, state) {
+	data, ok := r.readResourceState(ctx, &resp.Diagnostics, req.Plan.Get, state)
+	if !ok {
 		return
 	}
 	// Create the resource
@@ -190,17 +258,19 @@ func (r *GenericResource) Create(ctx context.Context, req resource.CreateRequest
 		resp.Diagnostics.AddError("Unable to create "+r.TypeName, err.Error())
 		return
 	}
-	r.finalizeCreateOrUpdate(ctx, state, CREATE_OPERATION, &resp.Diagnostics, &resp.State)
+	r.finalizeCreateOrUpdate(ctx, state, data, CREATE_OPERATION, &resp.Diagnostics, &resp.State)
 }
 
 func (r *GenericResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Read resource from Terraform state
 	state := r.Build()
-	if !ReadResource(ctx, &resp.Diagnostics, req.State.Get, state) {
+	data, ok := r.readResourceState(ctx, &resp.Diagnostics, req.State.Get, state)
+	if !ok {
 		return
 	}
-	// Get latest resource state
-	err := state.Read(ctx, r.client.Client)
+	// Get latest resource state, which is bounded by the read timeout if
+	// one is configured
+	err := r.readWithTimeout(ctx, state, data.timeouts)
 	if err != nil {
 		if helper.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
@@ -210,33 +280,41 @@ func (r *GenericResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 	// Save resource into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	r.writeResourceState(ctx, &resp.Diagnostics, &resp.State, state, data)
 }
 
 func (r *GenericResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Read desired resource state from Terraform
 	plan := r.Build()
-	if !ReadResource(ctx, &resp.Diagnostics, req.Plan.Get, plan) {
+	data, ok := r.readResourceState(ctx, &resp.Diagnostics, req.Plan.Get, plan)
+	if !ok {
 		return
 	}
 	// Read current resource state from Terraform
 	state := r.Build()
-	if !ReadResource(ctx, &resp.Diagnostics, req.State.Get, state) {
+	if _, ok := r.readResourceState(ctx, &resp.Diagnostics, req.State.Get, state); !ok {
 		return
 	}
 	// Update the resource
 	err := plan.Update(ctx, r.client.Client, state)
 	if err != nil {
+		var concurrentUpdateErr *ConcurrentUpdateError
+		if errors.As(err, &concurrentUpdateErr) {
+			resp.Diagnostics.AddError("Unable to update "+r.TypeName+" due to concurrent updates",
+				err.Error()+" Retry the operation once other clients have stopped updating the resource.")
+			return
+		}
 		resp.Diagnostics.AddError("Unable to update "+r.TypeName, err.Error())
 		return
 	}
-	r.finalizeCreateOrUpdate(ctx, plan, UPDATE_OPERATION, &resp.Diagnostics, &resp.State)
+	r.finalizeCreateOrUpdate(ctx, plan, data, UPDATE_OPERATION, &resp.Diagnostics, &resp.State)
 }
 
 func (r *GenericResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Read resource from Terraform state
 	state := r.Build()
-	if !ReadResource(ctx, &resp.Diagnostics, req.State.Get, state) {
+	data, ok := r.readResourceState(ctx, &resp.Diagnostics, req.State.Get, state)
+	if !ok {
 		return
 	}
 	// Delete the resource
@@ -246,7 +324,7 @@ func (r *GenericResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 	// Wait for resource to disappear
-	err = r.AwaitDeleted(ctx, state)
+	err = r.AwaitDeleted(ctx, state, data.timeouts)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to finalize deletion of "+r.TypeName, err.Error())
 		return
@@ -261,24 +339,41 @@ func (r *GenericResource) ImportState(ctx context.Context, req resource.ImportSt
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	r.writeResourceState(ctx, &resp.Diagnostics, &resp.State, state, resourceData{labels: types.MapNull(types.StringType)})
+}
+
+// ParseId splits a resource ID into its path segments, checking that it has a
+// non-empty value for each of the expected segments. The segment names are
+// only used to describe the expected format in the error message. A resource
+// that is not scoped to an organization (e.g. a cluster-scoped resource) has
+// a single segment for its name.
+func ParseId(id string, segments ...string) ([]string, error) {
+	pathParts := strings.Split(id, "/")
+	valid := len(pathParts) == len(segments)
+	for _, part := range pathParts {
+		valid = valid && part != ""
+	}
+	if !valid {
+		return nil, fmt.Errorf("Expected an id with format \"%s\". Got: %s", strings.Join(segments, "/"), id)
+	}
+	return pathParts, nil
 }
 
 const (
 	READINESS_TIMEOUT = 10 * time.Minute
 	DELETION_TIMEOUT  = 1 * time.Minute
-	POLLING_INTERVAL  = 10 * time.Second
-	FAILURE_THRESHOLD = POLLING_INTERVAL + 1*time.Second
 	DEFAULT_RESOURCE  = "default"
 	CREATE_OPERATION  = "create"
 	UPDATE_OPERATION  = "update"
 	DELETE_OPERATION  = "delete"
+	READ_OPERATION    = "read"
 )
 
 type OperationTimeouts struct {
 	Create *string `tfsdk:"create" hcl:"create" cty:"create"`
 	Update *string `tfsdk:"update" hcl:"update" cty:"update"`
 	Delete *string `tfsdk:"delete" hcl:"delete" cty:"delete"`
+	Read   *string `tfsdk:"read" hcl:"read" cty:"read"`
 }
 
 func ParseTimeouts(timeouts map[string]OperationTimeouts, resourceTypes map[string]struct{}) (map[string]map[string]time.Duration, error) {
@@ -291,34 +386,46 @@ func ParseTimeouts(timeouts map[string]OperationTimeouts, resourceTypes map[stri
 				errList = append(errList, fmt.Errorf("Invalid resource type: %s", resource))
 			}
 		}
-		rto := make(map[string]time.Duration)
-		for operation, operationTimeout := range map[string]*string{
-			CREATE_OPERATION: resourceTimeouts.Create,
-			UPDATE_OPERATION: resourceTimeouts.Update,
-			DELETE_OPERATION: resourceTimeouts.Delete,
-		} {
-			if operationTimeout == nil {
-				continue
-			}
-			// Parse time duration
-			parsed, err := time.ParseDuration(*operationTimeout)
-			if err != nil {
-				// Trim superfluous "time: " prefix from error message
-				errMsg := strings.TrimPrefix(err.Error(), "time: ")
-				errList = append(errList, fmt.Errorf("Invalid timeout for %s %s: %s", resource, operation, errMsg))
-				continue
-			}
-			if parsed < 0 {
-				errList = append(errList, fmt.Errorf("Timeout for %s %s is negative: %s", resource, operation, parsed))
-				continue
-			}
-			rto[operation] = parsed
+		rto, err := parseOperationTimeouts(resource, resourceTimeouts)
+		if err != nil {
+			errList = append(errList, err)
 		}
 		to[resource] = rto
 	}
 	return to, errors.Join(errList...)
 }
 
+// parseOperationTimeouts parses the timeouts for each operation on a
+// resource. The resource name is only used in error messages.
+func parseOperationTimeouts(resource string, resourceTimeouts OperationTimeouts) (map[string]time.Duration, error) {
+	var errList []error
+	rto := make(map[string]time.Duration)
+	for operation, operationTimeout := range map[string]*string{
+		CREATE_OPERATION: resourceTimeouts.Create,
+		UPDATE_OPERATION: resourceTimeouts.Update,
+		DELETE_OPERATION: resourceTimeouts.Delete,
+		READ_OPERATION:   resourceTimeouts.Read,
+	} {
+		if operationTimeout == nil {
+			continue
+		}
+		// Parse time duration
+		parsed, err := time.ParseDuration(*operationTimeout)
+		if err != nil {
+			// Trim superfluous "time: " prefix from error message
+			errMsg := strings.TrimPrefix(err.Error(), "time: ")
+			errList = append(errList, fmt.Errorf("Invalid timeout for %s %s: %s", resource, operation, errMsg))
+			continue
+		}
+		if parsed < 0 {
+			errList = append(errList, fmt.Errorf("Timeout for %s %s is negative: %s", resource, operation, parsed))
+			continue
+		}
+		rto[operation] = parsed
+	}
+	return rto, errors.Join(errList...)
+}
+
 func (r *GenericResource) getTimeout(resource, operation string) *time.Duration {
 	// Get timeouts for resource
 	timeouts, ok := r.client.timeouts[resource]
@@ -332,7 +439,15 @@ func (r *GenericResource) getTimeout(resource, operation string) *time.Duration
 	return nil
 }
 
-func (r *GenericResource) GetTimeout(operation string, defaultTimeout time.Duration) time.Duration {
+// GetTimeout returns the timeout for an operation on the resource. The
+// timeouts configured for the resource instance take precedence over the
+// timeouts configured in the provider for the resource type, which take
+// precedence over the `default` timeouts in the provider.
+func (r *GenericResource) GetTimeout(instanceTimeouts map[string]time.Duration, operation string, defaultTimeout time.Duration) time.Duration {
+	// Get timeout for resource instance and operation
+	if timeout, ok := instanceTimeouts[operation]; ok {
+		return timeout
+	}
 	// Get timeout for resource and operation
 	if timeout := r.getTimeout(r.TypeName, operation); timeout != nil {
 		return *timeout
@@ -345,13 +460,21 @@ func (r *GenericResource) GetTimeout(operation string, defaultTimeout time.Durat
 }
 
 type resourceFailedError struct {
-	message string
+	message   string
+	permanent bool
 }
 
 func ResourceFailed(format string, args ...any) error {
 	return &resourceFailedError{message: fmt.Sprintf(format, args...)}
 }
 
+// ResourceFailedPermanently returns an error indicating that the resource is
+// in a failed state that it will not recover from without its configuration
+// being changed, so that waiting for it is abandoned immediately.
+func ResourceFailedPermanently(format string, args ...any) error {
+	return &resourceFailedError{message: fmt.Sprintf(format, args...), permanent: true}
+}
+
 func (err *resourceFailedError) Error() string {
 	return err.message
 }
@@ -400,6 +523,34 @@ func sendToChannel[T any](ctx context.Context, ch chan T, v T) {
 	}
 }
 
+var errEventsNotSupported = errors.New("Event stream not supported for resource")
+
+func (r *GenericResource) consumeEvents(ctx context.Context, path string, callback func(sse.Event)) error {
+	if path == "" {
+		return errEventsNotSupported
+	}
+	return r.client.events.ConsumeEvents(ctx, path, callback)
+}
+
+// refresh reads the resource and notifies the consumer of the event stream
+// about its state.
+func (r *GenericResource) refresh(ctx context.Context, stream *eventStream, state ResourceState) {
+	err := stream.withLock(func() error {
+		return state.Read(ctx, r.client.Client)
+	})
+	if err == nil {
+		sendToChannel(ctx, stream.eventChannel, true)
+	} else if helper.IsNotFound(err) {
+		sendToChannel(ctx, stream.eventChannel, false)
+	} else if isNetworkError(err) {
+		// Suppress network errors, which may be transient and retriable
+		tflog.Info(ctx, "Suppressing network error while awaiting readiness",
+			map[string]any{"resourceType": r.TypeName, "error": err.Error()})
+	} else {
+		sendToChannel(ctx, stream.errChannel, err)
+	}
+}
+
 func (r *GenericResource) stream(ctx context.Context, state ResourceState) *eventStream {
 	stream := eventStream{
 		eventChannel: make(chan bool),
@@ -408,12 +559,19 @@ func (r *GenericResource) stream(ctx context.Context, state ResourceState) *even
 	stream.wg.Add(1)
 	go func() {
 		defer stream.wg.Done()
-		// Try to use SSE to stream events on resource
-		err := r.client.ProviderConfig.ConsumeEvents(ctx, state.GetEventPath(), func(event sse.Event) {
-			// Do nothing on heartbeat messages
+		// Try to use SSE to stream events on resource, if supported
+		err := r.consumeEvents(ctx, state.GetEventPath(), func(event sse.Event) {
+			// Do nothing on heartbeat messages, which are only used to
+			// detect stalled event streams
 			if event.Type == SSE_EVENT_HEARTBEAT {
 				return
 			}
+			// If event is RESYNC, read the resource, since events on it may
+			// have been missed while the event stream was not connected
+			if event.Type == SSE_EVENT_RESYNC {
+				r.refresh(ctx, &stream, state)
+				return
+			}
 			// If event is DELETED or has no data, notify that the resource was deleted
 			if event.Type == SSE_EVENT_DELETED || event.Data == SSE_DATA_NO_RESOURCE {
 				sendToChannel(ctx, stream.eventChannel, false)
@@ -436,25 +594,15 @@ func (r *GenericResource) stream(ctx context.Context, state ResourceState) *even
 			return
 		}
 		tflog.Info(ctx, "Downgrading from SSE to polling", map[string]any{"error": err})
+		backoff := r.client.ProviderConfig.GetPollingBackoff()
+		interval := backoff.InitialInterval
 		for {
-			err := stream.withLock(func() error {
-				return state.Read(ctx, r.client.Client)
-			})
-			if err == nil {
-				sendToChannel(ctx, stream.eventChannel, true)
-			} else if helper.IsNotFound(err) {
-				sendToChannel(ctx, stream.eventChannel, false)
-			} else if isNetworkError(err) {
-				// Suppress network errors, which may be transient and retriable
-				tflog.Info(ctx, "Suppressing network error while awaiting readiness",
-					map[string]any{"resourceType": r.TypeName, "error": err.Error()})
-			} else {
-				sendToChannel(ctx, stream.errChannel, err)
-			}
-			// Wait for polling interval or until context is done
+			r.refresh(ctx, &stream, state)
+			// Wait for polling interval or until context is done, and
+			// increase the interval for the next poll
 			select {
-			case <-time.After(POLLING_INTERVAL):
-				continue
+			case <-time.After(backoff.Delay(interval)):
+				interval = backoff.Next(interval)
 			case <-ctx.Done():
 				return
 			}
@@ -463,8 +611,8 @@ func (r *GenericResource) stream(ctx context.Context, state ResourceState) *even
 	return &stream
 }
 
-func (r *GenericResource) AwaitReady(ctx context.Context, state ResourceState, operation string) error {
-	timeout := r.GetTimeout(operation, READINESS_TIMEOUT)
+func (r *GenericResource) AwaitReady(ctx context.Context, state ResourceState, operation string, timeouts map[string]time.Duration) error {
+	timeout := r.GetTimeout(timeouts, operation, READINESS_TIMEOUT)
 	if timeout == 0 {
 		tflog.Info(ctx, "Not waiting for resource to achieve desired state because timeout is 0",
 			map[string]any{"resourceType": r.TypeName, "operation": operation})
@@ -476,8 +624,10 @@ func (r *GenericResource) AwaitReady(ctx context.Context, state ResourceState, o
 		cancel()
 		stream.wg.Wait()
 	}()
+	failureThreshold := r.client.ProviderConfig.GetPollingBackoff().FailureThreshold()
 	var readyErr error
 	var failedSince time.Time
+	reauthenticated := false
 	for {
 		var err error
 		done := false
@@ -487,7 +637,7 @@ func (r *GenericResource) AwaitReady(ctx context.Context, state ResourceState, o
 			if !exists {
 				return errors.New("Resource no longer exists")
 			}
-		case <-time.After(FAILURE_THRESHOLD):
+		case <-time.After(failureThreshold):
 			// Check readiness periodically even if not triggered by channel
 		case err = <-stream.errChannel:
 			// Check error encountered on channel
@@ -504,6 +654,9 @@ func (r *GenericResource) AwaitReady(ctx context.Context, state ResourceState, o
 					return fmt.Errorf("Timed out after %s", timeout)
 				}
 			}
+			if r.reauthenticate(ctx, err, &reauthenticated) {
+				continue
+			}
 			return err
 		}
 		// Check if resource is ready
@@ -513,11 +666,18 @@ func (r *GenericResource) AwaitReady(ctx context.Context, state ResourceState, o
 		if readyErr == nil {
 			return nil
 		}
-		// Return early if resource in failed state for some time
-		if _, ok := readyErr.(*resourceFailedError); ok {
+		if r.reauthenticate(ctx, readyErr, &reauthenticated) {
+			continue
+		}
+		// Return early if resource in failed state permanently or for some
+		// time
+		if failedErr, ok := readyErr.(*resourceFailedError); ok {
+			if failedErr.permanent {
+				return readyErr
+			}
 			if failedSince.IsZero() {
 				failedSince = time.Now()
-			} else if failedSince.Add(FAILURE_THRESHOLD).Before(time.Now()) {
+			} else if failedSince.Add(failureThreshold).Before(time.Now()) {
 				return readyErr
 			}
 		} else {
@@ -526,8 +686,27 @@ func (r *GenericResource) AwaitReady(ctx context.Context, state ResourceState, o
 	}
 }
 
-func (r *GenericResource) AwaitDeleted(ctx context.Context, state ResourceState) error {
-	timeout := r.GetTimeout(DELETE_OPERATION, DELETION_TIMEOUT)
+// reauthenticate obtains new credentials if the supplied error indicates that
+// the current credentials were rejected, which can happen if a session token
+// expires during a long wait, and returns whether the caller should retry.
+// Credentials are only renewed once per wait.
+func (r *GenericResource) reauthenticate(ctx context.Context, err error, reauthenticated *bool) bool {
+	if *reauthenticated || !helper.IsUnauthorized(err) {
+		return false
+	}
+	*reauthenticated = true
+	tflog.Info(ctx, "Credentials were rejected while awaiting readiness, logging in again",
+		map[string]any{"resourceType": r.TypeName, "error": err.Error()})
+	loginErr := r.client.ProviderConfig.Login(ctx)
+	if loginErr != nil {
+		tflog.Warn(ctx, "Unable to log in again", map[string]any{"error": loginErr.Error()})
+		return false
+	}
+	return true
+}
+
+func (r *GenericResource) AwaitDeleted(ctx context.Context, state ResourceState, timeouts map[string]time.Duration) error {
+	timeout := r.GetTimeout(timeouts, DELETE_OPERATION, DELETION_TIMEOUT)
 	if timeout == 0 {
 		tflog.Info(ctx, "Not waiting for deletion to be finalized because timeout is 0",
 			map[string]any{"resourceType": r.TypeName})
@@ -0,0 +1,226 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package framework
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	LABELS_ATTRIBUTE     = "labels"
+	LABELS_ALL_ATTRIBUTE = "labels_all"
+)
+
+// WithDefaultLabels adds the computed `labels_all` attribute to resources that
+// have a `labels` attribute. The `labels` attribute contains the labels that
+// were configured for the resource, while `labels_all` contains the labels
+// that are applied to the resource, which also include the `default_labels`
+// in the provider configuration. Keeping them separate allows the effective
+// labels to be shown in the plan without producing a diff between the
+// configured labels and the labels of the resource.
+func WithDefaultLabels(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	if _, ok := attributes[LABELS_ATTRIBUTE].(*schema.MapAttribute); !ok {
+		return attributes
+	}
+	description := "All labels of the resource, including those supplied by the `default_labels` configured for the provider"
+	attributes[LABELS_ALL_ATTRIBUTE] = &schema.MapAttribute{
+		Description:         description,
+		MarkdownDescription: description,
+		ElementType:         types.StringType,
+		Computed:            true,
+	}
+	return attributes
+}
+
+// hasLabelsAll returns whether the supplied schema type has the `labels_all`
+// attribute added by WithDefaultLabels().
+func hasLabelsAll(schemaType attr.Type) bool {
+	objectType, ok := schemaType.(types.ObjectType)
+	if !ok {
+		return false
+	}
+	_, ok = objectType.AttrTypes[LABELS_ALL_ATTRIBUTE]
+	return ok
+}
+
+// planLabelsAll sets the planned value of `labels_all` to the planned labels
+// merged with the default labels in the provider configuration. Labels that
+// are configured explicitly take precedence over default labels.
+func (r *GenericResource) planLabelsAll(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var configLabels, planLabels types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(LABELS_ATTRIBUTE), &configLabels)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root(LABELS_ATTRIBUTE), &planLabels)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defaultLabels := r.client.ProviderConfig.GetDefaultLabels()
+	labelsAll := types.MapUnknown(types.StringType)
+	if planLabels.IsUnknown() {
+		// If labels were not configured, then only the default labels are
+		// applied, otherwise the labels are not known yet
+		if configLabels.IsNull() && len(defaultLabels) != 0 {
+			labelsAll = toLabelsMap(defaultLabels, nil)
+		}
+	} else if len(defaultLabels) == 0 {
+		labelsAll = planLabels
+	} else {
+		labelsAll = toLabelsMap(defaultLabels, planLabels.Elements())
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(LABELS_ALL_ATTRIBUTE), labelsAll)...)
+}
+
+// toLabelsMap returns a map value containing the supplied default labels
+// and labels, where labels take precedence over default labels.
+func toLabelsMap(defaultLabels map[string]string, labels map[string]attr.Value) types.Map {
+	elements := make(map[string]attr.Value, len(defaultLabels)+len(labels))
+	for key, value := range defaultLabels {
+		elements[key] = types.StringValue(value)
+	}
+	for key, value := range labels {
+		elements[key] = value
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// withoutLabelsAll returns the supplied object without the `labels_all`
+// attribute, so that it can be converted to a ResourceState. If `labels_all`
+// is known, it is used as the value of `labels` so that the ResourceState
+// contains the labels to apply to the resource. The original value of
+// `labels` is also returned.
+func withoutLabelsAll(obj types.Object) (types.Object, types.Map, diag.Diagnostics) {
+	attributes := obj.Attributes()
+	labels, _ := attributes[LABELS_ATTRIBUTE].(types.Map)
+	labelsAll, ok := attributes[LABELS_ALL_ATTRIBUTE].(types.Map)
+	if !ok {
+		return obj, labels, nil
+	}
+	attributeTypes := obj.AttributeTypes(context.Background())
+	delete(attributes, LABELS_ALL_ATTRIBUTE)
+	delete(attributeTypes, LABELS_ALL_ATTRIBUTE)
+	if !labelsAll.IsNull() && !labelsAll.IsUnknown() {
+		attributes[LABELS_ATTRIBUTE] = labelsAll
+	}
+	result, diags := types.ObjectValue(attributeTypes, attributes)
+	return result, labels, diags
+}
+
+// resourceData contains the values in the Terraform plan or state that are
+// not part of the ResourceState.
+type resourceData struct {
+	// labels is the value of `labels`, which excludes default labels that
+	// were not configured explicitly
+	labels types.Map
+	// timeoutsBlock is the value of the `timeouts` block
+	timeoutsBlock types.Object
+	// timeouts contains the timeouts specified in the `timeouts` block by
+	// operation
+	timeouts map[string]time.Duration
+}
+
+// readResourceState decodes the Terraform plan or state to a ResourceState,
+// like ReadResource(), and returns the values of `labels` and `timeouts` in
+// the plan or state.
+func (r *GenericResource) readResourceState(ctx context.Context, diags *diag.Diagnostics, fn func(context.Context, any) diag.Diagnostics, dest ResourceState) (resourceData, bool) {
+	var obj types.Object
+	diags.Append(fn(ctx, &obj)...)
+	if diags.HasError() {
+		return resourceData{}, false
+	}
+	var data resourceData
+	var d diag.Diagnostics
+	obj, data.timeoutsBlock, data.timeouts, d = withoutTimeouts(ctx, r.TypeName, obj)
+	diags.Append(d...)
+	if diags.HasError() {
+		return resourceData{}, false
+	}
+	obj, data.labels, d = withoutLabelsAll(obj)
+	diags.Append(d...)
+	if diags.HasError() {
+		return resourceData{}, false
+	}
+	diags.Append(obj.As(ctx, dest, basetypes.ObjectAsOptions{
+		UnhandledNullAsEmpty:    true,
+		UnhandledUnknownAsEmpty: true,
+	})...)
+	return data, !diags.HasError()
+}
+
+// writeResourceState saves a ResourceState into the Terraform state along
+// with the `timeouts` block. If the resource has the `labels_all` attribute,
+// it is set to the labels of the resource, and `labels` is set to the labels
+// of the resource excluding default labels that are not in the configured
+// labels.
+func (r *GenericResource) writeResourceState(ctx context.Context, diags *diag.Diagnostics, tfstate *tfsdk.State, state ResourceState, data resourceData) {
+	objectType := tfstate.Schema.Type().(types.ObjectType)
+	// Convert ResourceState to object without `labels_all` and `timeouts`
+	attributeTypes := make(map[string]attr.Type, len(objectType.AttrTypes))
+	for name, attributeType := range objectType.AttrTypes {
+		if name != LABELS_ALL_ATTRIBUTE && name != TIMEOUTS_BLOCK {
+			attributeTypes[name] = attributeType
+		}
+	}
+	obj, d := types.ObjectValueFrom(ctx, attributeTypes, state)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+	attributes := obj.Attributes()
+	// Populate `labels_all` with labels of resource, and remove default labels
+	// from `labels` unless they were configured explicitly
+	if hasLabelsAll(objectType) {
+		labelsAll, _ := attributes[LABELS_ATTRIBUTE].(types.Map)
+		attributes[LABELS_ALL_ATTRIBUTE] = labelsAll
+		attributes[LABELS_ATTRIBUTE] = r.withoutDefaultLabels(labelsAll, data.labels)
+	}
+	// Preserve the `timeouts` block, which is not part of the resource
+	if timeoutsType, ok := objectType.AttrTypes[TIMEOUTS_BLOCK].(types.ObjectType); ok {
+		if data.timeoutsBlock.IsNull() {
+			attributes[TIMEOUTS_BLOCK] = types.ObjectNull(timeoutsType.AttrTypes)
+		} else {
+			attributes[TIMEOUTS_BLOCK] = data.timeoutsBlock
+		}
+	}
+	obj, d = types.ObjectValue(objectType.AttrTypes, attributes)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+	diags.Append(tfstate.Set(ctx, obj)...)
+}
+
+// withoutDefaultLabels returns the supplied labels excluding default labels,
+// unless they appear in the configured labels.
+func (r *GenericResource) withoutDefaultLabels(labels, configuredLabels types.Map) types.Map {
+	defaultLabels := r.client.ProviderConfig.GetDefaultLabels()
+	if labels.IsNull() || labels.IsUnknown() || len(defaultLabels) == 0 {
+		return labels
+	}
+	configured := configuredLabels.Elements()
+	elements := make(map[string]attr.Value)
+	for key, value := range labels.Elements() {
+		_, isConfigured := configured[key]
+		_, isDefault := defaultLabels[key]
+		if isConfigured || !isDefault {
+			elements[key] = value
+		}
+	}
+	// If there were no configured labels, then return null instead of an
+	// empty map to be consistent with the configuration
+	if len(elements) == 0 && (configuredLabels.IsNull() || configuredLabels.IsUnknown()) {
+		return types.MapNull(types.StringType)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
@@ -9,10 +9,14 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -268,3 +272,153 @@ func RequiresReplace() *GenericPlanModifier {
 		fn:          requiresReplace,
 	}
 }
+
+const (
+	ORGANIZATION_ATTRIBUTE = "organization"
+	PROJECT_ATTRIBUTE      = "project"
+	PROVIDER_DEFAULT_FMT   = "If not specified, the `default_%s` configured for the provider is used."
+)
+
+// providerDefaultAttributes are the top-level attributes whose values can be
+// supplied by the provider configuration if they are not specified.
+var providerDefaultAttributes = []string{ORGANIZATION_ATTRIBUTE, PROJECT_ATTRIBUTE}
+
+// getProviderDefault returns the default value in the provider configuration
+// for the attribute with the supplied name, or the empty string if there is
+// no default value.
+func (c *ProviderClient) getProviderDefault(name string) string {
+	switch name {
+	case ORGANIZATION_ATTRIBUTE:
+		return c.ProviderConfig.GetDefaultOrganization()
+	case PROJECT_ATTRIBUTE:
+		return c.ProviderConfig.GetDefaultProject()
+	}
+	return ""
+}
+
+// hasProviderDefault returns whether the attribute at the supplied path can be
+// supplied by the provider configuration. These are the attributes converted
+// by WithProviderDefaults().
+func hasProviderDefault(ctx context.Context, config tfsdk.Config, attrPath path.Path) bool {
+	attribute, diags := config.Schema.AttributeAtPath(ctx, attrPath)
+	return !diags.HasError() && attribute.IsOptional() && attribute.IsComputed()
+}
+
+// applyProviderDefaults sets the value of each attribute that can be supplied
+// by the provider configuration, if it is not specified in the supplied
+// configuration, using the supplied setter. If the attribute is not specified
+// and there is no default value, an error is added to the diagnostics.
+func (c *ProviderClient) applyProviderDefaults(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	config tfsdk.Config,
+	setAttribute func(context.Context, path.Path, any) diag.Diagnostics,
+) {
+	for _, name := range providerDefaultAttributes {
+		attrPath := path.Root(name)
+		if !hasProviderDefault(ctx, config, attrPath) {
+			continue
+		}
+		var configValue types.String
+		diags.Append(config.GetAttribute(ctx, attrPath, &configValue)...)
+		if diags.HasError() || !configValue.IsNull() {
+			continue
+		}
+		defaultValue := c.getProviderDefault(name)
+		if defaultValue == "" {
+			diags.AddAttributeError(attrPath, "Missing "+name,
+				fmt.Sprintf("The attribute `%s` must be specified because `default_%s` is not configured for the provider.", name, name))
+			continue
+		}
+		diags.Append(setAttribute(ctx, attrPath, types.StringValue(defaultValue))...)
+	}
+}
+
+// requiresReplaceForProviderDefaults checks whether a change to any attribute
+// that can be supplied by the provider configuration requires the resource
+// to be replaced. This is done after the provider defaults are applied,
+// instead of by the RequiresReplace() plan modifier, because plan modifiers
+// on attributes are invoked before the plan modifier of the resource.
+func requiresReplaceForProviderDefaults(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	for _, name := range providerDefaultAttributes {
+		attrPath := path.Root(name)
+		if !hasProviderDefault(ctx, req.Config, attrPath) {
+			continue
+		}
+		var planValue, stateValue types.String
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, attrPath, &planValue)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, attrPath, &stateValue)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		genericRequest := GenericRequest{
+			Path:       attrPath,
+			Config:     req.Config,
+			Plan:       resp.Plan,
+			PlanValue:  planValue,
+			State:      req.State,
+			StateValue: stateValue,
+		}
+		genericResponse := GenericResponse{
+			PlanValue:   planValue,
+			Diagnostics: &resp.Diagnostics,
+		}
+		requiresReplace(genericRequest, &genericResponse)
+		if genericResponse.RequiresReplace {
+			resp.RequiresReplace = append(resp.RequiresReplace, attrPath)
+		}
+	}
+}
+
+// withProviderDefaultDescription appends a sentence describing the provider
+// default to the description of an attribute.
+func withProviderDefaultDescription(description, name string) string {
+	if description != "" && !strings.HasSuffix(description, ".") {
+		description += "."
+	}
+	return strings.TrimSpace(description + " " + fmt.Sprintf(PROVIDER_DEFAULT_FMT, name))
+}
+
+// WithProviderDefaults makes each top-level attribute that can be supplied by
+// the provider configuration optional, if it is required. The RequiresReplace()
+// plan modifier is removed from the attribute, because the planned value is
+// not known until the plan modifier of the resource is invoked.
+func WithProviderDefaults[T any](attributes map[string]T) map[string]T {
+	result := make(map[string]T, len(attributes))
+	for name, attribute := range attributes {
+		result[name] = attribute
+	}
+	for _, name := range providerDefaultAttributes {
+		var converted any
+		switch attribute := any(attributes[name]).(type) {
+		case *resourceschema.StringAttribute:
+			if attribute.Required {
+				attr := *attribute
+				attr.Required, attr.Optional, attr.Computed = false, true, true
+				attr.Description = withProviderDefaultDescription(attr.Description, name)
+				attr.MarkdownDescription = withProviderDefaultDescription(attr.MarkdownDescription, name)
+				attr.PlanModifiers = nil
+				converted = &attr
+			}
+		case *datasourceschema.StringAttribute:
+			if attribute.Required {
+				attr := *attribute
+				attr.Required, attr.Optional, attr.Computed = false, true, true
+				attr.Description = withProviderDefaultDescription(attr.Description, name)
+				attr.MarkdownDescription = withProviderDefaultDescription(attr.MarkdownDescription, name)
+				converted = &attr
+			}
+		case datasourceschema.StringAttribute:
+			if attribute.Required {
+				attribute.Required, attribute.Optional, attribute.Computed = false, true, true
+				attribute.Description = withProviderDefaultDescription(attribute.Description, name)
+				attribute.MarkdownDescription = withProviderDefaultDescription(attribute.MarkdownDescription, name)
+				converted = attribute
+			}
+		}
+		if attr, ok := converted.(T); ok {
+			result[name] = attr
+		}
+	}
+	return result
+}
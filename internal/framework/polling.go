@@ -0,0 +1,67 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package framework
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	// DEFAULT_POLLING_INTERVAL is the default delay before the first poll of
+	// a resource after falling back to polling.
+	DEFAULT_POLLING_INTERVAL = 10 * time.Second
+
+	// DEFAULT_POLLING_MAX_INTERVAL is the default maximum delay between
+	// polls of a resource.
+	DEFAULT_POLLING_MAX_INTERVAL = 1 * time.Minute
+
+	// DEFAULT_POLLING_BACKOFF_MULTIPLIER is the default factor by which the
+	// delay between polls of a resource is multiplied after each poll.
+	DEFAULT_POLLING_BACKOFF_MULTIPLIER = 1.5
+)
+
+// PollingBackoff configures the delay between polls of a resource when
+// awaiting a resource without an event stream.
+type PollingBackoff struct {
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	Multiplier      float64
+}
+
+// DefaultPollingBackoff returns the polling backoff that is used if none is
+// configured.
+func DefaultPollingBackoff() PollingBackoff {
+	return PollingBackoff{
+		InitialInterval: DEFAULT_POLLING_INTERVAL,
+		MaxInterval:     DEFAULT_POLLING_MAX_INTERVAL,
+		Multiplier:      DEFAULT_POLLING_BACKOFF_MULTIPLIER,
+	}
+}
+
+// FailureThreshold returns the amount of time that a resource has to be in a
+// failed state before waiting for it is abandoned, which is also the maximum
+// delay between readiness checks. It is slightly longer than the initial
+// polling interval, so that the resource is polled at least once in between.
+func (b PollingBackoff) FailureThreshold() time.Duration {
+	return b.InitialInterval + 1*time.Second
+}
+
+// Delay returns the delay before the next poll given the current interval,
+// which is between half and all of the interval, so that resources that
+// started polling at the same time are not polled at the same time.
+func (b PollingBackoff) Delay(interval time.Duration) time.Duration {
+	return interval/2 + rand.N(interval/2+1)
+}
+
+// Next returns the interval after the supplied one.
+func (b PollingBackoff) Next(interval time.Duration) time.Duration {
+	next := float64(interval) * b.Multiplier
+	if next >= float64(b.MaxInterval) {
+		return b.MaxInterval
+	}
+	return time.Duration(next)
+}
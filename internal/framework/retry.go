@@ -0,0 +1,81 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/helper"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// CONCURRENT_UPDATE_MAX_ATTEMPTS is the maximum number of times that an
+	// update that fails due to a concurrent update is attempted.
+	CONCURRENT_UPDATE_MAX_ATTEMPTS = 8
+
+	// CONCURRENT_UPDATE_INITIAL_BACKOFF is the delay before the first retry
+	// of an update that fails due to a concurrent update, which is doubled
+	// after each subsequent attempt.
+	CONCURRENT_UPDATE_INITIAL_BACKOFF = 100 * time.Millisecond
+
+	// CONCURRENT_UPDATE_MAX_BACKOFF is the maximum delay between attempts.
+	CONCURRENT_UPDATE_MAX_BACKOFF = 5 * time.Second
+)
+
+// ConcurrentUpdateError is returned by RetryOnConcurrentUpdate if all
+// attempts to update a resource failed due to concurrent updates.
+type ConcurrentUpdateError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *ConcurrentUpdateError) Error() string {
+	return fmt.Sprintf("Resource is being updated concurrently by another client, and %d attempts to update it failed. "+
+		"The last error was: %s", e.Attempts, e.Err.Error())
+}
+
+func (e *ConcurrentUpdateError) Unwrap() error {
+	return e.Err
+}
+
+// RetryOnConcurrentUpdate invokes the supplied function, which should fetch
+// any state it depends on such as the resource version, and retries it with
+// exponential backoff and jitter if it fails due to a concurrent update. It
+// gives up after CONCURRENT_UPDATE_MAX_ATTEMPTS attempts, or if the context
+// deadline would expire before the next attempt, and returns
+// ConcurrentUpdateError.
+func RetryOnConcurrentUpdate(ctx context.Context, fn func() error) error {
+	backoff := CONCURRENT_UPDATE_INITIAL_BACKOFF
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		// If error is not retriable (code=CONCURRENT_UPDATE), fail fast
+		if err == nil || !helper.IsConcurrentUpdate(err) {
+			return err
+		}
+		if attempt >= CONCURRENT_UPDATE_MAX_ATTEMPTS {
+			return &ConcurrentUpdateError{Attempts: attempt, Err: err}
+		}
+		// Wait between half and all of the backoff, so that clients that
+		// conflicted with each other do not retry at the same time
+		delay := backoff/2 + rand.N(backoff/2+1)
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+			return &ConcurrentUpdateError{Attempts: attempt, Err: err}
+		}
+		tflog.Debug(ctx, "Retrying update that failed due to concurrent update",
+			map[string]any{"attempt": attempt, "delay": delay.String()})
+		select {
+		case <-ctx.Done():
+			return &ConcurrentUpdateError{Attempts: attempt, Err: err}
+		case <-time.After(delay):
+		}
+		backoff = min(2*backoff, CONCURRENT_UPDATE_MAX_BACKOFF)
+	}
+}
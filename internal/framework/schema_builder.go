@@ -8,7 +8,9 @@ package framework
 import (
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -26,6 +28,15 @@ func (ab *AttributeBuilder) WithStringAttribute(name, description string, option
 	return ab
 }
 
+func (ab *AttributeBuilder) WithRequiredStringAttribute(name, description string) *AttributeBuilder {
+	ab.attributes[name] = schema.StringAttribute{
+		Description:         description,
+		MarkdownDescription: description,
+		Required:            true,
+	}
+	return ab
+}
+
 func (ab *AttributeBuilder) WithOptionalStringAttribute(name, description string) *AttributeBuilder {
 	return ab.WithStringAttribute(name, description, true)
 }
@@ -48,6 +59,16 @@ func (ab *AttributeBuilder) WithStringListAttribute(name, description string) *A
 	return ab
 }
 
+func (ab *AttributeBuilder) WithComputedStringListAttribute(name, description string) *AttributeBuilder {
+	ab.attributes[name] = schema.ListAttribute{
+		Description:         description,
+		MarkdownDescription: description,
+		ElementType:         types.StringType,
+		Computed:            true,
+	}
+	return ab
+}
+
 func (ab *AttributeBuilder) WithNewNestedAttribute(name, description string) *AttributeBuilder {
 	childAttributes := make(map[string]schema.Attribute)
 	ab.attributes[name] = schema.SingleNestedAttribute{
@@ -72,6 +93,32 @@ func (ab *AttributeBuilder) WithNewListNestedAttribute(name, description string)
 	return &AttributeBuilder{childAttributes}
 }
 
+// WithDetailsAttribute attaches a computed nested attribute containing the
+// supplied data source attributes, which describe the full state of an item in
+// a list. The attributes that identify the item are required for data sources
+// that look up a single item, so they are converted to computed attributes.
+func (ab *AttributeBuilder) WithDetailsAttribute(typeName string, attributes map[string]schema.Attribute) *AttributeBuilder {
+	childAttributes := make(map[string]schema.Attribute, len(attributes))
+	for name, attribute := range attributes {
+		if stringAttribute, ok := attribute.(*schema.StringAttribute); ok && stringAttribute.Required {
+			computedAttribute := *stringAttribute
+			computedAttribute.Required = false
+			computedAttribute.Computed = true
+			computedAttribute.Validators = nil
+			attribute = &computedAttribute
+		}
+		childAttributes[name] = attribute
+	}
+	description := fmt.Sprintf("The details of the %s, which are only populated if `expand` is `true`", typeName)
+	ab.attributes["details"] = schema.SingleNestedAttribute{
+		Description:         description,
+		MarkdownDescription: description,
+		Attributes:          childAttributes,
+		Computed:            true,
+	}
+	return ab
+}
+
 type SchemaBuilder struct {
 	AttributeBuilder
 	description string
@@ -88,6 +135,12 @@ const (
 		"  * `key=value` - Only return items that have label with specified key set to value\n" +
 		"  * `!key` - Only return items that do _not_ have label with specified key\n" +
 		"  * `key!=value` - Only return items that do _not_ have label with specified key set to value"
+	FIELD_FILTER_DESCRIPTION = "List of filters to apply based on fields, which are composed using `AND`. " +
+		"Field paths refer to fields in the REST API representation of items, e.g. `status.state` or `tier`. Acceptable filter expressions are:\n" +
+		"  * `fieldPath` - Only return items that have a non-`null` value at the specified field path\n" +
+		"  * `fieldPath=value` - Only return items that have a matching value at the specified field path\n" +
+		"  * `!fieldPath` - Only return items that have a `null` value at the specified field path\n" +
+		"  * `fieldPath!=value` - Only return items that do _not_ have a matching value at the specified field path"
 )
 
 // WithOrganizationScopeFilters attaches common attributes for the filter nested
@@ -95,6 +148,7 @@ const (
 func (sb *SchemaBuilder) WithOrganizationScopeFilters(typeNamePlural string) *AttributeBuilder {
 	return sb.WithNewNestedAttribute("filter", fmt.Sprintf("Filters to apply to %s", typeNamePlural)).
 		WithStringListAttribute("labels", LABEL_FILTER_DESCRIPTION).
+		WithStringListAttribute("fields", FIELD_FILTER_DESCRIPTION).
 		WithOptionalStringAttribute("organization", fmt.Sprintf("The organization to filter %s on", typeNamePlural))
 }
 
@@ -133,6 +187,35 @@ func (sb *SchemaBuilder) WithDatabaseScopeList(typeName, typeNamePlural string)
 		WithComputedStringAttribute("database", fmt.Sprintf("The database the %s belongs to", typeName))
 }
 
+// WithMaxResultsAttribute attaches the attribute used to limit the number of
+// items returned by a data source that lists resources of the supplied type.
+func (sb *SchemaBuilder) WithMaxResultsAttribute(typeNamePlural string) *SchemaBuilder {
+	description := fmt.Sprintf("The maximum number of %s to return. If not specified, all %s that satisfy the filter requirements are returned.", typeNamePlural, typeNamePlural)
+	sb.attributes["max_results"] = schema.Int64Attribute{
+		Description:         description,
+		MarkdownDescription: description,
+		Optional:            true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+	return sb
+}
+
+// WithExpandAttribute attaches the attribute used to request the details of
+// each item returned by a data source that lists resources of the supplied
+// type.
+func (sb *SchemaBuilder) WithExpandAttribute(typeNamePlural string) *SchemaBuilder {
+	description := fmt.Sprintf("Whether to populate the `details` attribute of the %s, "+
+		"which are returned by the same requests used to list the %s", typeNamePlural, typeNamePlural)
+	sb.attributes["expand"] = schema.BoolAttribute{
+		Description:         description,
+		MarkdownDescription: description,
+		Optional:            true,
+	}
+	return sb
+}
+
 func (sb *SchemaBuilder) Build() *schema.Schema {
 	return &schema.Schema{
 		Description:         sb.description,
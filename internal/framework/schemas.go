@@ -9,9 +9,11 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	datasource "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -53,6 +55,38 @@ func WithPattern(path, pattern string) SchemaOverride {
 	})
 }
 
+// WithEnumValidator returns a SchemaOverride that validates the value of the
+// string property at the specified path against its enum values at plan
+// time. Enum values are not validated by default, since the server may accept
+// values that were added after the specification was embedded.
+func WithEnumValidator(path string) SchemaOverride {
+	return SchemaOverrideForPath(path, func(propertySchema *openapi3.Schema) {
+		if propertySchema.Extensions == nil {
+			propertySchema.Extensions = make(map[string]any)
+		}
+		propertySchema.Extensions["x-tf-validate-enum"] = true
+	})
+}
+
+// WithOptional returns a SchemaOverride that makes the property at the
+// specified path optional, so that its value is computed by the server if it
+// is not specified.
+func WithOptional(path string) SchemaOverride {
+	parentPath, name := "", path
+	if index := strings.LastIndex(path, "."); index != -1 {
+		parentPath, name = path[:index], path[index+1:]
+	}
+	removeRequired := func(oas *openapi3.Schema) {
+		oas.Required = slices.DeleteFunc(oas.Required, func(required string) bool {
+			return required == name
+		})
+	}
+	if parentPath == "" {
+		return removeRequired
+	}
+	return SchemaOverrideForPath(parentPath, removeRequired)
+}
+
 // SchemaOverrideForPath returns a SchemaOverride that applies an override to
 // the property at the specified path.
 func SchemaOverrideForPath(path string, override SchemaOverride) SchemaOverride {
@@ -65,8 +99,13 @@ func SchemaOverrideForPath(path string, override SchemaOverride) SchemaOverride
 				// Property has been found, so apply override
 				override(property.Value)
 			} else {
-				// Property is nested, so invoke override on nested schema
-				SchemaOverrideForPath(parts[1], override)(property.Value)
+				// Property is nested, so invoke override on nested schema,
+				// which is the schema of the items if it is an array
+				nested := property.Value
+				if getType(nested) == "array" && nested.Items != nil && nested.Items.Value != nil {
+					nested = nested.Items.Value
+				}
+				SchemaOverrideForPath(parts[1], override)(nested)
 			}
 		}
 	}
@@ -138,6 +177,10 @@ func IsImmutableAttribute(oas *openapi3.Schema) bool {
 	return IsExtensionSet(oas, "x-immutable") || IsIdentifierAttribute(oas)
 }
 
+func IsEnumValidated(oas *openapi3.Schema) bool {
+	return IsExtensionSet(oas, "x-tf-validate-enum")
+}
+
 func GetStringValidators(oas *openapi3.Schema) []validator.String {
 	var validators []validator.String
 
@@ -157,25 +200,83 @@ func GetStringValidators(oas *openapi3.Schema) []validator.String {
 		}
 		validators = append(validators, stringvalidator.RegexMatches(regexp.MustCompile(pattern), "must match pattern: "+pattern))
 	}
+
+	if IsEnumValidated(oas) {
+		var values []string
+		for _, value := range oas.Enum {
+			if str, ok := value.(string); ok {
+				values = append(values, str)
+			}
+		}
+		// Only constrain values if the enum has string values, since OneOf()
+		// with no values rejects every value
+		if len(values) > 0 {
+			validators = append(validators, stringvalidator.OneOf(values...))
+		}
+	}
 	return validators
 }
 
+// JSON_TYPE is the type returned by getType() for schemas describing free-form
+// JSON values, which are exposed as JSON-encoded string attributes.
+const JSON_TYPE = "json"
+
+// FREE_FORM_SCHEMAS are the references to the schemas in the specification
+// that describe free-form JSON values.
+var FREE_FORM_SCHEMAS = []string{
+	"#/components/schemas/JsonNode",
+	"#/components/schemas/AnyType",
+}
+
+// isFreeFormType returns whether the supplied schema describes a free-form
+// JSON value, which is the case if it is composed of one of the schemas in
+// FREE_FORM_SCHEMAS. Properties refer to these schemas using allOf, since
+// other keywords alongside $ref, such as the Terraform name, are ignored.
+func isFreeFormType(schema *openapi3.Schema) bool {
+	for _, schemaRef := range schema.AllOf {
+		if schemaRef != nil && slices.Contains(FREE_FORM_SCHEMAS, schemaRef.Ref) {
+			return true
+		}
+	}
+	return false
+}
+
 // getType returns the schema type as a string, which in practice is how the
-// type attribute is always serialized. If the type attribute is absent or
+// type attribute is always serialized. If the schema describes a free-form
+// JSON value, then JSON_TYPE is returned. If the type attribute is absent or
 // serialized as an array that does not contain exactly one element, then the
 // empty string is returned.
 func getType(schema *openapi3.Schema) string {
-	if schema == nil || schema.Type == nil || len(schema.Type.Slice()) != 1 {
+	if schema == nil {
+		return ""
+	}
+	if isFreeFormType(schema) {
+		return JSON_TYPE
+	}
+	if schema.Type == nil || len(schema.Type.Slice()) != 1 {
 		return ""
 	}
 	return schema.Type.Slice()[0]
 }
 
+// isMapType returns whether the supplied schema is an object with
+// additionalProperties, which is represented as a map.
+func isMapType(schema *openapi3.Schema) bool {
+	return getType(schema) == "object" && schema.AdditionalProperties.Schema != nil
+}
+
 // GetTerraformType returns the primitive type appearing in the supplied schema.
 // Types "array" and "object" are ignored and should be handled by using
-// ToResourceSchema() or ToDataSourceSchema().
+// ToResourceSchema() or ToDataSourceSchema(), except for maps with primitive
+// values, which are returned as a map type.
 func GetTerraformType(schemaRef *openapi3.SchemaRef) attr.Type {
 	if schemaRef != nil && schemaRef.Value != nil {
+		if isMapType(schemaRef.Value) {
+			if elemType := GetTerraformType(schemaRef.Value.AdditionalProperties.Schema); elemType != nil {
+				return types.MapType{ElemType: elemType}
+			}
+			return nil
+		}
 		switch getType(schemaRef.Value) {
 		case "boolean":
 			return types.BoolType
@@ -309,7 +410,7 @@ func ToResourceAttribute(oas *openapi3.Schema, required, readOnly bool) (string,
 	case "object":
 		if oas.AdditionalProperties.Schema != nil {
 			// If map values are objects, use MapNestedAttribute to attach nested object schema
-			if getType(oas.AdditionalProperties.Schema.Value) == "object" {
+			if getType(oas.AdditionalProperties.Schema.Value) == "object" && !isMapType(oas.AdditionalProperties.Schema.Value) {
 				return name, &resource.MapNestedAttribute{
 					Description:         oas.Description,
 					MarkdownDescription: oas.Description,
@@ -356,6 +457,19 @@ func ToResourceAttribute(oas *openapi3.Schema, required, readOnly bool) (string,
 			Validators:          GetStringValidators(oas),
 			PlanModifiers:       appendNonNil([]planmodifier.String{}, planmodifier.String(useStateForUnknown), planmodifier.String(requiresReplace)),
 		}
+	case JSON_TYPE:
+		// Use normalized JSON type so that semantically equivalent JSON
+		// values do not produce a diff
+		return name, &resource.StringAttribute{
+			Description:         oas.Description,
+			MarkdownDescription: oas.Description,
+			Required:            required,
+			Optional:            optional,
+			Computed:            computed,
+			Sensitive:           sensitive,
+			CustomType:          jsontypes.NormalizedType{},
+			PlanModifiers:       appendNonNil([]planmodifier.String{}, planmodifier.String(useStateForUnknown), planmodifier.String(requiresReplace)),
+		}
 	default:
 		return "", nil
 	}
@@ -436,7 +550,7 @@ func ToDataSourceAttribute(oas *openapi3.Schema) (string, datasource.Attribute)
 	case "object":
 		if oas.AdditionalProperties.Schema != nil {
 			// If map values are objects, use MapNestedAttribute to attach nested object schema
-			if getType(oas.AdditionalProperties.Schema.Value) == "object" {
+			if getType(oas.AdditionalProperties.Schema.Value) == "object" && !isMapType(oas.AdditionalProperties.Schema.Value) {
 				return name, &datasource.MapNestedAttribute{
 					Description:         oas.Description,
 					MarkdownDescription: oas.Description,
@@ -474,6 +588,15 @@ func ToDataSourceAttribute(oas *openapi3.Schema) (string, datasource.Attribute)
 			Computed:            computed,
 			Sensitive:           sensitive,
 		}
+	case JSON_TYPE:
+		return name, &datasource.StringAttribute{
+			Description:         oas.Description,
+			MarkdownDescription: oas.Description,
+			Required:            required,
+			Computed:            computed,
+			Sensitive:           sensitive,
+			CustomType:          jsontypes.NormalizedType{},
+		}
 	default:
 		return "", nil
 	}
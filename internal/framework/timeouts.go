@@ -0,0 +1,105 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const TIMEOUTS_BLOCK = "timeouts"
+
+var durationPattern = regexp.MustCompile(`^(0|([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+)$`)
+
+func timeoutAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description:         description,
+		MarkdownDescription: description,
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(durationPattern, "must be a non-negative duration with time unit suffix"),
+		},
+	}
+}
+
+// TimeoutsBlock returns the `timeouts` block that is added to every resource
+// to override the timeouts configured in the provider for the resource type.
+func TimeoutsBlock() schema.SingleNestedBlock {
+	description := "Timeouts for operations on this resource, which take precedence over the `timeouts` configured in the provider"
+	return schema.SingleNestedBlock{
+		Description:         description,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			CREATE_OPERATION: timeoutAttribute("The timeout for resource readiness after creation, specified as a duration with time unit suffix, e.g. `10m`. " +
+				"A timeout of `0` indicates not to wait."),
+			UPDATE_OPERATION: timeoutAttribute("The timeout for resource readiness after update, specified as a duration with time unit suffix, e.g. `1m`. " +
+				"A timeout of `0` indicates not to wait."),
+			DELETE_OPERATION: timeoutAttribute("The timeout for resource deletion, specified as a duration with time unit suffix, e.g. `30s`. " +
+				"A timeout of `0` indicates not to wait."),
+			READ_OPERATION: timeoutAttribute("The timeout for reading the resource, including when it is imported, specified as a duration with time unit suffix, e.g. `1m`. " +
+				"By default, there is no timeout."),
+		},
+	}
+}
+
+// withoutTimeouts returns the supplied object without the `timeouts` block,
+// so that it can be converted to a ResourceState, along with the value of
+// the block and the timeouts that it specifies. The resource type is only used
+// in error messages.
+func withoutTimeouts(ctx context.Context, resourceType string, obj types.Object) (types.Object, types.Object, map[string]time.Duration, diag.Diagnostics) {
+	attributes := obj.Attributes()
+	timeouts, ok := attributes[TIMEOUTS_BLOCK].(types.Object)
+	if !ok {
+		return obj, types.Object{}, nil, nil
+	}
+	attributeTypes := obj.AttributeTypes(ctx)
+	delete(attributes, TIMEOUTS_BLOCK)
+	delete(attributeTypes, TIMEOUTS_BLOCK)
+	result, diags := types.ObjectValue(attributeTypes, attributes)
+	if diags.HasError() || timeouts.IsNull() || timeouts.IsUnknown() {
+		return result, timeouts, nil, diags
+	}
+	// Decode and parse the timeouts for each operation
+	var operationTimeouts OperationTimeouts
+	diags.Append(timeouts.As(ctx, &operationTimeouts, basetypes.ObjectAsOptions{
+		UnhandledNullAsEmpty:    true,
+		UnhandledUnknownAsEmpty: true,
+	})...)
+	if diags.HasError() {
+		return result, timeouts, nil, diags
+	}
+	parsed, err := parseOperationTimeouts(resourceType, operationTimeouts)
+	if err != nil {
+		diags.AddAttributeError(path.Root(TIMEOUTS_BLOCK), "Invalid timeouts", err.Error())
+	}
+	return result, timeouts, parsed, diags
+}
+
+// readWithTimeout reads the resource state, failing if the read timeout
+// expires before the resource is read.
+func (r *GenericResource) readWithTimeout(ctx context.Context, state ResourceState, timeouts map[string]time.Duration) error {
+	timeout := r.GetTimeout(timeouts, READ_OPERATION, 0)
+	if timeout == 0 {
+		return state.Read(ctx, r.client.Client)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	err := state.Read(ctx, r.client.Client)
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		return fmt.Errorf("Timed out after %s: %s", timeout, err.Error())
+	}
+	return err
+}
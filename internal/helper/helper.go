@@ -8,6 +8,7 @@ package helper
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -21,6 +22,16 @@ func IsNotFound(err error) bool {
 	return ok && apiError.GetStatusCode() == http.StatusNotFound
 }
 
+func IsConcurrentUpdate(err error) bool {
+	apiError, ok := err.(*ApiError)
+	return ok && apiError.GetCode() == openapi.ErrorContentCodeCONCURRENTUPDATE
+}
+
+func IsUnauthorized(err error) bool {
+	apiError, ok := err.(*ApiError)
+	return ok && apiError.GetStatusCode() == http.StatusUnauthorized
+}
+
 var _ error = &ApiError{}
 
 type ApiError struct {
@@ -90,29 +101,172 @@ func ParseResponse(resp *http.Response, dest any) error {
 	return nil
 }
 
-func processListResponse(prefix string, resp *http.Response, err error) ([]string, error) {
+// DEFAULT_PAGE_SIZE is the number of items requested per page when listing
+// resources, if no page size was configured.
+const DEFAULT_PAGE_SIZE = 100
+
+// ListOptions contains the options for listing resources.
+type ListOptions struct {
+	// LabelFilter is a comma-separated list of label filters to apply.
+	LabelFilter *string
+
+	// FieldFilter is a comma-separated list of field filters to apply.
+	FieldFilter *string
+
+	// ListAccessible is whether to return accessible sub-resources even if
+	// the current user does not have access to list all resources.
+	ListAccessible bool
+
+	// MaxResults is the maximum number of items to return. If nil, then all
+	// items are returned.
+	MaxResults *int64
+
+	// Expand is whether to return the payload of each item in addition to
+	// its name.
+	Expand bool
+
+	// PageSize is the maximum number of items to request per page. If 0,
+	// then DEFAULT_PAGE_SIZE is used.
+	PageSize int32
+}
+
+// getPageSize returns the maximum number of items to request per page.
+func (opts ListOptions) getPageSize() int32 {
+	if opts.PageSize > 0 {
+		return opts.PageSize
+	}
+	return DEFAULT_PAGE_SIZE
+}
+
+// getExpand returns the value of the `expand` query parameter.
+func (opts ListOptions) getExpand() *string {
+	if !opts.Expand {
+		return nil
+	}
+	expand := "true"
+	return &expand
+}
+
+// ListItem is an item returned by a list request.
+type ListItem struct {
+	// Name is the fully-qualified name of the resource.
+	Name string
+
+	// Payload is the JSON-encoded resource, which is only populated if
+	// payload expansion was requested.
+	Payload json.RawMessage
+}
+
+// DecodePayload decodes the payload of the item into the supplied value. If
+// the item does not have a payload, this returns false.
+func (item ListItem) DecodePayload(v any) (bool, error) {
+	if item.Payload == nil {
+		return false, nil
+	}
+	err := json.Unmarshal(item.Payload, v)
+	if err != nil {
+		return false, fmt.Errorf("Unable to decode payload of %s: %w", item.Name, err)
+	}
+	return true, nil
+}
+
+// listPageFn requests a page of items with the supplied limit and cursor.
+type listPageFn func(limit *int32, cursor *string) (*http.Response, error)
+
+// listAll requests pages of items using the supplied function, following the
+// cursor of each page until all items are returned or the maximum number of
+// results is reached, and returns the items with the supplied prefix.
+func listAll(ctx context.Context, prefix string, opts ListOptions, fn listPageFn) ([]ListItem, error) {
+	pageSize := opts.getPageSize()
+	var names []ListItem
+	var cursor *string
+	for {
+		// Do not request more items than needed
+		limit := pageSize
+		if opts.MaxResults != nil {
+			remaining := *opts.MaxResults - int64(len(names))
+			if remaining <= 0 {
+				break
+			}
+			if remaining < int64(limit) {
+				limit = int32(remaining)
+			}
+		}
+		resp, err := fn(&limit, cursor)
+		items, next, err := processListResponse(resp, err)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			item.Name = prefix + item.Name
+			names = append(names, item)
+		}
+		// Stop if there are no more items. Also stop if the cursor did not
+		// advance, which could happen if the server does not support cursors.
+		// The cursor is the sub-path of the last item, which is compared to
+		// the `$ref` of each item if payloads are expanded.
+		if !next || len(items) == 0 {
+			break
+		}
+		last := items[len(items)-1].Name
+		if cursor != nil && *cursor == last {
+			break
+		}
+		cursor = &last
+	}
+	// Truncate items in case more were returned than requested
+	if opts.MaxResults != nil && *opts.MaxResults >= 0 && int64(len(names)) > *opts.MaxResults {
+		names = names[:*opts.MaxResults]
+	}
+	return names, nil
+}
+
+// processListResponse decodes a page of items and returns the items and
+// whether more items are available.
+func processListResponse(resp *http.Response, err error) ([]ListItem, bool, error) {
 	// Make sure request was successful
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	// Decode as ItemList
 	var itemList openapi.ItemList
 	err = ParseResponse(resp, &itemList)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
-	// Return resource names with prefix
-	var names []string
+	// Return resource names, along with payloads if items were expanded
+	var items []ListItem
 	if itemList.Items != nil {
 		for _, item := range *itemList.Items {
-			name, err := item.AsItemListItems0()
+			listItem, err := toListItem(item)
 			if err != nil {
-				return nil, err
+				return nil, false, err
 			}
-			names = append(names, prefix+name)
+			items = append(items, listItem)
 		}
 	}
-	return names, nil
+	return items, itemList.Next != nil, nil
+}
+
+// toListItem converts an item returned by a list request, which is either a
+// string or an expanded payload containing `$ref`, to a ListItem.
+func toListItem(item openapi.ItemList_Items_Item) (ListItem, error) {
+	name, err := item.AsItemListItems0()
+	if err == nil {
+		return ListItem{Name: name}, nil
+	}
+	entry, err := item.AsExpandedListEntry()
+	if err != nil {
+		return ListItem{}, err
+	}
+	if entry.Ref == nil {
+		return ListItem{}, errors.New("Expanded list item has no $ref")
+	}
+	payload, err := item.MarshalJSON()
+	if err != nil {
+		return ListItem{}, err
+	}
+	return ListItem{Name: *entry.Ref, Payload: payload}, nil
 }
 
 func checkBackupFilter(organization, project, database string) error {
@@ -131,125 +285,254 @@ func checkBackupFilter(organization, project, database string) error {
 	return nil
 }
 
-func GetBackups(ctx context.Context, client openapi.ClientInterface, organization, project, database string, labelFilter *string, listAccessible bool) ([]string, error) {
+func GetBackups(ctx context.Context, client openapi.ClientInterface, organization, project, database string, opts ListOptions) ([]ListItem, error) {
 	err := checkBackupFilter(organization, project, database)
 	if err != nil {
 		return nil, err
 	}
 	var prefix string
-	var resp *http.Response
+	var fn listPageFn
 	if len(organization) == 0 {
 		// List all backups
-		params := openapi.GetAllBackupsParams{
-			LabelFilter:    labelFilter,
-			ListAccessible: &listAccessible,
+		fn = func(limit *int32, cursor *string) (*http.Response, error) {
+			params := openapi.GetAllBackupsParams{
+				Limit:          limit,
+				Cursor:         cursor,
+				Expand:         opts.getExpand(),
+				LabelFilter:    opts.LabelFilter,
+				FieldFilter:    opts.FieldFilter,
+				ListAccessible: &opts.ListAccessible,
+			}
+			return client.GetAllBackups(ctx, &params)
 		}
-		resp, err = client.GetAllBackups(ctx, &params)
 	} else if len(project) == 0 {
 		// List all backups within organization
 		prefix = organization + "/"
-		params := openapi.GetOrganizationBackupsParams{
-			LabelFilter:    labelFilter,
-			ListAccessible: &listAccessible,
+		fn = func(limit *int32, cursor *string) (*http.Response, error) {
+			params := openapi.GetOrganizationBackupsParams{
+				Limit:          limit,
+				Cursor:         cursor,
+				Expand:         opts.getExpand(),
+				LabelFilter:    opts.LabelFilter,
+				FieldFilter:    opts.FieldFilter,
+				ListAccessible: &opts.ListAccessible,
+			}
+			return client.GetOrganizationBackups(ctx, organization, &params)
 		}
-		resp, err = client.GetOrganizationBackups(ctx, organization, &params)
 	} else if len(database) == 0 {
 		// List all backups within project
 		prefix = organization + "/" + project + "/"
-		params := openapi.GetProjectBackupsParams{
-			LabelFilter:    labelFilter,
-			ListAccessible: &listAccessible,
+		fn = func(limit *int32, cursor *string) (*http.Response, error) {
+			params := openapi.GetProjectBackupsParams{
+				Limit:          limit,
+				Cursor:         cursor,
+				Expand:         opts.getExpand(),
+				LabelFilter:    opts.LabelFilter,
+				FieldFilter:    opts.FieldFilter,
+				ListAccessible: &opts.ListAccessible,
+			}
+			return client.GetProjectBackups(ctx, organization, project, &params)
 		}
-		resp, err = client.GetProjectBackups(ctx, organization, project, &params)
 	} else {
 		// List all backups within database
 		prefix = organization + "/" + project + "/" + database + "/"
-		params := openapi.GetBackupsParams{
-			LabelFilter:    labelFilter,
-			ListAccessible: &listAccessible,
+		fn = func(limit *int32, cursor *string) (*http.Response, error) {
+			params := openapi.GetBackupsParams{
+				Limit:          limit,
+				Cursor:         cursor,
+				Expand:         opts.getExpand(),
+				LabelFilter:    opts.LabelFilter,
+				FieldFilter:    opts.FieldFilter,
+				ListAccessible: &opts.ListAccessible,
+			}
+			return client.GetBackups(ctx, organization, project, database, &params)
 		}
-		resp, err = client.GetBackups(ctx, organization, project, database, &params)
 	}
-	return processListResponse(prefix, resp, err)
+	return listAll(ctx, prefix, opts, fn)
 }
 
-func GetDatabases(ctx context.Context, client openapi.ClientInterface, organization, project string, labelFilter *string, listAccessible bool) ([]string, error) {
+func GetDatabases(ctx context.Context, client openapi.ClientInterface, organization, project string, opts ListOptions) ([]ListItem, error) {
 	var prefix string
-	var resp *http.Response
-	var err error
+	var fn listPageFn
 	if len(organization) == 0 {
 		// Make sure project was not specified without organization
 		if len(project) != 0 {
 			return nil, fmt.Errorf("Cannot specify project filter (%s) without organization", project)
 		}
 		// List all databases
-		params := openapi.GetAllDatabasesParams{
-			LabelFilter:    labelFilter,
-			ListAccessible: &listAccessible,
+		fn = func(limit *int32, cursor *string) (*http.Response, error) {
+			params := openapi.GetAllDatabasesParams{
+				Limit:          limit,
+				Cursor:         cursor,
+				Expand:         opts.getExpand(),
+				LabelFilter:    opts.LabelFilter,
+				FieldFilter:    opts.FieldFilter,
+				ListAccessible: &opts.ListAccessible,
+			}
+			return client.GetAllDatabases(ctx, &params)
 		}
-		resp, err = client.GetAllDatabases(ctx, &params)
 	} else if len(project) == 0 {
 		// List all databases within organization
 		prefix = organization + "/"
-		params := openapi.GetOrganizationDatabasesParams{
-			LabelFilter:    labelFilter,
-			ListAccessible: &listAccessible,
+		fn = func(limit *int32, cursor *string) (*http.Response, error) {
+			params := openapi.GetOrganizationDatabasesParams{
+				Limit:          limit,
+				Cursor:         cursor,
+				Expand:         opts.getExpand(),
+				LabelFilter:    opts.LabelFilter,
+				FieldFilter:    opts.FieldFilter,
+				ListAccessible: &opts.ListAccessible,
+			}
+			return client.GetOrganizationDatabases(ctx, organization, &params)
 		}
-		resp, err = client.GetOrganizationDatabases(ctx, organization, &params)
 	} else {
 		// List all databases within project
 		prefix = organization + "/" + project + "/"
-		params := openapi.GetDatabasesParams{
-			LabelFilter:    labelFilter,
-			ListAccessible: &listAccessible,
+		fn = func(limit *int32, cursor *string) (*http.Response, error) {
+			params := openapi.GetDatabasesParams{
+				Limit:          limit,
+				Cursor:         cursor,
+				Expand:         opts.getExpand(),
+				LabelFilter:    opts.LabelFilter,
+				FieldFilter:    opts.FieldFilter,
+				ListAccessible: &opts.ListAccessible,
+			}
+			return client.GetDatabases(ctx, organization, project, &params)
 		}
-		resp, err = client.GetDatabases(ctx, organization, project, &params)
 	}
-	return processListResponse(prefix, resp, err)
+	return listAll(ctx, prefix, opts, fn)
 }
 
-func GetProjects(ctx context.Context, client openapi.ClientInterface, organization string, labelFilter *string, listAccessible bool) ([]string, error) {
+func GetProjects(ctx context.Context, client openapi.ClientInterface, organization string, opts ListOptions) ([]ListItem, error) {
 	var prefix string
-	var resp *http.Response
-	var err error
+	var fn listPageFn
 	if len(organization) == 0 {
 		// List all projects
-		params := openapi.GetAllProjectsParams{
-			LabelFilter:    labelFilter,
-			ListAccessible: &listAccessible,
+		fn = func(limit *int32, cursor *string) (*http.Response, error) {
+			params := openapi.GetAllProjectsParams{
+				Limit:          limit,
+				Cursor:         cursor,
+				Expand:         opts.getExpand(),
+				LabelFilter:    opts.LabelFilter,
+				FieldFilter:    opts.FieldFilter,
+				ListAccessible: &opts.ListAccessible,
+			}
+			return client.GetAllProjects(ctx, &params)
 		}
-		resp, err = client.GetAllProjects(ctx, &params)
 	} else {
 		// List all project within organization
 		prefix = organization + "/"
-		params := openapi.GetProjectsParams{
-			LabelFilter:    labelFilter,
-			ListAccessible: &listAccessible,
+		fn = func(limit *int32, cursor *string) (*http.Response, error) {
+			params := openapi.GetProjectsParams{
+				Limit:          limit,
+				Cursor:         cursor,
+				Expand:         opts.getExpand(),
+				LabelFilter:    opts.LabelFilter,
+				FieldFilter:    opts.FieldFilter,
+				ListAccessible: &opts.ListAccessible,
+			}
+			return client.GetProjects(ctx, organization, &params)
+		}
+	}
+	return listAll(ctx, prefix, opts, fn)
+}
+
+func GetUsers(ctx context.Context, client openapi.ClientInterface, organization string, opts ListOptions) ([]ListItem, error) {
+	var prefix string
+	var fn listPageFn
+	if len(organization) == 0 {
+		// List all users
+		fn = func(limit *int32, cursor *string) (*http.Response, error) {
+			params := openapi.GetAllUsersParams{
+				Limit:          limit,
+				Cursor:         cursor,
+				Expand:         opts.getExpand(),
+				LabelFilter:    opts.LabelFilter,
+				FieldFilter:    opts.FieldFilter,
+				ListAccessible: &opts.ListAccessible,
+			}
+			return client.GetAllUsers(ctx, &params)
+		}
+	} else {
+		// List all users within organization
+		prefix = organization + "/"
+		fn = func(limit *int32, cursor *string) (*http.Response, error) {
+			params := openapi.GetUsersParams{
+				Limit:          limit,
+				Cursor:         cursor,
+				Expand:         opts.getExpand(),
+				LabelFilter:    opts.LabelFilter,
+				FieldFilter:    opts.FieldFilter,
+				ListAccessible: &opts.ListAccessible,
+			}
+			return client.GetUsers(ctx, organization, &params)
 		}
-		resp, err = client.GetProjects(ctx, organization, &params)
 	}
-	return processListResponse(prefix, resp, err)
+	return listAll(ctx, prefix, opts, fn)
 }
 
-func GetBackupPolicies(ctx context.Context, client openapi.ClientInterface, organization string, labelFilter *string, listAccessible bool) ([]string, error) {
+func GetBackupPolicies(ctx context.Context, client openapi.ClientInterface, organization string, opts ListOptions) ([]ListItem, error) {
 	var prefix string
-	var resp *http.Response
-	var err error
+	var fn listPageFn
 	if len(organization) == 0 {
 		// List all backup policies
-		params := openapi.GetAllBackupPoliciesParams{
-			LabelFilter:    labelFilter,
-			ListAccessible: &listAccessible,
+		fn = func(limit *int32, cursor *string) (*http.Response, error) {
+			params := openapi.GetAllBackupPoliciesParams{
+				Limit:          limit,
+				Cursor:         cursor,
+				Expand:         opts.getExpand(),
+				LabelFilter:    opts.LabelFilter,
+				FieldFilter:    opts.FieldFilter,
+				ListAccessible: &opts.ListAccessible,
+			}
+			return client.GetAllBackupPolicies(ctx, &params)
 		}
-		resp, err = client.GetAllBackupPolicies(ctx, &params)
 	} else {
 		// List all backup policies within organization
 		prefix = organization + "/"
-		params := openapi.GetBackupPoliciesParams{
-			LabelFilter:    labelFilter,
-			ListAccessible: &listAccessible,
+		fn = func(limit *int32, cursor *string) (*http.Response, error) {
+			params := openapi.GetBackupPoliciesParams{
+				Limit:          limit,
+				Cursor:         cursor,
+				Expand:         opts.getExpand(),
+				LabelFilter:    opts.LabelFilter,
+				FieldFilter:    opts.FieldFilter,
+				ListAccessible: &opts.ListAccessible,
+			}
+			return client.GetBackupPolicies(ctx, organization, &params)
 		}
-		resp, err = client.GetBackupPolicies(ctx, organization, &params)
 	}
-	return processListResponse(prefix, resp, err)
+	return listAll(ctx, prefix, opts, fn)
+}
+
+func GetBackupsFromPolicy(ctx context.Context, client openapi.ClientInterface, organization, policy string, opts ListOptions) ([]ListItem, error) {
+	// List all backups created by backup policy, which are returned as
+	// fully-qualified names
+	return listAll(ctx, "", opts, func(limit *int32, cursor *string) (*http.Response, error) {
+		params := openapi.GetBackupsFromPolicyParams{
+			Limit:          limit,
+			Cursor:         cursor,
+			Expand:         opts.getExpand(),
+			LabelFilter:    opts.LabelFilter,
+			FieldFilter:    opts.FieldFilter,
+			ListAccessible: &opts.ListAccessible,
+		}
+		return client.GetBackupsFromPolicy(ctx, organization, policy, &params)
+	})
+}
+
+func GetMatchingDatabases(ctx context.Context, client openapi.ClientInterface, organization, policy string, opts ListOptions) ([]ListItem, error) {
+	// List all databases selected by backup policy, which are returned as
+	// fully-qualified names
+	return listAll(ctx, "", opts, func(limit *int32, cursor *string) (*http.Response, error) {
+		params := openapi.GetMatchingDatabasesParams{
+			Limit:          limit,
+			Cursor:         cursor,
+			Expand:         opts.getExpand(),
+			LabelFilter:    opts.LabelFilter,
+			FieldFilter:    opts.FieldFilter,
+			ListAccessible: &opts.ListAccessible,
+		}
+		return client.GetMatchingDatabases(ctx, organization, policy, &params)
+	})
 }
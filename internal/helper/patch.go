@@ -0,0 +1,148 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package helper
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/nuodb/terraform-provider-nuodbaas/openapi"
+)
+
+// READ_ONLY_FIELDS are the top-level fields that are populated by the server
+// and should never be included in a patch.
+var READ_ONLY_FIELDS = []string{"resourceVersion", "status"}
+
+// CreatePatch returns the JSON Patch operations that transform the JSON
+// representation of current into the JSON representation of desired. Objects
+// are compared field by field, while all other values, including arrays, are
+// replaced if they differ. Read-only fields are ignored.
+func CreatePatch(current, desired any) ([]openapi.JsonPatchOperation, error) {
+	currentObj, err := toJsonObject(current)
+	if err != nil {
+		return nil, err
+	}
+	desiredObj, err := toJsonObject(desired)
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range READ_ONLY_FIELDS {
+		delete(currentObj, field)
+		delete(desiredObj, field)
+	}
+	var patch []openapi.JsonPatchOperation
+	err = appendPatch(&patch, "", currentObj, desiredObj)
+	return patch, err
+}
+
+func toJsonObject(v any) (map[string]any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	obj := make(map[string]any)
+	err = json.Unmarshal(b, &obj)
+	return obj, err
+}
+
+func appendPatch(patch *[]openapi.JsonPatchOperation, path string, current, desired map[string]any) error {
+	// Visit keys in sorted order so that the patch is deterministic
+	keys := make([]string, 0, len(current)+len(desired))
+	for key := range current {
+		keys = append(keys, key)
+	}
+	for key := range desired {
+		if _, ok := current[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		childPath := path + "/" + escapePathSegment(key)
+		currentValue, inCurrent := current[key]
+		desiredValue, inDesired := desired[key]
+		switch {
+		case !inDesired || desiredValue == nil:
+			if inCurrent && currentValue != nil {
+				*patch = append(*patch, openapi.JsonPatchOperation{
+					Op:   openapi.JsonPatchOperationOpRemove,
+					Path: childPath,
+				})
+			}
+		case !inCurrent || currentValue == nil:
+			op, err := newValueOperation(openapi.JsonPatchOperationOpAdd, childPath, desiredValue)
+			if err != nil {
+				return err
+			}
+			*patch = append(*patch, op)
+		default:
+			currentChild, currentIsObj := currentValue.(map[string]any)
+			desiredChild, desiredIsObj := desiredValue.(map[string]any)
+			if currentIsObj && desiredIsObj {
+				if err := appendPatch(patch, childPath, currentChild, desiredChild); err != nil {
+					return err
+				}
+			} else if !reflect.DeepEqual(currentValue, desiredValue) {
+				op, err := newValueOperation(openapi.JsonPatchOperationOpReplace, childPath, desiredValue)
+				if err != nil {
+					return err
+				}
+				*patch = append(*patch, op)
+			}
+		}
+	}
+	return nil
+}
+
+func newValueOperation(op openapi.JsonPatchOperationOp, path string, value any) (openapi.JsonPatchOperation, error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return openapi.JsonPatchOperation{}, err
+	}
+	var node openapi.JsonNode
+	err = node.UnmarshalJSON(b)
+	if err != nil {
+		return openapi.JsonPatchOperation{}, err
+	}
+	return openapi.JsonPatchOperation{Op: op, Path: path, Value: &node}, nil
+}
+
+// escapePathSegment escapes a field name for use in a JSON Pointer as
+// described in RFC 6901.
+func escapePathSegment(segment string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(segment, "~", "~0"), "/", "~1")
+}
+
+// UpdateWithPatch updates a resource by sending a JSON Patch that transforms
+// the prior state into the desired state using the supplied function, so that
+// fields that were changed by others since the prior state was read are left
+// unchanged. If there are no changes, no request is sent. Otherwise, the patch
+// starts with a `test` operation on the supplied resource version, which
+// should be read from the server immediately before, since the patch is
+// rejected with CONCURRENT_UPDATE if the resource was modified after it was
+// read.
+func UpdateWithPatch(prior, desired any, resourceVersion *string, fn func(patch []openapi.JsonPatchOperation) (*http.Response, error)) error {
+	patch, err := CreatePatch(prior, desired)
+	if err != nil || len(patch) == 0 {
+		return err
+	}
+	if resourceVersion != nil {
+		test, err := newValueOperation(openapi.JsonPatchOperationOpTest, "/resourceVersion", *resourceVersion)
+		if err != nil {
+			return err
+		}
+		patch = append([]openapi.JsonPatchOperation{test}, patch...)
+	}
+	resp, err := fn(patch)
+	if err != nil {
+		return err
+	}
+	// Decode the response and check that there is no error
+	return ParseResponse(resp, nil)
+}
@@ -0,0 +1,142 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package accesstoken
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/framework"
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/helper"
+	"github.com/nuodb/terraform-provider-nuodbaas/openapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ framework.EphemeralResourceState = &AccessTokenModel{}
+)
+
+type AccessTokenModel struct {
+	LimitAllow    []string                      `tfsdk:"limit_allow" hcl:"limit_allow" cty:"limit_allow"`
+	ExtraDeny     []string                      `tfsdk:"extra_deny" hcl:"extra_deny" cty:"extra_deny"`
+	ExpiresIn     *string                       `tfsdk:"expires_in" hcl:"expires_in" cty:"expires_in"`
+	ExpiresAtTime *string                       `tfsdk:"expires_at_time" hcl:"expires_at_time" cty:"expires_at_time"`
+	Token         string                        `tfsdk:"token" hcl:"token" cty:"token"`
+	AccessRule    *openapi.DbaasAccessRuleModel `tfsdk:"access_rule" hcl:"access_rule" cty:"access_rule"`
+}
+
+// GetAccessTokenEphemeralResourceSchema returns the schema for the access token
+// ephemeral resource. This has to be provided explicitly because the request
+// and response of the login operation are described by separate schemas in
+// the OpenAPI spec for the REST API.
+func GetAccessTokenEphemeralResourceSchema() *schema.Schema {
+	description := "Ephemeral resource for obtaining a short-lived access token from the DBaaS Control Plane, " +
+		"whose access can be limited to a subset of the access of the current user. The token is never stored in the Terraform plan or state."
+	return &schema.Schema{
+		Description:         description,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"limit_allow": schema.ListAttribute{
+				Description:         "The allow rule entries to use for the token, which cannot exceed the access of the current user",
+				MarkdownDescription: "The allow rule entries to use for the token, which cannot exceed the access of the current user",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"extra_deny": schema.ListAttribute{
+				Description:         "Extra deny rule entries to append to the access rule to further restrict access granted by the token",
+				MarkdownDescription: "Extra deny rule entries to append to the access rule to further restrict access granted by the token",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"expires_in": schema.StringAttribute{
+				Description:         "Requested duration in seconds, minutes, hours or days (s, m, h, d) after which the token should expire, e.g. `30m`",
+				MarkdownDescription: "Requested duration in seconds, minutes, hours or days (s, m, h, d) after which the token should expire, e.g. `30m`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile("^[0-9]+[smhd]$"), "must be a number followed by one of s, m, h, d"),
+					stringvalidator.ConflictsWith(path.MatchRoot("expires_at_time")),
+				},
+			},
+			"expires_at_time": schema.StringAttribute{
+				Description:         "The time at which the token expires. If specified, this is the requested expiration time in ISO-8601 format.",
+				MarkdownDescription: "The time at which the token expires. If specified, this is the requested expiration time in ISO-8601 format.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"token": schema.StringAttribute{
+				Description:         "The access token, which can be supplied to the `token` attribute of the provider configuration",
+				MarkdownDescription: "The access token, which can be supplied to the `token` attribute of the provider configuration",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"access_rule": schema.SingleNestedAttribute{
+				Description:         "The access rule granted by the token",
+				MarkdownDescription: "The access rule granted by the token",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"allow": schema.ListAttribute{
+						Description:         "List of access rule entries in the form `<verb>:<resource specifier>[:<SLA>]` that specify requests to allow",
+						MarkdownDescription: "List of access rule entries in the form `<verb>:<resource specifier>[:<SLA>]` that specify requests to allow",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+					"deny": schema.ListAttribute{
+						Description:         "List of access rule entries in the form `<verb>:<resource specifier>` that specify requests to deny",
+						MarkdownDescription: "List of access rule entries in the form `<verb>:<resource specifier>` that specify requests to deny",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (state *AccessTokenModel) Open(ctx context.Context, client openapi.ClientInterface) error {
+	request := openapi.LoginRequestModel{
+		ExpiresIn:     state.ExpiresIn,
+		ExpiresAtTime: state.ExpiresAtTime,
+	}
+	if state.LimitAllow != nil {
+		request.LimitAllow = &state.LimitAllow
+	}
+	if state.ExtraDeny != nil {
+		request.ExtraDeny = &state.ExtraDeny
+	}
+	resp, err := client.Login(ctx, request)
+	if err != nil {
+		return err
+	}
+	var response openapi.LoginResponseModel
+	err = helper.ParseResponse(resp, &response)
+	if err != nil {
+		return err
+	}
+	if response.Token != nil {
+		state.Token = *response.Token
+	}
+	state.ExpiresAtTime = response.ExpiresAtTime
+	state.AccessRule = response.AccessRule
+	return nil
+}
+
+func NewAccessTokenEphemeralResourceState() framework.EphemeralResourceState {
+	return &AccessTokenModel{}
+}
+
+func NewAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &framework.GenericEphemeralResource{
+		TypeName:        "access_token",
+		EphemeralSchema: GetAccessTokenEphemeralResourceSchema(),
+		Build:           NewAccessTokenEphemeralResourceState,
+	}
+}
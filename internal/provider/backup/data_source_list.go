@@ -19,7 +19,7 @@ import (
 )
 
 var (
-	_ framework.DataSourceState = &BackupsDataSourceModel{}
+	_ framework.ListDataSourceState = &BackupsDataSourceModel{}
 )
 
 type BackupFilterModel struct {
@@ -27,33 +27,47 @@ type BackupFilterModel struct {
 	Project      *string  `tfsdk:"project" hcl:"project" cty:"project"`
 	Database     *string  `tfsdk:"database" hcl:"database" cty:"database"`
 	Labels       []string `tfsdk:"labels" hcl:"labels" cty:"labels"`
+	Fields       []string `tfsdk:"fields" hcl:"fields" cty:"fields"`
 }
 
 type BackupNameModel struct {
-	Organization string `tfsdk:"organization" hcl:"organization" cty:"organization"`
-	Project      string `tfsdk:"project" hcl:"project" cty:"project"`
-	Database     string `tfsdk:"database" hcl:"database" cty:"database"`
-	Name         string `tfsdk:"name" hcl:"name" cty:"name"`
+	Organization string               `tfsdk:"organization" hcl:"organization" cty:"organization"`
+	Project      string               `tfsdk:"project" hcl:"project" cty:"project"`
+	Database     string               `tfsdk:"database" hcl:"database" cty:"database"`
+	Name         string               `tfsdk:"name" hcl:"name" cty:"name"`
+	Details      *openapi.BackupModel `tfsdk:"details" hcl:"details" cty:"details"`
 }
 
 type BackupsDataSourceModel struct {
-	Filter  *BackupFilterModel `tfsdk:"filter" hcl:"filter" cty:"filter"`
-	Backups []BackupNameModel  `tfsdk:"backups" hcl:"backups" cty:"backups"`
+	Filter     *BackupFilterModel `tfsdk:"filter" hcl:"filter" cty:"filter"`
+	MaxResults *int64             `tfsdk:"max_results" hcl:"max_results" cty:"max_results"`
+	Expand     *bool              `tfsdk:"expand" hcl:"expand" cty:"expand"`
+	Backups    []BackupNameModel  `tfsdk:"backups" hcl:"backups" cty:"backups"`
+
+	// pageSize is the maximum number of items to request per page
+	pageSize int32
 }
 
 // GetBackupsDataSourceSchema returns the schema for the backups (plural) data
 // source. This has to be provided explicitly because there is no schema in the
 // OpenAPI spec for the REST API that corresponds to it.
-func GetBackupsDataSourceSchema() *schema.Schema {
+func GetBackupsDataSourceSchema() (*schema.Schema, error) {
+	details, err := GetBackupDataSourceAttributes()
+	if err != nil {
+		return nil, err
+	}
 	sb := framework.NewSchemaBuilder().WithDescription("Data source for listing NuoDB backups created using the DBaaS Control Plane")
 	sb.WithDatabaseScopeFilters("backups")
-	sb.WithDatabaseScopeList("backup", "backups").WithNameAttribute("backup")
-	return sb.Build()
+	sb.WithDatabaseScopeList("backup", "backups").WithNameAttribute("backup").
+		WithDetailsAttribute("backup", details)
+	sb.WithMaxResultsAttribute("backups")
+	sb.WithExpandAttribute("backups")
+	return sb.Build(), nil
 }
 
 func (state *BackupsDataSourceModel) Read(ctx context.Context, client openapi.ClientInterface) error {
 	var organization, project, database string
-	var labelFilter *string
+	var labelFilter, fieldFilter *string
 	if state.Filter != nil {
 		if state.Filter.Organization != nil {
 			organization = *state.Filter.Organization
@@ -68,8 +82,19 @@ func (state *BackupsDataSourceModel) Read(ctx context.Context, client openapi.Cl
 			labelFilterStr := strings.Join(state.Filter.Labels, ",")
 			labelFilter = &labelFilterStr
 		}
+		if state.Filter.Fields != nil {
+			fieldFilterStr := strings.Join(state.Filter.Fields, ",")
+			fieldFilter = &fieldFilterStr
+		}
 	}
-	backups, err := helper.GetBackups(ctx, client, organization, project, database, labelFilter, true)
+	backups, err := helper.GetBackups(ctx, client, organization, project, database, helper.ListOptions{
+		LabelFilter:    labelFilter,
+		FieldFilter:    fieldFilter,
+		ListAccessible: true,
+		MaxResults:     state.MaxResults,
+		Expand:         state.Expand != nil && *state.Expand,
+		PageSize:       state.pageSize,
+	})
 	if err != nil {
 		return err
 	}
@@ -77,19 +102,31 @@ func (state *BackupsDataSourceModel) Read(ctx context.Context, client openapi.Cl
 	return err
 }
 
-func GetBackupDataSourceResponse(backups []string) ([]BackupNameModel, error) {
+// SetPageSize implements framework.ListDataSourceState.
+func (state *BackupsDataSourceModel) SetPageSize(pageSize int32) {
+	state.pageSize = pageSize
+}
+
+func GetBackupDataSourceResponse(backups []helper.ListItem) ([]BackupNameModel, error) {
 	var ret []BackupNameModel
 	for _, backup := range backups {
-		parts := strings.Split(backup, "/")
+		parts := strings.Split(backup.Name, "/")
 		if len(parts) != 4 {
-			return nil, fmt.Errorf("Unexpected format for backup name: %s", backup)
+			return nil, fmt.Errorf("Unexpected format for backup name: %s", backup.Name)
 		}
-		ret = append(ret, BackupNameModel{
+		model := BackupNameModel{
 			Organization: parts[0],
 			Project:      parts[1],
 			Database:     parts[2],
 			Name:         parts[3],
-		})
+		}
+		var details openapi.BackupModel
+		if ok, err := backup.DecodePayload(&details); err != nil {
+			return nil, err
+		} else if ok {
+			model.Details = &details
+		}
+		ret = append(ret, model)
 	}
 	return ret, nil
 }
@@ -100,8 +137,8 @@ func NewBackupsDataSourceState() framework.DataSourceState {
 
 func NewBackupsDataSource() datasource.DataSource {
 	return &framework.GenericDataSource{
-		TypeName:       "backups",
-		SchemaOverride: GetBackupsDataSourceSchema(),
-		Build:          NewBackupsDataSourceState,
+		TypeName:          "backups",
+		GetSchemaOverride: GetBackupsDataSourceSchema,
+		Build:             NewBackupsDataSourceState,
 	}
 }
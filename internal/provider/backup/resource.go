@@ -8,7 +8,7 @@ package backup
 import (
 	"context"
 	"fmt"
-	"strings"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -52,6 +52,11 @@ func (state *BackupResourceModel) CheckReady(ctx context.Context, client openapi
 }
 
 func (state *BackupResourceModel) Create(ctx context.Context, client openapi.ClientInterface) error {
+	// If name is not specified, create an on-demand backup and use the name
+	// generated by the server
+	if state.Name == "" {
+		return state.createOnDemand(ctx, client)
+	}
 	resp, err := client.CreateOrUpdateBackup(ctx, state.Organization, state.Project, state.Database, state.Name, openapi.BackupModel(*state))
 	if err != nil {
 		return err
@@ -59,6 +64,28 @@ func (state *BackupResourceModel) Create(ctx context.Context, client openapi.Cli
 	return helper.ParseResponse(resp, nil)
 }
 
+func (state *BackupResourceModel) createOnDemand(ctx context.Context, client openapi.ClientInterface) error {
+	if state.ImportSource != nil {
+		return fmt.Errorf("Backup name must be specified when importing an existing backup handle")
+	}
+	resp, err := client.CreateBackup(ctx, state.Organization, state.Project, state.Database, openapi.BackupCreateModel{
+		Organization: state.Organization,
+		Project:      state.Project,
+		Database:     state.Database,
+		Labels:       state.Labels,
+	})
+	if err != nil {
+		return err
+	}
+	var created openapi.BackupModel
+	err = helper.ParseResponse(resp, &created)
+	if err != nil {
+		return err
+	}
+	state.Name = created.Name
+	return nil
+}
+
 func (state *BackupResourceModel) Read(ctx context.Context, client openapi.ClientInterface) error {
 	resp, err := client.GetBackup(ctx, state.Organization, state.Project, state.Database, state.Name)
 	if err != nil {
@@ -69,38 +96,23 @@ func (state *BackupResourceModel) Read(ctx context.Context, client openapi.Clien
 }
 
 func (state *BackupResourceModel) Update(ctx context.Context, client openapi.ClientInterface, currentState framework.ResourceState) error {
-	// Fetch database and get resourceVersion
 	latest := &BackupResourceModel{
 		Organization: state.Organization,
 		Project:      state.Project,
 		Database:     state.Database,
 		Name:         state.Name,
 	}
-	err := latest.Read(ctx, client)
-	if err != nil {
-		return err
-	}
-	for {
-		state.ResourceVersion = latest.ResourceVersion
-		resp, err := client.CreateOrUpdateBackup(ctx, state.Organization, state.Project, state.Database, state.Name, openapi.BackupModel(*state))
+	return framework.RetryOnConcurrentUpdate(ctx, func() error {
+		// Fetch backup to get resourceVersion, and send only the fields
+		// that differ from the current state
+		err := latest.Read(ctx, client)
 		if err != nil {
 			return err
 		}
-		// Decode the response and check that there is no error
-		err = helper.ParseResponse(resp, nil)
-		if err == nil {
-			return nil
-		}
-		// If error is not retriable (code=CONCURRENT_UPDATE), fail fast
-		if apiError, ok := err.(*helper.ApiError); !ok || apiError.GetCode() != openapi.ErrorContentCodeCONCURRENTUPDATE {
-			return err
-		}
-		// Re-fetch database and get resourceVersion
-		err = latest.Read(ctx, client)
-		if err != nil {
-			return err
-		}
-	}
+		return helper.UpdateWithPatch(currentState, state, latest.ResourceVersion, func(patch []openapi.JsonPatchOperation) (*http.Response, error) {
+			return client.PatchBackupWithApplicationJSONPatchPlusJSONBody(ctx, state.Organization, state.Project, state.Database, state.Name, patch)
+		})
+	})
 }
 
 func (state *BackupResourceModel) Delete(ctx context.Context, client openapi.ClientInterface) error {
@@ -112,9 +124,9 @@ func (state *BackupResourceModel) Delete(ctx context.Context, client openapi.Cli
 }
 
 func (state *BackupResourceModel) SetId(id string) error {
-	pathParts := strings.Split(id, "/")
-	if len(pathParts) != 4 || pathParts[0] == "" || pathParts[1] == "" || pathParts[2] == "" || pathParts[3] == "" {
-		return fmt.Errorf("Expected an id with format \"organization/project/database/name\". Got: %s", id)
+	pathParts, err := framework.ParseId(id, "organization", "project", "database", "name")
+	if err != nil {
+		return err
 	}
 	state.Organization = pathParts[0]
 	state.Project = pathParts[1]
@@ -128,7 +140,11 @@ func (state *BackupResourceModel) GetEventPath() string {
 }
 
 func GetBackupResourceAttributes() (map[string]schema.Attribute, error) {
-	return framework.GetResourceAttributes("BackupModel")
+	return framework.GetResourceAttributes("BackupModel",
+		// Allow name to be omitted to create an on-demand backup with a server-generated name
+		framework.WithOptional("name"),
+		framework.WithDescription("name", "The name of the backup. If omitted, an on-demand backup is created and the name is generated by the server."),
+	)
 }
 
 func NewBackupResourceState() framework.ResourceState {
@@ -0,0 +1,169 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package backuppolicy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/framework"
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/helper"
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/provider/backup"
+	"github.com/nuodb/terraform-provider-nuodbaas/openapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var (
+	_ framework.ListDataSourceState = &BackupPolicyBackupsDataSourceModel{}
+)
+
+type BackupPolicyLabelFilterModel struct {
+	Labels []string `tfsdk:"labels" hcl:"labels" cty:"labels"`
+	Fields []string `tfsdk:"fields" hcl:"fields" cty:"fields"`
+}
+
+func (filter *BackupPolicyLabelFilterModel) getLabelFilter() *string {
+	if filter == nil || filter.Labels == nil {
+		return nil
+	}
+	labelFilter := strings.Join(filter.Labels, ",")
+	return &labelFilter
+}
+
+func (filter *BackupPolicyLabelFilterModel) getFieldFilter() *string {
+	if filter == nil || filter.Fields == nil {
+		return nil
+	}
+	fieldFilter := strings.Join(filter.Fields, ",")
+	return &fieldFilter
+}
+
+type BackupPolicyBackupModel struct {
+	Organization string               `tfsdk:"organization" hcl:"organization" cty:"organization"`
+	Project      string               `tfsdk:"project" hcl:"project" cty:"project"`
+	Database     string               `tfsdk:"database" hcl:"database" cty:"database"`
+	Name         string               `tfsdk:"name" hcl:"name" cty:"name"`
+	RetainedAs   []string             `tfsdk:"retained_as" hcl:"retained_as" cty:"retained_as"`
+	Details      *openapi.BackupModel `tfsdk:"details" hcl:"details" cty:"details"`
+}
+
+type BackupPolicyBackupsDataSourceModel struct {
+	Organization string                        `tfsdk:"organization" hcl:"organization" cty:"organization"`
+	Policy       string                        `tfsdk:"policy" hcl:"policy" cty:"policy"`
+	Filter       *BackupPolicyLabelFilterModel `tfsdk:"filter" hcl:"filter" cty:"filter"`
+	MaxResults   *int64                        `tfsdk:"max_results" hcl:"max_results" cty:"max_results"`
+	Expand       *bool                         `tfsdk:"expand" hcl:"expand" cty:"expand"`
+	Backups      []BackupPolicyBackupModel     `tfsdk:"backups" hcl:"backups" cty:"backups"`
+
+	// pageSize is the maximum number of items to request per page
+	pageSize int32
+}
+
+// GetBackupPolicyBackupsDataSourceSchema returns the schema for the data source
+// that lists the backups created by a backup policy. This has to be provided
+// explicitly because there is no schema in the OpenAPI spec for the REST API
+// that corresponds to it.
+func GetBackupPolicyBackupsDataSourceSchema() (*schema.Schema, error) {
+	details, err := backup.GetBackupDataSourceAttributes()
+	if err != nil {
+		return nil, err
+	}
+	sb := framework.NewSchemaBuilder().WithDescription("Data source for listing NuoDB backups created by a backup policy")
+	sb.WithRequiredStringAttribute("organization", "The organization the backup policy belongs to")
+	sb.WithRequiredStringAttribute("policy", "The name of the backup policy")
+	sb.WithNewNestedAttribute("filter", "Filters to apply to backups").
+		WithStringListAttribute("labels", framework.LABEL_FILTER_DESCRIPTION).
+		WithStringListAttribute("fields", framework.FIELD_FILTER_DESCRIPTION)
+	sb.WithDatabaseScopeList("backup", "backups").WithNameAttribute("backup").
+		WithComputedStringListAttribute("retained_as", "The retention cycles of the backup policy that the backup is retained as").
+		WithDetailsAttribute("backup", details)
+	sb.WithMaxResultsAttribute("backups")
+	sb.WithExpandAttribute("backups")
+	return sb.Build(), nil
+}
+
+func (state *BackupPolicyBackupsDataSourceModel) Read(ctx context.Context, client openapi.ClientInterface) error {
+	backups, err := helper.GetBackupsFromPolicy(ctx, client, state.Organization, state.Policy, helper.ListOptions{
+		LabelFilter:    state.Filter.getLabelFilter(),
+		FieldFilter:    state.Filter.getFieldFilter(),
+		ListAccessible: true,
+		MaxResults:     state.MaxResults,
+		Expand:         state.Expand != nil && *state.Expand,
+		PageSize:       state.pageSize,
+	})
+	if err != nil {
+		return err
+	}
+	state.Backups = nil
+	for _, item := range backups {
+		parts := strings.Split(item.Name, "/")
+		if len(parts) != 4 {
+			return fmt.Errorf("Unexpected format for backup name: %s", item.Name)
+		}
+		model := BackupPolicyBackupModel{
+			Organization: parts[0],
+			Project:      parts[1],
+			Database:     parts[2],
+			Name:         parts[3],
+		}
+		// Get the retention cycles from the backup status, which only
+		// requires an extra request if the payload was not expanded
+		var details openapi.BackupModel
+		if ok, err := item.DecodePayload(&details); err != nil {
+			return err
+		} else if ok {
+			model.Details = &details
+		} else if err = getBackup(ctx, client, model, &details); err != nil {
+			// Skip backups that were deleted after being listed, which can
+			// happen if they were pruned by the backup policy
+			if helper.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		model.RetainedAs = getRetainedAs(&details)
+		state.Backups = append(state.Backups, model)
+	}
+	return nil
+}
+
+// SetPageSize implements framework.ListDataSourceState.
+func (state *BackupPolicyBackupsDataSourceModel) SetPageSize(pageSize int32) {
+	state.pageSize = pageSize
+}
+
+func getBackup(ctx context.Context, client openapi.ClientInterface, model BackupPolicyBackupModel, backup *openapi.BackupModel) error {
+	resp, err := client.GetBackup(ctx, model.Organization, model.Project, model.Database, model.Name)
+	if err != nil {
+		return err
+	}
+	return helper.ParseResponse(resp, backup)
+}
+
+func getRetainedAs(backup *openapi.BackupModel) []string {
+	retainedAs := []string{}
+	if backup.Status != nil && backup.Status.RetainedAs != nil {
+		for _, cycle := range *backup.Status.RetainedAs {
+			retainedAs = append(retainedAs, string(cycle))
+		}
+	}
+	return retainedAs
+}
+
+func NewBackupPolicyBackupsDataSourceState() framework.DataSourceState {
+	return &BackupPolicyBackupsDataSourceModel{}
+}
+
+func NewBackupPolicyBackupsDataSource() datasource.DataSource {
+	return &framework.GenericDataSource{
+		TypeName:          "backuppolicy_backups",
+		GetSchemaOverride: GetBackupPolicyBackupsDataSourceSchema,
+		Build:             NewBackupPolicyBackupsDataSourceState,
+	}
+}
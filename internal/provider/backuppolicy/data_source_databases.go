@@ -0,0 +1,89 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package backuppolicy
+
+import (
+	"context"
+
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/framework"
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/helper"
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/provider/database"
+	"github.com/nuodb/terraform-provider-nuodbaas/openapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var (
+	_ framework.ListDataSourceState = &BackupPolicyDatabasesDataSourceModel{}
+)
+
+type BackupPolicyDatabasesDataSourceModel struct {
+	Organization string                        `tfsdk:"organization" hcl:"organization" cty:"organization"`
+	Policy       string                        `tfsdk:"policy" hcl:"policy" cty:"policy"`
+	Filter       *BackupPolicyLabelFilterModel `tfsdk:"filter" hcl:"filter" cty:"filter"`
+	MaxResults   *int64                        `tfsdk:"max_results" hcl:"max_results" cty:"max_results"`
+	Expand       *bool                         `tfsdk:"expand" hcl:"expand" cty:"expand"`
+	Databases    []database.DatabaseNameModel  `tfsdk:"databases" hcl:"databases" cty:"databases"`
+
+	// pageSize is the maximum number of items to request per page
+	pageSize int32
+}
+
+// GetBackupPolicyDatabasesDataSourceSchema returns the schema for the data
+// source that lists the databases selected by a backup policy. This has to be
+// provided explicitly because there is no schema in the OpenAPI spec for the
+// REST API that corresponds to it.
+func GetBackupPolicyDatabasesDataSourceSchema() (*schema.Schema, error) {
+	details, err := database.GetDatabaseDataSourceAttributes()
+	if err != nil {
+		return nil, err
+	}
+	sb := framework.NewSchemaBuilder().WithDescription("Data source for listing NuoDB databases that are selected by a backup policy")
+	sb.WithRequiredStringAttribute("organization", "The organization the backup policy belongs to")
+	sb.WithRequiredStringAttribute("policy", "The name of the backup policy")
+	sb.WithNewNestedAttribute("filter", "Filters to apply to databases").
+		WithStringListAttribute("labels", framework.LABEL_FILTER_DESCRIPTION).
+		WithStringListAttribute("fields", framework.FIELD_FILTER_DESCRIPTION)
+	sb.WithProjectScopeList("database", "databases").WithNameAttribute("database").
+		WithDetailsAttribute("database", details)
+	sb.WithMaxResultsAttribute("databases")
+	sb.WithExpandAttribute("databases")
+	return sb.Build(), nil
+}
+
+func (state *BackupPolicyDatabasesDataSourceModel) Read(ctx context.Context, client openapi.ClientInterface) error {
+	databases, err := helper.GetMatchingDatabases(ctx, client, state.Organization, state.Policy, helper.ListOptions{
+		LabelFilter:    state.Filter.getLabelFilter(),
+		FieldFilter:    state.Filter.getFieldFilter(),
+		ListAccessible: true,
+		MaxResults:     state.MaxResults,
+		Expand:         state.Expand != nil && *state.Expand,
+		PageSize:       state.pageSize,
+	})
+	if err != nil {
+		return err
+	}
+	state.Databases, err = database.GetDatabaseDataSourceResponse(databases)
+	return err
+}
+
+// SetPageSize implements framework.ListDataSourceState.
+func (state *BackupPolicyDatabasesDataSourceModel) SetPageSize(pageSize int32) {
+	state.pageSize = pageSize
+}
+
+func NewBackupPolicyDatabasesDataSourceState() framework.DataSourceState {
+	return &BackupPolicyDatabasesDataSourceModel{}
+}
+
+func NewBackupPolicyDatabasesDataSource() datasource.DataSource {
+	return &framework.GenericDataSource{
+		TypeName:          "backuppolicy_databases",
+		GetSchemaOverride: GetBackupPolicyDatabasesDataSourceSchema,
+		Build:             NewBackupPolicyDatabasesDataSourceState,
+	}
+}
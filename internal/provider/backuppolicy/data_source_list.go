@@ -19,38 +19,52 @@ import (
 )
 
 var (
-	_ framework.DataSourceState = &BackupPoliciesDataSourceModel{}
+	_ framework.ListDataSourceState = &BackupPoliciesDataSourceModel{}
 )
 
 type BackupPolicyFilterModel struct {
 	Organization *string  `tfsdk:"organization" hcl:"organization" cty:"organization"`
 	Labels       []string `tfsdk:"labels" hcl:"labels" cty:"labels"`
+	Fields       []string `tfsdk:"fields" hcl:"fields" cty:"fields"`
 }
 
 type BackupPolicyNameModel struct {
-	Organization string `tfsdk:"organization" hcl:"organization" cty:"organization"`
-	Name         string `tfsdk:"name" hcl:"name" cty:"name"`
+	Organization string                     `tfsdk:"organization" hcl:"organization" cty:"organization"`
+	Name         string                     `tfsdk:"name" hcl:"name" cty:"name"`
+	Details      *openapi.BackupPolicyModel `tfsdk:"details" hcl:"details" cty:"details"`
 }
 
 type BackupPoliciesDataSourceModel struct {
-	Filter   *BackupPolicyFilterModel `tfsdk:"filter" hcl:"filter" cty:"filter"`
-	Policies []BackupPolicyNameModel  `tfsdk:"policies" hcl:"policies" cty:"policies"`
+	Filter     *BackupPolicyFilterModel `tfsdk:"filter" hcl:"filter" cty:"filter"`
+	MaxResults *int64                   `tfsdk:"max_results" hcl:"max_results" cty:"max_results"`
+	Expand     *bool                    `tfsdk:"expand" hcl:"expand" cty:"expand"`
+	Policies   []BackupPolicyNameModel  `tfsdk:"policies" hcl:"policies" cty:"policies"`
+
+	// pageSize is the maximum number of items to request per page
+	pageSize int32
 }
 
 // GetBackupPoliciesDataSourceSchema returns the schema for the backuppolicies
 // (plural) data source. This has to be provided explicitly because there is no
 // schema in the OpenAPI spec for the REST API that corresponds to it.
-func GetBackupPoliciesDataSourceSchema() *schema.Schema {
+func GetBackupPoliciesDataSourceSchema() (*schema.Schema, error) {
+	details, err := GetBackupPolicyDataSourceAttributes()
+	if err != nil {
+		return nil, err
+	}
 	sb := framework.NewSchemaBuilder().WithDescription("Data source for listing NuoDB backup policies created using the DBaaS Control Plane")
 	sb.WithOrganizationScopeFilters("policies")
-	sb.WithOrganizationScopeList("policy", "policies").WithNameAttribute("policy")
-	return sb.Build()
+	sb.WithOrganizationScopeList("policy", "policies").WithNameAttribute("policy").
+		WithDetailsAttribute("backup policy", details)
+	sb.WithMaxResultsAttribute("backup policies")
+	sb.WithExpandAttribute("backup policies")
+	return sb.Build(), nil
 }
 
 // Read implements datasource.DataSource.
 func (state *BackupPoliciesDataSourceModel) Read(ctx context.Context, client openapi.ClientInterface) error {
 	var organization string
-	var labelFilter *string
+	var labelFilter, fieldFilter *string
 	if state.Filter != nil {
 		if state.Filter.Organization != nil {
 			organization = *state.Filter.Organization
@@ -59,8 +73,19 @@ func (state *BackupPoliciesDataSourceModel) Read(ctx context.Context, client ope
 			labelFilterStr := strings.Join(state.Filter.Labels, ",")
 			labelFilter = &labelFilterStr
 		}
+		if state.Filter.Fields != nil {
+			fieldFilterStr := strings.Join(state.Filter.Fields, ",")
+			fieldFilter = &fieldFilterStr
+		}
 	}
-	policies, err := helper.GetBackupPolicies(ctx, client, organization, labelFilter, true)
+	policies, err := helper.GetBackupPolicies(ctx, client, organization, helper.ListOptions{
+		LabelFilter:    labelFilter,
+		FieldFilter:    fieldFilter,
+		ListAccessible: true,
+		MaxResults:     state.MaxResults,
+		Expand:         state.Expand != nil && *state.Expand,
+		PageSize:       state.pageSize,
+	})
 	if err != nil {
 		return err
 	}
@@ -68,17 +93,29 @@ func (state *BackupPoliciesDataSourceModel) Read(ctx context.Context, client ope
 	return err
 }
 
-func GetBackupPoliciesDataSourceResponse(policies []string) ([]BackupPolicyNameModel, error) {
+// SetPageSize implements framework.ListDataSourceState.
+func (state *BackupPoliciesDataSourceModel) SetPageSize(pageSize int32) {
+	state.pageSize = pageSize
+}
+
+func GetBackupPoliciesDataSourceResponse(policies []helper.ListItem) ([]BackupPolicyNameModel, error) {
 	var ret []BackupPolicyNameModel
 	for _, policy := range policies {
-		parts := strings.Split(policy, "/")
+		parts := strings.Split(policy.Name, "/")
 		if len(parts) != 2 {
-			return nil, fmt.Errorf("Unexpected format for backup policy name: %s", policy)
+			return nil, fmt.Errorf("Unexpected format for backup policy name: %s", policy.Name)
 		}
-		ret = append(ret, BackupPolicyNameModel{
+		model := BackupPolicyNameModel{
 			Organization: parts[0],
 			Name:         parts[1],
-		})
+		}
+		var details openapi.BackupPolicyModel
+		if ok, err := policy.DecodePayload(&details); err != nil {
+			return nil, err
+		} else if ok {
+			model.Details = &details
+		}
+		ret = append(ret, model)
 	}
 	return ret, nil
 }
@@ -89,8 +126,8 @@ func NewBackupPoliciesDataSourceState() framework.DataSourceState {
 
 func NewBackupPoliciesDataSource() datasource.DataSource {
 	return &framework.GenericDataSource{
-		TypeName:       "backuppolicies",
-		SchemaOverride: GetBackupPoliciesDataSourceSchema(),
-		Build:          NewBackupPoliciesDataSourceState,
+		TypeName:          "backuppolicies",
+		GetSchemaOverride: GetBackupPoliciesDataSourceSchema,
+		Build:             NewBackupPoliciesDataSourceState,
 	}
 }
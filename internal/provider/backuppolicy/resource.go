@@ -8,7 +8,7 @@ package backuppolicy
 import (
 	"context"
 	"fmt"
-	"strings"
+	"net/http"
 
 	"github.com/nuodb/terraform-provider-nuodbaas/internal/framework"
 	"github.com/nuodb/terraform-provider-nuodbaas/internal/helper"
@@ -51,36 +51,21 @@ func (state *BackupPolicyResourceModel) Read(ctx context.Context, client openapi
 }
 
 func (state *BackupPolicyResourceModel) Update(ctx context.Context, client openapi.ClientInterface, currentState framework.ResourceState) error {
-	// Fetch backup policy and get resourceVersion
 	latest := &BackupPolicyResourceModel{
 		Organization: state.Organization,
 		Name:         state.Name,
 	}
-	err := latest.Read(ctx, client)
-	if err != nil {
-		return err
-	}
-	for {
-		state.ResourceVersion = latest.ResourceVersion
-		resp, err := client.CreateBackupPolicy(ctx, state.Organization, state.Name, openapi.BackupPolicyModel(*state))
-		if err != nil {
-			return err
-		}
-		// Decode the response and check that there is no error
-		err = helper.ParseResponse(resp, nil)
-		if err == nil {
-			return nil
-		}
-		// If error is not retriable (code=CONCURRENT_UPDATE), fail fast
-		if apiError, ok := err.(*helper.ApiError); !ok || apiError.GetCode() != openapi.ErrorContentCodeCONCURRENTUPDATE {
-			return err
-		}
-		// Re-fetch policy and get resourceVersion
-		err = latest.Read(ctx, client)
+	return framework.RetryOnConcurrentUpdate(ctx, func() error {
+		// Fetch backup policy to get resourceVersion, and send only the fields
+		// that differ from the current state
+		err := latest.Read(ctx, client)
 		if err != nil {
 			return err
 		}
-	}
+		return helper.UpdateWithPatch(currentState, state, latest.ResourceVersion, func(patch []openapi.JsonPatchOperation) (*http.Response, error) {
+			return client.PatchBackupPolicyWithApplicationJSONPatchPlusJSONBody(ctx, state.Organization, state.Name, patch)
+		})
+	})
 }
 
 func (state *BackupPolicyResourceModel) Delete(ctx context.Context, client openapi.ClientInterface) error {
@@ -92,9 +77,9 @@ func (state *BackupPolicyResourceModel) Delete(ctx context.Context, client opena
 }
 
 func (state *BackupPolicyResourceModel) SetId(id string) error {
-	pathParts := strings.Split(id, "/")
-	if len(pathParts) != 2 || pathParts[0] == "" || pathParts[1] == "" {
-		return fmt.Errorf("Expected an id with format \"organization/name\". Got: %s", id)
+	pathParts, err := framework.ParseId(id, "organization", "name")
+	if err != nil {
+		return err
 	}
 	state.Organization = pathParts[0]
 	state.Name = pathParts[1]
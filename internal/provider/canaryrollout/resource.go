@@ -0,0 +1,158 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package canaryrollout
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/framework"
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/helper"
+	"github.com/nuodb/terraform-provider-nuodbaas/openapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+var (
+	_ framework.ResourceState = &CanaryRolloutResourceModel{}
+)
+
+// The condition types are not enumerated by the REST API specification, which
+// only describes the generic structure of conditions, so the types that are
+// expected to be set by the canary rollout controller are pinned by the status
+// fixtures in TestCanaryRolloutConditions.
+const (
+	// READY_CONDITION is the condition type that is set to True once the
+	// change has been promoted to all target resources
+	READY_CONDITION = "Ready"
+	// ROLLED_BACK is the condition type that is set to True once the change
+	// has been rolled back due to a failed step
+	ROLLED_BACK = "RolledBack"
+)
+
+type CanaryRolloutResourceModel openapi.CanaryRolloutModel
+
+func (state *CanaryRolloutResourceModel) Reset() {
+	*state = CanaryRolloutResourceModel{}
+}
+
+func (state *CanaryRolloutResourceModel) CheckReady(ctx context.Context, client openapi.ClientInterface) error {
+	if state.Status == nil || state.Status.Conditions == nil {
+		return fmt.Errorf("Canary rollout %s has no status information", state.Name)
+	}
+	// Fail if rollout was rolled back, since it will not make any progress
+	// without its configuration being changed. Ignore conditions left over
+	// from a previous generation, which may have been rolled back due to the
+	// configuration that is being replaced.
+	for _, condition := range *state.Status.Conditions {
+		if condition.Type == ROLLED_BACK && condition.Status == openapi.CanaryrolloutstatusConditionsStatusTrue &&
+			state.isCurrent(condition) {
+			return framework.ResourceFailedPermanently("Canary rollout %s was rolled back: %s", state.Name, condition.Message)
+		}
+	}
+	// Check that rollout has completed and that the status is up-to-date
+	// with respect to the latest configuration
+	for _, condition := range *state.Status.Conditions {
+		if condition.Type != READY_CONDITION {
+			continue
+		}
+		if condition.Status == openapi.CanaryrolloutstatusConditionsStatusTrue && state.isCurrent(condition) {
+			return nil
+		}
+		return fmt.Errorf("Canary rollout %s has not completed: step=%s, message=%s",
+			state.Name, state.getCurrentStep(), condition.Message)
+	}
+	return fmt.Errorf("Canary rollout %s has not completed: step=%s", state.Name, state.getCurrentStep())
+}
+
+// isCurrent returns whether the condition was set based on the generation of
+// the rollout observed by the controller, or if either generation is unknown.
+func (state *CanaryRolloutResourceModel) isCurrent(condition openapi.CanaryrolloutstatusConditions) bool {
+	return condition.ObservedGeneration == nil || state.Status.ObservedGeneration == nil ||
+		*condition.ObservedGeneration == *state.Status.ObservedGeneration
+}
+
+func (state *CanaryRolloutResourceModel) getCurrentStep() string {
+	if state.Status == nil || state.Status.CurrentStepIndex == nil {
+		return "unknown"
+	}
+	return fmt.Sprint(*state.Status.CurrentStepIndex)
+}
+
+func (state *CanaryRolloutResourceModel) Create(ctx context.Context, client openapi.ClientInterface) error {
+	resp, err := client.CreateCanaryRollout(ctx, state.Name, nil, openapi.CanaryRolloutModel(*state))
+	if err != nil {
+		return err
+	}
+	return helper.ParseResponse(resp, nil)
+}
+
+func (state *CanaryRolloutResourceModel) Read(ctx context.Context, client openapi.ClientInterface) error {
+	resp, err := client.GetCanaryRollout(ctx, state.Name)
+	if err != nil {
+		return err
+	}
+	state.Reset()
+	return helper.ParseResponse(resp, state)
+}
+
+func (state *CanaryRolloutResourceModel) Update(ctx context.Context, client openapi.ClientInterface, currentState framework.ResourceState) error {
+	latest := &CanaryRolloutResourceModel{Name: state.Name}
+	return framework.RetryOnConcurrentUpdate(ctx, func() error {
+		// Fetch canary rollout and get resourceVersion
+		err := latest.Read(ctx, client)
+		if err != nil {
+			return err
+		}
+		state.ResourceVersion = latest.ResourceVersion
+		resp, err := client.CreateCanaryRollout(ctx, state.Name, nil, openapi.CanaryRolloutModel(*state))
+		if err != nil {
+			return err
+		}
+		// Decode the response and check that there is no error
+		return helper.ParseResponse(resp, nil)
+	})
+}
+
+func (state *CanaryRolloutResourceModel) Delete(ctx context.Context, client openapi.ClientInterface) error {
+	resp, err := client.DeleteCanaryRollout(ctx, state.Name, nil)
+	if err != nil {
+		return err
+	}
+	return helper.ParseResponse(resp, nil)
+}
+
+func (state *CanaryRolloutResourceModel) SetId(id string) error {
+	pathParts, err := framework.ParseId(id, "name")
+	if err != nil {
+		return err
+	}
+	state.Name = pathParts[0]
+	return nil
+}
+
+func (state *CanaryRolloutResourceModel) GetEventPath() string {
+	// Event streams are not available for cluster-scoped resources
+	return ""
+}
+
+func GetCanaryRolloutResourceAttributes() (map[string]schema.Attribute, error) {
+	return framework.GetResourceAttributes("CanaryRolloutModel")
+}
+
+func NewCanaryRolloutResourceState() framework.ResourceState {
+	return &CanaryRolloutResourceModel{}
+}
+
+func NewCanaryRolloutResource() resource.Resource {
+	return &framework.GenericResource{
+		TypeName:              "canary_rollout",
+		Description:           "Resource for managing canary rollouts in the DBaaS Control Plane cluster",
+		GetResourceAttributes: GetCanaryRolloutResourceAttributes,
+		Build:                 NewCanaryRolloutResourceState,
+	}
+}
@@ -0,0 +1,105 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package canaryrollout
+
+import (
+	"context"
+
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/framework"
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/helper"
+	"github.com/nuodb/terraform-provider-nuodbaas/openapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+var (
+	_ framework.ResourceState = &CanaryRolloutTemplateResourceModel{}
+)
+
+type CanaryRolloutTemplateResourceModel openapi.CanaryRolloutTemplateModel
+
+func (state *CanaryRolloutTemplateResourceModel) Reset() {
+	*state = CanaryRolloutTemplateResourceModel{}
+}
+
+func (state *CanaryRolloutTemplateResourceModel) CheckReady(ctx context.Context, client openapi.ClientInterface) error {
+	return nil
+}
+
+func (state *CanaryRolloutTemplateResourceModel) Create(ctx context.Context, client openapi.ClientInterface) error {
+	resp, err := client.CreateCanaryRolloutTemplate(ctx, state.Name, nil, openapi.CanaryRolloutTemplateModel(*state))
+	if err != nil {
+		return err
+	}
+	return helper.ParseResponse(resp, nil)
+}
+
+func (state *CanaryRolloutTemplateResourceModel) Read(ctx context.Context, client openapi.ClientInterface) error {
+	resp, err := client.GetCanaryRolloutTemplate(ctx, state.Name)
+	if err != nil {
+		return err
+	}
+	state.Reset()
+	return helper.ParseResponse(resp, state)
+}
+
+func (state *CanaryRolloutTemplateResourceModel) Update(ctx context.Context, client openapi.ClientInterface, currentState framework.ResourceState) error {
+	latest := &CanaryRolloutTemplateResourceModel{Name: state.Name}
+	return framework.RetryOnConcurrentUpdate(ctx, func() error {
+		// Fetch canary rollout template and get resourceVersion
+		err := latest.Read(ctx, client)
+		if err != nil {
+			return err
+		}
+		state.ResourceVersion = latest.ResourceVersion
+		resp, err := client.CreateCanaryRolloutTemplate(ctx, state.Name, nil, openapi.CanaryRolloutTemplateModel(*state))
+		if err != nil {
+			return err
+		}
+		// Decode the response and check that there is no error
+		return helper.ParseResponse(resp, nil)
+	})
+}
+
+func (state *CanaryRolloutTemplateResourceModel) Delete(ctx context.Context, client openapi.ClientInterface) error {
+	resp, err := client.DeleteCanaryRolloutTemplate(ctx, state.Name, nil)
+	if err != nil {
+		return err
+	}
+	return helper.ParseResponse(resp, nil)
+}
+
+func (state *CanaryRolloutTemplateResourceModel) SetId(id string) error {
+	pathParts, err := framework.ParseId(id, "name")
+	if err != nil {
+		return err
+	}
+	state.Name = pathParts[0]
+	return nil
+}
+
+func (state *CanaryRolloutTemplateResourceModel) GetEventPath() string {
+	// Event streams are not available for cluster-scoped resources
+	return ""
+}
+
+func GetCanaryRolloutTemplateResourceAttributes() (map[string]schema.Attribute, error) {
+	return framework.GetResourceAttributes("CanaryRolloutTemplateModel")
+}
+
+func NewCanaryRolloutTemplateResourceState() framework.ResourceState {
+	return &CanaryRolloutTemplateResourceModel{}
+}
+
+func NewCanaryRolloutTemplateResource() resource.Resource {
+	return &framework.GenericResource{
+		TypeName:              "canary_rollout_template",
+		Description:           "Resource for managing canary rollout templates in the DBaaS Control Plane cluster",
+		GetResourceAttributes: GetCanaryRolloutTemplateResourceAttributes,
+		Build:                 NewCanaryRolloutTemplateResourceState,
+	}
+}
@@ -19,39 +19,53 @@ import (
 )
 
 var (
-	_ framework.DataSourceState = &DatabasesDataSourceModel{}
+	_ framework.ListDataSourceState = &DatabasesDataSourceModel{}
 )
 
 type DatabaseFilterModel struct {
 	Organization *string  `tfsdk:"organization" hcl:"organization" cty:"organization"`
 	Project      *string  `tfsdk:"project" hcl:"project" cty:"project"`
 	Labels       []string `tfsdk:"labels" hcl:"labels" cty:"labels"`
+	Fields       []string `tfsdk:"fields" hcl:"fields" cty:"fields"`
 }
 
 type DatabaseNameModel struct {
-	Organization string `tfsdk:"organization" hcl:"organization" cty:"organization"`
-	Project      string `tfsdk:"project" hcl:"project" cty:"project"`
-	Name         string `tfsdk:"name" hcl:"name" cty:"name"`
+	Organization string                 `tfsdk:"organization" hcl:"organization" cty:"organization"`
+	Project      string                 `tfsdk:"project" hcl:"project" cty:"project"`
+	Name         string                 `tfsdk:"name" hcl:"name" cty:"name"`
+	Details      *openapi.DatabaseModel `tfsdk:"details" hcl:"details" cty:"details"`
 }
 
 type DatabasesDataSourceModel struct {
-	Filter    *DatabaseFilterModel `tfsdk:"filter" hcl:"filter" cty:"filter"`
-	Databases []DatabaseNameModel  `tfsdk:"databases" hcl:"databases" cty:"databases"`
+	Filter     *DatabaseFilterModel `tfsdk:"filter" hcl:"filter" cty:"filter"`
+	MaxResults *int64               `tfsdk:"max_results" hcl:"max_results" cty:"max_results"`
+	Expand     *bool                `tfsdk:"expand" hcl:"expand" cty:"expand"`
+	Databases  []DatabaseNameModel  `tfsdk:"databases" hcl:"databases" cty:"databases"`
+
+	// pageSize is the maximum number of items to request per page
+	pageSize int32
 }
 
 // GetDatabasesDataSourceSchema returns the schema for the databases (plural)
 // data source. This has to be provided explicitly because there is no schema in
 // the OpenAPI spec for the REST API that corresponds to it.
-func GetDatabasesDataSourceSchema() *schema.Schema {
+func GetDatabasesDataSourceSchema() (*schema.Schema, error) {
+	details, err := GetDatabaseDataSourceAttributes()
+	if err != nil {
+		return nil, err
+	}
 	sb := framework.NewSchemaBuilder().WithDescription("Data source for listing NuoDB databases created using the DBaaS Control Plane")
 	sb.WithProjectScopeFilters("databases")
-	sb.WithProjectScopeList("database", "databases").WithNameAttribute("database")
-	return sb.Build()
+	sb.WithProjectScopeList("database", "databases").WithNameAttribute("database").
+		WithDetailsAttribute("database", details)
+	sb.WithMaxResultsAttribute("databases")
+	sb.WithExpandAttribute("databases")
+	return sb.Build(), nil
 }
 
 func (state *DatabasesDataSourceModel) Read(ctx context.Context, client openapi.ClientInterface) error {
 	var organization, project string
-	var labelFilter *string
+	var labelFilter, fieldFilter *string
 	if state.Filter != nil {
 		if state.Filter.Organization != nil {
 			organization = *state.Filter.Organization
@@ -63,8 +77,19 @@ func (state *DatabasesDataSourceModel) Read(ctx context.Context, client openapi.
 			labelFilterStr := strings.Join(state.Filter.Labels, ",")
 			labelFilter = &labelFilterStr
 		}
+		if state.Filter.Fields != nil {
+			fieldFilterStr := strings.Join(state.Filter.Fields, ",")
+			fieldFilter = &fieldFilterStr
+		}
 	}
-	databases, err := helper.GetDatabases(ctx, client, organization, project, labelFilter, true)
+	databases, err := helper.GetDatabases(ctx, client, organization, project, helper.ListOptions{
+		LabelFilter:    labelFilter,
+		FieldFilter:    fieldFilter,
+		ListAccessible: true,
+		MaxResults:     state.MaxResults,
+		Expand:         state.Expand != nil && *state.Expand,
+		PageSize:       state.pageSize,
+	})
 	if err != nil {
 		return err
 	}
@@ -72,18 +97,30 @@ func (state *DatabasesDataSourceModel) Read(ctx context.Context, client openapi.
 	return err
 }
 
-func GetDatabaseDataSourceResponse(databases []string) ([]DatabaseNameModel, error) {
+// SetPageSize implements framework.ListDataSourceState.
+func (state *DatabasesDataSourceModel) SetPageSize(pageSize int32) {
+	state.pageSize = pageSize
+}
+
+func GetDatabaseDataSourceResponse(databases []helper.ListItem) ([]DatabaseNameModel, error) {
 	var ret []DatabaseNameModel
 	for _, db := range databases {
-		parts := strings.Split(db, "/")
+		parts := strings.Split(db.Name, "/")
 		if len(parts) != 3 {
-			return nil, fmt.Errorf("Unexpected format for database name: %s", db)
+			return nil, fmt.Errorf("Unexpected format for database name: %s", db.Name)
 		}
-		ret = append(ret, DatabaseNameModel{
+		model := DatabaseNameModel{
 			Organization: parts[0],
 			Project:      parts[1],
 			Name:         parts[2],
-		})
+		}
+		var details openapi.DatabaseModel
+		if ok, err := db.DecodePayload(&details); err != nil {
+			return nil, err
+		} else if ok {
+			model.Details = &details
+		}
+		ret = append(ret, model)
 	}
 	return ret, nil
 }
@@ -94,8 +131,8 @@ func NewDatabasesDataSourceState() framework.DataSourceState {
 
 func NewDatabasesDataSource() datasource.DataSource {
 	return &framework.GenericDataSource{
-		TypeName:       "databases",
-		SchemaOverride: GetDatabasesDataSourceSchema(),
-		Build:          NewDatabasesDataSourceState,
+		TypeName:          "databases",
+		GetSchemaOverride: GetDatabasesDataSourceSchema,
+		Build:             NewDatabasesDataSourceState,
 	}
 }
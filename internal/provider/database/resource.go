@@ -10,7 +10,6 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -150,43 +149,28 @@ func (state *DatabaseResourceModel) Update(ctx context.Context, client openapi.C
 			return err
 		}
 	}
-	// Fetch database and get resourceVersion
+	// Send only the fields that differ from the current state of the
+	// database, excluding the DBA password, which cannot be updated using a
+	// patch
+	prior := *currentDatabase
+	prior.DbaPassword = nil
+	desired := *state
+	desired.DbaPassword = nil
 	latest := &DatabaseResourceModel{
 		Organization: state.Organization,
 		Project:      state.Project,
 		Name:         state.Name,
 	}
-	err := latest.Read(ctx, client)
-	if err != nil {
-		return err
-	}
-	// Stash DBA password and set it to null in request, since PUT requests
-	// do not accept it
-	dbaPassword := state.DbaPassword
-	state.DbaPassword = nil
-	for {
-		state.ResourceVersion = latest.ResourceVersion
-		resp, err := client.CreateDatabase(ctx, state.Organization, state.Project, state.Name, openapi.DatabaseCreateUpdateModel(*state))
+	return framework.RetryOnConcurrentUpdate(ctx, func() error {
+		// Fetch database to get resourceVersion
+		err := latest.Read(ctx, client)
 		if err != nil {
 			return err
 		}
-		// Decode the response and check that there is no error
-		err = helper.ParseResponse(resp, nil)
-		if err == nil {
-			// Add back DBA password so that it is preserved in state
-			state.DbaPassword = dbaPassword
-			return nil
-		}
-		// If error is not retriable (code=CONCURRENT_UPDATE), fail fast
-		if apiError, ok := err.(*helper.ApiError); !ok || apiError.GetCode() != openapi.ErrorContentCodeCONCURRENTUPDATE {
-			return err
-		}
-		// Re-fetch database and get resourceVersion
-		err = latest.Read(ctx, client)
-		if err != nil {
-			return err
-		}
-	}
+		return helper.UpdateWithPatch(&prior, &desired, latest.ResourceVersion, func(patch []openapi.JsonPatchOperation) (*http.Response, error) {
+			return client.PatchDatabaseWithApplicationJSONPatchPlusJSONBody(ctx, state.Organization, state.Project, state.Name, patch)
+		})
+	})
 }
 
 func (state *DatabaseResourceModel) Delete(ctx context.Context, client openapi.ClientInterface) error {
@@ -198,9 +182,9 @@ func (state *DatabaseResourceModel) Delete(ctx context.Context, client openapi.C
 }
 
 func (state *DatabaseResourceModel) SetId(id string) error {
-	pathParts := strings.Split(id, "/")
-	if len(pathParts) != 3 || pathParts[0] == "" || pathParts[1] == "" || pathParts[2] == "" {
-		return fmt.Errorf("Expected an id with format \"organization/project/name\". Got: %s", id)
+	pathParts, err := framework.ParseId(id, "organization", "project", "name")
+	if err != nil {
+		return err
 	}
 	state.Organization = pathParts[0]
 	state.Project = pathParts[1]
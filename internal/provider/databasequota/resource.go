@@ -0,0 +1,182 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package databasequota
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/framework"
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/helper"
+	"github.com/nuodb/terraform-provider-nuodbaas/openapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+var (
+	_ framework.ResourceState = &DatabaseQuotaResourceModel{}
+)
+
+// updatedGenerations records, by name, the generation that the quota
+// controller had observed when the spec of a database quota was last updated.
+// The model does not expose the generation of the database quota, so its
+// status is only considered current once the controller has observed a newer
+// generation than the one recorded here.
+var (
+	updatedGenerationsLock sync.Mutex
+	updatedGenerations     = make(map[string]int64)
+)
+
+type DatabaseQuotaResourceModel openapi.DatabaseQuotaModel
+
+func (state *DatabaseQuotaResourceModel) Reset() {
+	*state = DatabaseQuotaResourceModel{}
+}
+
+func (state *DatabaseQuotaResourceModel) CheckReady(ctx context.Context, client openapi.ClientInterface) error {
+	if state.Status == nil || state.Status.ObservedGeneration == nil {
+		return fmt.Errorf("Database quota %s has not been observed by the quota controller", state.Name)
+	}
+	// Check that status is up-to-date with respect to the latest spec, since
+	// the status of a previous generation may not reflect the current limits
+	if !state.isCurrent() {
+		return fmt.Errorf("Database quota %s has not been observed by the quota controller since it was updated: observedGeneration=%d",
+			state.Name, *state.Status.ObservedGeneration)
+	}
+	// The quota controller records the objects that the quota was enforced
+	// on, and clears them after a successful reconciliation
+	if state.Status.LastEnforced != nil && len(*state.Status.LastEnforced) != 0 {
+		var objects []string
+		for _, enforced := range *state.Status.LastEnforced {
+			objects = append(objects, fmt.Sprintf("%s %s", enforced.ObjectRef.Kind, enforced.ObjectRef.Name))
+		}
+		return fmt.Errorf("Database quota %s is being enforced on: %s", state.Name, strings.Join(objects, ", "))
+	}
+	return nil
+}
+
+// isCurrent returns whether the quota controller has observed the spec of the
+// database quota since it was last updated by the provider.
+func (state *DatabaseQuotaResourceModel) isCurrent() bool {
+	updatedGenerationsLock.Lock()
+	defer updatedGenerationsLock.Unlock()
+	generation, ok := updatedGenerations[state.Name]
+	if !ok {
+		return true
+	}
+	if *state.Status.ObservedGeneration <= generation {
+		return false
+	}
+	delete(updatedGenerations, state.Name)
+	return true
+}
+
+// setUpdatedGeneration records the generation observed by the quota
+// controller before the spec of the database quota was updated, or removes it
+// if observedGeneration is nil.
+func setUpdatedGeneration(name string, observedGeneration *int64) {
+	updatedGenerationsLock.Lock()
+	defer updatedGenerationsLock.Unlock()
+	if observedGeneration == nil {
+		delete(updatedGenerations, name)
+	} else {
+		updatedGenerations[name] = *observedGeneration
+	}
+}
+
+func (state *DatabaseQuotaResourceModel) Create(ctx context.Context, client openapi.ClientInterface) error {
+	setUpdatedGeneration(state.Name, nil)
+	resp, err := client.CreateDatabaseQuota(ctx, state.Name, nil, openapi.DatabaseQuotaModel(*state))
+	if err != nil {
+		return err
+	}
+	return helper.ParseResponse(resp, nil)
+}
+
+func (state *DatabaseQuotaResourceModel) Read(ctx context.Context, client openapi.ClientInterface) error {
+	resp, err := client.GetDatabaseQuota(ctx, state.Name)
+	if err != nil {
+		return err
+	}
+	state.Reset()
+	return helper.ParseResponse(resp, state)
+}
+
+func (state *DatabaseQuotaResourceModel) Update(ctx context.Context, client openapi.ClientInterface, currentState framework.ResourceState) error {
+	currentQuota, _ := currentState.(*DatabaseQuotaResourceModel)
+	specChanged := currentQuota == nil || !reflect.DeepEqual(state.Spec, currentQuota.Spec)
+	latest := &DatabaseQuotaResourceModel{Name: state.Name}
+	return framework.RetryOnConcurrentUpdate(ctx, func() error {
+		// Fetch database quota and get resourceVersion
+		err := latest.Read(ctx, client)
+		if err != nil {
+			return err
+		}
+		state.ResourceVersion = latest.ResourceVersion
+		resp, err := client.CreateDatabaseQuota(ctx, state.Name, nil, openapi.DatabaseQuotaModel(*state))
+		if err != nil {
+			return err
+		}
+		// Decode the response and check that there is no error
+		err = helper.ParseResponse(resp, nil)
+		if err != nil {
+			return err
+		}
+		// If the spec was changed, record the generation that the quota
+		// controller had observed before the update, so that readiness is
+		// not reported based on the status of that generation
+		var observedGeneration *int64
+		if specChanged && latest.Status != nil {
+			observedGeneration = latest.Status.ObservedGeneration
+		}
+		setUpdatedGeneration(state.Name, observedGeneration)
+		return nil
+	})
+}
+
+func (state *DatabaseQuotaResourceModel) Delete(ctx context.Context, client openapi.ClientInterface) error {
+	setUpdatedGeneration(state.Name, nil)
+	resp, err := client.DeleteDatabaseQuota(ctx, state.Name, nil)
+	if err != nil {
+		return err
+	}
+	return helper.ParseResponse(resp, nil)
+}
+
+func (state *DatabaseQuotaResourceModel) SetId(id string) error {
+	pathParts, err := framework.ParseId(id, "name")
+	if err != nil {
+		return err
+	}
+	state.Name = pathParts[0]
+	return nil
+}
+
+func (state *DatabaseQuotaResourceModel) GetEventPath() string {
+	// Event streams are not available for cluster-scoped resources
+	return ""
+}
+
+func GetDatabaseQuotaResourceAttributes() (map[string]schema.Attribute, error) {
+	return framework.GetResourceAttributes("DatabaseQuotaModel")
+}
+
+func NewDatabaseQuotaResourceState() framework.ResourceState {
+	return &DatabaseQuotaResourceModel{}
+}
+
+func NewDatabaseQuotaResource() resource.Resource {
+	return &framework.GenericResource{
+		TypeName:              "database_quota",
+		Description:           "Resource for managing database quotas in the DBaaS Control Plane cluster",
+		GetResourceAttributes: GetDatabaseQuotaResourceAttributes,
+		Build:                 NewDatabaseQuotaResourceState,
+	}
+}
@@ -0,0 +1,105 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package helmfeature
+
+import (
+	"context"
+
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/framework"
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/helper"
+	"github.com/nuodb/terraform-provider-nuodbaas/openapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+var (
+	_ framework.ResourceState = &HelmFeatureResourceModel{}
+)
+
+type HelmFeatureResourceModel openapi.HelmFeatureModel
+
+func (state *HelmFeatureResourceModel) Reset() {
+	*state = HelmFeatureResourceModel{}
+}
+
+func (state *HelmFeatureResourceModel) CheckReady(ctx context.Context, client openapi.ClientInterface) error {
+	return nil
+}
+
+func (state *HelmFeatureResourceModel) Create(ctx context.Context, client openapi.ClientInterface) error {
+	resp, err := client.CreateHelmFeature(ctx, state.Name, nil, openapi.HelmFeatureModel(*state))
+	if err != nil {
+		return err
+	}
+	return helper.ParseResponse(resp, nil)
+}
+
+func (state *HelmFeatureResourceModel) Read(ctx context.Context, client openapi.ClientInterface) error {
+	resp, err := client.GetHelmFeature(ctx, state.Name)
+	if err != nil {
+		return err
+	}
+	state.Reset()
+	return helper.ParseResponse(resp, state)
+}
+
+func (state *HelmFeatureResourceModel) Update(ctx context.Context, client openapi.ClientInterface, currentState framework.ResourceState) error {
+	latest := &HelmFeatureResourceModel{Name: state.Name}
+	return framework.RetryOnConcurrentUpdate(ctx, func() error {
+		// Fetch Helm feature and get resourceVersion
+		err := latest.Read(ctx, client)
+		if err != nil {
+			return err
+		}
+		state.ResourceVersion = latest.ResourceVersion
+		resp, err := client.CreateHelmFeature(ctx, state.Name, nil, openapi.HelmFeatureModel(*state))
+		if err != nil {
+			return err
+		}
+		// Decode the response and check that there is no error
+		return helper.ParseResponse(resp, nil)
+	})
+}
+
+func (state *HelmFeatureResourceModel) Delete(ctx context.Context, client openapi.ClientInterface) error {
+	resp, err := client.DeleteHelmFeature(ctx, state.Name, nil)
+	if err != nil {
+		return err
+	}
+	return helper.ParseResponse(resp, nil)
+}
+
+func (state *HelmFeatureResourceModel) SetId(id string) error {
+	pathParts, err := framework.ParseId(id, "name")
+	if err != nil {
+		return err
+	}
+	state.Name = pathParts[0]
+	return nil
+}
+
+func (state *HelmFeatureResourceModel) GetEventPath() string {
+	// Event streams are not available for cluster-scoped resources
+	return ""
+}
+
+func GetHelmFeatureResourceAttributes() (map[string]schema.Attribute, error) {
+	return framework.GetResourceAttributes("HelmFeatureModel")
+}
+
+func NewHelmFeatureResourceState() framework.ResourceState {
+	return &HelmFeatureResourceModel{}
+}
+
+func NewHelmFeatureResource() resource.Resource {
+	return &framework.GenericResource{
+		TypeName:              "helm_feature",
+		Description:           "Resource for managing Helm features in the DBaaS Control Plane cluster",
+		GetResourceAttributes: GetHelmFeatureResourceAttributes,
+		Build:                 NewHelmFeatureResourceState,
+	}
+}
@@ -19,38 +19,52 @@ import (
 )
 
 var (
-	_ framework.DataSourceState = &ProjectsDataSourceModel{}
+	_ framework.ListDataSourceState = &ProjectsDataSourceModel{}
 )
 
 type ProjectFilterModel struct {
 	Organization *string  `tfsdk:"organization" hcl:"organization" cty:"organization"`
 	Labels       []string `tfsdk:"labels" hcl:"labels" cty:"labels"`
+	Fields       []string `tfsdk:"fields" hcl:"fields" cty:"fields"`
 }
 
 type ProjectNameModel struct {
-	Organization string `tfsdk:"organization" hcl:"organization" cty:"organization"`
-	Name         string `tfsdk:"name" hcl:"name" cty:"name"`
+	Organization string                `tfsdk:"organization" hcl:"organization" cty:"organization"`
+	Name         string                `tfsdk:"name" hcl:"name" cty:"name"`
+	Details      *openapi.ProjectModel `tfsdk:"details" hcl:"details" cty:"details"`
 }
 
 type ProjectsDataSourceModel struct {
-	Filter   *ProjectFilterModel `tfsdk:"filter" hcl:"filter" cty:"filter"`
-	Projects []ProjectNameModel  `tfsdk:"projects" hcl:"projects" cty:"projects"`
+	Filter     *ProjectFilterModel `tfsdk:"filter" hcl:"filter" cty:"filter"`
+	MaxResults *int64              `tfsdk:"max_results" hcl:"max_results" cty:"max_results"`
+	Expand     *bool               `tfsdk:"expand" hcl:"expand" cty:"expand"`
+	Projects   []ProjectNameModel  `tfsdk:"projects" hcl:"projects" cty:"projects"`
+
+	// pageSize is the maximum number of items to request per page
+	pageSize int32
 }
 
 // GetProjectsDataSourceSchema returns the schema for the projects (plural) data
 // source. This has to be provided explicitly because there is no schema in the
 // OpenAPI spec for the REST API that corresponds to it.
-func GetProjectsDataSourceSchema() *schema.Schema {
+func GetProjectsDataSourceSchema() (*schema.Schema, error) {
+	details, err := GetProjectDataSourceAttributes()
+	if err != nil {
+		return nil, err
+	}
 	sb := framework.NewSchemaBuilder().WithDescription("Data source for listing NuoDB projects created using the DBaaS Control Plane")
 	sb.WithOrganizationScopeFilters("projects")
-	sb.WithOrganizationScopeList("project", "projects").WithNameAttribute("project")
-	return sb.Build()
+	sb.WithOrganizationScopeList("project", "projects").WithNameAttribute("project").
+		WithDetailsAttribute("project", details)
+	sb.WithMaxResultsAttribute("projects")
+	sb.WithExpandAttribute("projects")
+	return sb.Build(), nil
 }
 
 // Read implements datasource.DataSource.
 func (state *ProjectsDataSourceModel) Read(ctx context.Context, client openapi.ClientInterface) error {
 	var organization string
-	var labelFilter *string
+	var labelFilter, fieldFilter *string
 	if state.Filter != nil {
 		if state.Filter.Organization != nil {
 			organization = *state.Filter.Organization
@@ -59,8 +73,19 @@ func (state *ProjectsDataSourceModel) Read(ctx context.Context, client openapi.C
 			labelFilterStr := strings.Join(state.Filter.Labels, ",")
 			labelFilter = &labelFilterStr
 		}
+		if state.Filter.Fields != nil {
+			fieldFilterStr := strings.Join(state.Filter.Fields, ",")
+			fieldFilter = &fieldFilterStr
+		}
 	}
-	projects, err := helper.GetProjects(ctx, client, organization, labelFilter, true)
+	projects, err := helper.GetProjects(ctx, client, organization, helper.ListOptions{
+		LabelFilter:    labelFilter,
+		FieldFilter:    fieldFilter,
+		ListAccessible: true,
+		MaxResults:     state.MaxResults,
+		Expand:         state.Expand != nil && *state.Expand,
+		PageSize:       state.pageSize,
+	})
 	if err != nil {
 		return err
 	}
@@ -68,17 +93,29 @@ func (state *ProjectsDataSourceModel) Read(ctx context.Context, client openapi.C
 	return err
 }
 
-func GetProjectDataSourceResponse(projects []string) ([]ProjectNameModel, error) {
+// SetPageSize implements framework.ListDataSourceState.
+func (state *ProjectsDataSourceModel) SetPageSize(pageSize int32) {
+	state.pageSize = pageSize
+}
+
+func GetProjectDataSourceResponse(projects []helper.ListItem) ([]ProjectNameModel, error) {
 	var ret []ProjectNameModel
 	for _, project := range projects {
-		parts := strings.Split(project, "/")
+		parts := strings.Split(project.Name, "/")
 		if len(parts) != 2 {
-			return nil, fmt.Errorf("Unexpected format for project name: %s", project)
+			return nil, fmt.Errorf("Unexpected format for project name: %s", project.Name)
 		}
-		ret = append(ret, ProjectNameModel{
+		model := ProjectNameModel{
 			Organization: parts[0],
 			Name:         parts[1],
-		})
+		}
+		var details openapi.ProjectModel
+		if ok, err := project.DecodePayload(&details); err != nil {
+			return nil, err
+		} else if ok {
+			model.Details = &details
+		}
+		ret = append(ret, model)
 	}
 	return ret, nil
 }
@@ -89,8 +126,8 @@ func NewProjectsDataSourceState() framework.DataSourceState {
 
 func NewProjectsDataSource() datasource.DataSource {
 	return &framework.GenericDataSource{
-		TypeName:       "projects",
-		SchemaOverride: GetProjectsDataSourceSchema(),
-		Build:          NewProjectsDataSourceState,
+		TypeName:          "projects",
+		GetSchemaOverride: GetProjectsDataSourceSchema,
+		Build:             NewProjectsDataSourceState,
 	}
 }
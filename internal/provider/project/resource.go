@@ -8,7 +8,7 @@ package project
 import (
 	"context"
 	"fmt"
-	"strings"
+	"net/http"
 
 	"github.com/nuodb/terraform-provider-nuodbaas/internal/framework"
 	"github.com/nuodb/terraform-provider-nuodbaas/internal/helper"
@@ -71,36 +71,21 @@ func (state *ProjectResourceModel) Read(ctx context.Context, client openapi.Clie
 }
 
 func (state *ProjectResourceModel) Update(ctx context.Context, client openapi.ClientInterface, currentState framework.ResourceState) error {
-	// Fetch project and get resourceVersion
 	latest := &ProjectResourceModel{
 		Organization: state.Organization,
 		Name:         state.Name,
 	}
-	err := latest.Read(ctx, client)
-	if err != nil {
-		return err
-	}
-	for {
-		state.ResourceVersion = latest.ResourceVersion
-		resp, err := client.CreateProject(ctx, state.Organization, state.Name, openapi.ProjectModel(*state))
-		if err != nil {
-			return err
-		}
-		// Decode the response and check that there is no error
-		err = helper.ParseResponse(resp, nil)
-		if err == nil {
-			return nil
-		}
-		// If error is not retriable (code=CONCURRENT_UPDATE), fail fast
-		if apiError, ok := err.(*helper.ApiError); !ok || apiError.GetCode() != openapi.ErrorContentCodeCONCURRENTUPDATE {
-			return err
-		}
-		// Re-fetch project and get resourceVersion
-		err = latest.Read(ctx, client)
+	return framework.RetryOnConcurrentUpdate(ctx, func() error {
+		// Fetch project to get resourceVersion, and send only the fields
+		// that differ from the current state
+		err := latest.Read(ctx, client)
 		if err != nil {
 			return err
 		}
-	}
+		return helper.UpdateWithPatch(currentState, state, latest.ResourceVersion, func(patch []openapi.JsonPatchOperation) (*http.Response, error) {
+			return client.PatchProjectWithApplicationJSONPatchPlusJSONBody(ctx, state.Organization, state.Name, patch)
+		})
+	})
 }
 
 func (state *ProjectResourceModel) Delete(ctx context.Context, client openapi.ClientInterface) error {
@@ -112,9 +97,9 @@ func (state *ProjectResourceModel) Delete(ctx context.Context, client openapi.Cl
 }
 
 func (state *ProjectResourceModel) SetId(id string) error {
-	pathParts := strings.Split(id, "/")
-	if len(pathParts) != 2 || pathParts[0] == "" || pathParts[1] == "" {
-		return fmt.Errorf("Expected an id with format \"organization/name\". Got: %s", id)
+	pathParts, err := framework.ParseId(id, "organization", "name")
+	if err != nil {
+		return err
 	}
 	state.Organization = pathParts[0]
 	state.Name = pathParts[1]
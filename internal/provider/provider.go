@@ -8,38 +8,55 @@ package provider
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/base64"
 	"errors"
+	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"time"
 
 	"github.com/nuodb/terraform-provider-nuodbaas/internal/framework"
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/helper"
+	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/accesstoken"
 	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/backup"
 	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/backuppolicy"
+	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/canaryrollout"
 	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/database"
+	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/databasequota"
+	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/helmfeature"
 	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/project"
+	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/roletemplate"
+	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/servicetier"
+	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/user"
 	"github.com/nuodb/terraform-provider-nuodbaas/openapi"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/tmaxmax/go-sse"
 )
 
 // Ensure NuoDbaasProvider satisfies various provider interfaces.
 var (
-	_ provider.Provider                   = &NuoDbaasProvider{}
-	_ provider.ProviderWithValidateConfig = &NuoDbaasProvider{}
+	_ provider.Provider                       = &NuoDbaasProvider{}
+	_ provider.ProviderWithValidateConfig     = &NuoDbaasProvider{}
+	_ provider.ProviderWithEphemeralResources = &NuoDbaasProvider{}
 )
 
 // NuoDbaasProvider defines the provider implementation.
@@ -49,12 +66,27 @@ type NuoDbaasProvider struct {
 
 // NuoDbaasProviderModel describes the provider data model.
 type NuoDbaasProviderModel struct {
-	User       *string                                `tfsdk:"user" hcl:"user" cty:"user"`
-	Password   *string                                `tfsdk:"password" hcl:"password" cty:"password"`
-	Token      *string                                `tfsdk:"token" hcl:"token" cty:"token"`
-	UrlBase    *string                                `tfsdk:"url_base" hcl:"url_base" cty:"url_base"`
-	SkipVerify *bool                                  `tfsdk:"skip_verify" hcl:"skip_verify" cty:"skip_verify"`
-	Timeouts   map[string]framework.OperationTimeouts `tfsdk:"timeouts" hcl:"timeouts" cty:"timeouts"`
+	User                  *string                                `tfsdk:"user" hcl:"user" cty:"user"`
+	Password              *string                                `tfsdk:"password" hcl:"password" cty:"password"`
+	Token                 *string                                `tfsdk:"token" hcl:"token" cty:"token"`
+	UrlBase               *string                                `tfsdk:"url_base" hcl:"url_base" cty:"url_base"`
+	SkipVerify            *bool                                  `tfsdk:"skip_verify" hcl:"skip_verify" cty:"skip_verify"`
+	CaCertificate         *string                                `tfsdk:"ca_certificate" hcl:"ca_certificate" cty:"ca_certificate"`
+	CaCertificateFile     *string                                `tfsdk:"ca_certificate_file" hcl:"ca_certificate_file" cty:"ca_certificate_file"`
+	ClientCertificate     *string                                `tfsdk:"client_certificate" hcl:"client_certificate" cty:"client_certificate"`
+	ClientKey             *string                                `tfsdk:"client_key" hcl:"client_key" cty:"client_key"`
+	TlsServerName         *string                                `tfsdk:"tls_server_name" hcl:"tls_server_name" cty:"tls_server_name"`
+	DefaultOrganization   *string                                `tfsdk:"default_organization" hcl:"default_organization" cty:"default_organization"`
+	DefaultProject        *string                                `tfsdk:"default_project" hcl:"default_project" cty:"default_project"`
+	DefaultLabels         *map[string]string                     `tfsdk:"default_labels" hcl:"default_labels" cty:"default_labels"`
+	ListPageSize          *int64                                 `tfsdk:"list_page_size" hcl:"list_page_size" cty:"list_page_size"`
+	HeartbeatTimeout      *string                                `tfsdk:"heartbeat_timeout" hcl:"heartbeat_timeout" cty:"heartbeat_timeout"`
+	PollInterval          *string                                `tfsdk:"poll_interval" hcl:"poll_interval" cty:"poll_interval"`
+	PollMaxInterval       *string                                `tfsdk:"poll_max_interval" hcl:"poll_max_interval" cty:"poll_max_interval"`
+	PollBackoffMultiplier *float64                               `tfsdk:"poll_backoff_multiplier" hcl:"poll_backoff_multiplier" cty:"poll_backoff_multiplier"`
+	Timeouts              map[string]framework.OperationTimeouts `tfsdk:"timeouts" hcl:"timeouts" cty:"timeouts"`
+
+	session *session
 }
 
 var _ framework.ProviderConfig = &NuoDbaasProviderModel{}
@@ -65,6 +97,22 @@ const (
 	NUODB_CP_TOKEN       = "NUODB_CP_TOKEN"    //nolint:gosec // This is not a hardcoded authentication token
 	NUODB_CP_URL_BASE    = "NUODB_CP_URL_BASE"
 	NUODB_CP_SKIP_VERIFY = "NUODB_CP_SKIP_VERIFY"
+
+	NUODB_CP_CA_CERTIFICATE      = "NUODB_CP_CA_CERTIFICATE"
+	NUODB_CP_CA_CERTIFICATE_FILE = "NUODB_CP_CA_CERTIFICATE_FILE"
+	NUODB_CP_CLIENT_CERTIFICATE  = "NUODB_CP_CLIENT_CERTIFICATE"
+	NUODB_CP_CLIENT_KEY          = "NUODB_CP_CLIENT_KEY" //nolint:gosec // This is not a hardcoded key
+	NUODB_CP_TLS_SERVER_NAME     = "NUODB_CP_TLS_SERVER_NAME"
+
+	NUODB_CP_DEFAULT_ORGANIZATION = "NUODB_CP_DEFAULT_ORGANIZATION"
+	NUODB_CP_DEFAULT_PROJECT      = "NUODB_CP_DEFAULT_PROJECT"
+
+	NUODB_CP_LIST_PAGE_SIZE    = "NUODB_CP_LIST_PAGE_SIZE"
+	NUODB_CP_HEARTBEAT_TIMEOUT = "NUODB_CP_HEARTBEAT_TIMEOUT"
+
+	NUODB_CP_POLL_INTERVAL           = "NUODB_CP_POLL_INTERVAL"
+	NUODB_CP_POLL_MAX_INTERVAL       = "NUODB_CP_POLL_MAX_INTERVAL"
+	NUODB_CP_POLL_BACKOFF_MULTIPLIER = "NUODB_CP_POLL_BACKOFF_MULTIPLIER"
 )
 
 func (pm *NuoDbaasProviderModel) GetUser() string {
@@ -102,39 +150,237 @@ func (pm *NuoDbaasProviderModel) GetSkipVerify() bool {
 	return os.Getenv(NUODB_CP_SKIP_VERIFY) == "true"
 }
 
-func (pm *NuoDbaasProviderModel) getHttpClient() *http.Client {
-	client := &http.Client{}
-	if pm.GetSkipVerify() {
-		client.Transport = &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true, //nolint:gosec // Reduced security at the demand of the user.
-			},
+func (pm *NuoDbaasProviderModel) GetCaCertificate() string {
+	if pm.CaCertificate != nil {
+		return *pm.CaCertificate
+	}
+	return os.Getenv(NUODB_CP_CA_CERTIFICATE)
+}
+
+func (pm *NuoDbaasProviderModel) GetCaCertificateFile() string {
+	if pm.CaCertificateFile != nil {
+		return *pm.CaCertificateFile
+	}
+	return os.Getenv(NUODB_CP_CA_CERTIFICATE_FILE)
+}
+
+func (pm *NuoDbaasProviderModel) GetClientCertificate() string {
+	if pm.ClientCertificate != nil {
+		return *pm.ClientCertificate
+	}
+	return os.Getenv(NUODB_CP_CLIENT_CERTIFICATE)
+}
+
+func (pm *NuoDbaasProviderModel) GetClientKey() string {
+	if pm.ClientKey != nil {
+		return *pm.ClientKey
+	}
+	return os.Getenv(NUODB_CP_CLIENT_KEY)
+}
+
+func (pm *NuoDbaasProviderModel) GetTlsServerName() string {
+	if pm.TlsServerName != nil {
+		return *pm.TlsServerName
+	}
+	return os.Getenv(NUODB_CP_TLS_SERVER_NAME)
+}
+
+func (pm *NuoDbaasProviderModel) GetDefaultOrganization() string {
+	if pm.DefaultOrganization != nil {
+		return *pm.DefaultOrganization
+	}
+	if defaultOrg := os.Getenv(NUODB_CP_DEFAULT_ORGANIZATION); defaultOrg != "" {
+		return defaultOrg
+	}
+	// Use the organization of the user
+	organization, _, found := strings.Cut(pm.GetUser(), "/")
+	if found {
+		return organization
+	}
+	return ""
+}
+
+func (pm *NuoDbaasProviderModel) GetDefaultProject() string {
+	if pm.DefaultProject != nil {
+		return *pm.DefaultProject
+	}
+	return os.Getenv(NUODB_CP_DEFAULT_PROJECT)
+}
+
+func (pm *NuoDbaasProviderModel) GetDefaultLabels() map[string]string {
+	if pm.DefaultLabels != nil {
+		return *pm.DefaultLabels
+	}
+	return nil
+}
+
+func (pm *NuoDbaasProviderModel) GetListPageSize() int32 {
+	if pm.ListPageSize != nil {
+		return int32(*pm.ListPageSize) //nolint:gosec // Value is validated by schema
+	}
+	if pageSize, err := strconv.ParseInt(os.Getenv(NUODB_CP_LIST_PAGE_SIZE), 10, 32); err == nil && pageSize > 0 {
+		return int32(pageSize)
+	}
+	return helper.DEFAULT_PAGE_SIZE
+}
+
+func (pm *NuoDbaasProviderModel) GetHeartbeatTimeout() time.Duration {
+	timeout, err := pm.parseHeartbeatTimeout()
+	if err != nil {
+		return framework.DEFAULT_HEARTBEAT_TIMEOUT
+	}
+	return timeout
+}
+
+func (pm *NuoDbaasProviderModel) parseHeartbeatTimeout() (time.Duration, error) {
+	return parseDuration("heartbeat_timeout", pm.HeartbeatTimeout, NUODB_CP_HEARTBEAT_TIMEOUT, framework.DEFAULT_HEARTBEAT_TIMEOUT)
+}
+
+func (pm *NuoDbaasProviderModel) GetPollingBackoff() framework.PollingBackoff {
+	backoff, err := pm.parsePollingBackoff()
+	if err != nil {
+		return framework.DefaultPollingBackoff()
+	}
+	return backoff
+}
+
+func (pm *NuoDbaasProviderModel) parsePollingBackoff() (framework.PollingBackoff, error) {
+	backoff := framework.DefaultPollingBackoff()
+	var err error
+	backoff.InitialInterval, err = parseDuration("poll_interval", pm.PollInterval, NUODB_CP_POLL_INTERVAL, backoff.InitialInterval)
+	if err != nil {
+		return backoff, err
+	}
+	if backoff.InitialInterval == 0 {
+		return backoff, errors.New("poll_interval must be positive")
+	}
+	// If the initial interval exceeds the default maximum interval, poll at
+	// a fixed interval unless a maximum interval is specified
+	backoff.MaxInterval = max(backoff.MaxInterval, backoff.InitialInterval)
+	backoff.MaxInterval, err = parseDuration("poll_max_interval", pm.PollMaxInterval, NUODB_CP_POLL_MAX_INTERVAL, backoff.MaxInterval)
+	if err != nil {
+		return backoff, err
+	}
+	if backoff.MaxInterval < backoff.InitialInterval {
+		return backoff, fmt.Errorf("poll_max_interval must not be less than poll_interval: %s < %s", backoff.MaxInterval, backoff.InitialInterval)
+	}
+	if pm.PollBackoffMultiplier != nil {
+		backoff.Multiplier = *pm.PollBackoffMultiplier
+	} else if value := os.Getenv(NUODB_CP_POLL_BACKOFF_MULTIPLIER); value != "" {
+		backoff.Multiplier, err = strconv.ParseFloat(value, 64)
+		if err != nil {
+			return backoff, fmt.Errorf("Unable to parse poll_backoff_multiplier: %w", err)
+		}
+	}
+	if backoff.Multiplier < 1 {
+		return backoff, fmt.Errorf("poll_backoff_multiplier must be at least 1: %g", backoff.Multiplier)
+	}
+	return backoff, nil
+}
+
+// parseDuration parses a non-negative duration from the supplied provider
+// attribute value, or from the environment variable if the attribute is not
+// specified, returning the default if neither is set.
+func parseDuration(attribute string, value *string, envVar string, defaultValue time.Duration) (time.Duration, error) {
+	str := os.Getenv(envVar)
+	if value != nil {
+		str = *value
+	}
+	if str == "" {
+		return defaultValue, nil
+	}
+	duration, err := time.ParseDuration(str)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to parse %s: %w", attribute, err)
+	}
+	if duration < 0 {
+		return 0, fmt.Errorf("%s must be non-negative: %s", attribute, str)
+	}
+	return duration, nil
+}
+
+// getTlsConfig returns the TLS configuration used by all connections to the
+// server, which includes the CA certificates to trust and the client
+// certificate to present to the server.
+func (pm *NuoDbaasProviderModel) getTlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		ServerName:         pm.GetTlsServerName(),
+		InsecureSkipVerify: pm.GetSkipVerify(), //nolint:gosec // Reduced security at the demand of the user.
+	}
+	// Use the supplied CA certificates instead of the system certificate
+	// pool to verify the server certificate
+	caCertificate := pm.GetCaCertificate()
+	if caCertificateFile := pm.GetCaCertificateFile(); caCertificateFile != "" {
+		content, err := os.ReadFile(caCertificateFile)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to read CA certificate file: %w", err)
+		}
+		caCertificate += "\n" + string(content)
+	}
+	if caCertificate != "" {
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM([]byte(caCertificate)) {
+			return nil, errors.New("No PEM-encoded certificates found in CA certificate")
+		}
+		tlsConfig.RootCAs = certPool
+	}
+	// Present client certificate to the server if one was supplied
+	if pm.GetClientCertificate() != "" || pm.GetClientKey() != "" {
+		clientCertificate, err := tls.X509KeyPair([]byte(pm.GetClientCertificate()), []byte(pm.GetClientKey()))
+		if err != nil {
+			return nil, fmt.Errorf("Unable to load client certificate: %w", err)
 		}
+		tlsConfig.Certificates = []tls.Certificate{clientCertificate}
 	}
-	return client
+	return tlsConfig, nil
 }
 
-func (pm *NuoDbaasProviderModel) getAuthHeader() string {
+func (pm *NuoDbaasProviderModel) getHttpClient() (*http.Client, error) {
+	tlsConfig, err := pm.getTlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Transport: transport}, nil
+}
+
+func (pm *NuoDbaasProviderModel) getBasicAuthHeader() string {
+	auth := pm.GetUser() + ":" + pm.GetPassword()
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(auth))
+}
+
+func (pm *NuoDbaasProviderModel) getAuthHeader(ctx context.Context) string {
 	if pm.GetToken() != "" {
 		return "Bearer " + pm.GetToken()
-	} else if pm.GetUser() != "" {
-		auth := pm.GetUser() + ":" + pm.GetPassword()
-		return "Basic " + base64.StdEncoding.EncodeToString([]byte(auth))
+	}
+	// Use session token if one was obtained for the user
+	if pm.session != nil {
+		if token := pm.session.getToken(ctx, pm); token != "" {
+			return "Bearer " + token
+		}
+	}
+	if pm.GetUser() != "" {
+		return pm.getBasicAuthHeader()
 	}
 	return ""
 }
 
 func (pm *NuoDbaasProviderModel) prepareRequest(req *http.Request) {
 	if req.Header.Get("Authorization") == "" {
-		if authHeader := pm.getAuthHeader(); authHeader != "" {
+		if authHeader := pm.getAuthHeader(req.Context()); authHeader != "" {
 			req.Header.Set("Authorization", authHeader)
 		}
 	}
 }
 
 func (pm *NuoDbaasProviderModel) CreateClient() (openapi.ClientInterface, error) {
+	httpClient, err := pm.getHttpClient()
+	if err != nil {
+		return nil, err
+	}
 	return openapi.NewClient(pm.GetUrlBase(),
-		openapi.WithHTTPClient(pm.getHttpClient()),
+		openapi.WithHTTPClient(httpClient),
 		openapi.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
 			pm.prepareRequest(req)
 			return nil
@@ -143,18 +389,48 @@ func (pm *NuoDbaasProviderModel) CreateClient() (openapi.ClientInterface, error)
 
 func (pm *NuoDbaasProviderModel) buildSseRequest(ctx context.Context, path string) (*http.Request, error) {
 	url := strings.TrimSuffix(pm.GetUrlBase(), "/") + "/" + strings.TrimPrefix(path, "/")
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
-	if err != nil {
-		return nil, err
+	// Authorization header is set by the transport of the SSE client, so
+	// that reconnections use the latest session token
+	return http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+}
+
+// noLastEventIDTransport removes the Last-Event-ID header that the SSE client
+// sets when reconnecting. Event streams cannot be resumed, because the REST
+// API defines the ID of an event as the name of the resource that it is on
+// relative to the request path, rather than a position in the event stream,
+// and the event stream endpoints do not accept a position to resume from.
+// The `cursor` parameter is not one either, since it skips resources that
+// sort before it when listing the resources in the initial RESYNC event.
+// Instead, the consumer is notified with a RESYNC event whenever the
+// connection is established, so that resources are read again.
+type noLastEventIDTransport struct {
+	base http.RoundTripper
+}
+
+func (t *noLastEventIDTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Last-Event-ID") != "" {
+		// Do not modify the supplied request, as required by RoundTripper
+		req = req.Clone(req.Context())
+		req.Header.Del("Last-Event-ID")
 	}
-	pm.prepareRequest(req)
-	return req, nil
+	return t.base.RoundTrip(req)
 }
 
-func (pm *NuoDbaasProviderModel) createSseClient(ctx context.Context) *sse.Client {
-	// Create SSE client with HTTP client from provider config
+func (pm *NuoDbaasProviderModel) createSseClient(ctx context.Context, onConnect func()) (*sse.Client, error) {
+	// Create SSE client with HTTP client from provider config, so that it
+	// uses the same TLS configuration as the REST client
+	httpClient, err := pm.getHttpClient()
+	if err != nil {
+		return nil, err
+	}
 	var sseClient sse.Client
-	sseClient.HTTPClient = pm.getHttpClient()
+	sseClient.HTTPClient = httpClient
+	sseClient.HTTPClient.Transport = &noLastEventIDTransport{
+		base: &authTransport{
+			base: sseClient.HTTPClient.Transport,
+			pm:   pm,
+		},
+	}
 	sseClient.OnRetry = func(err error, delay time.Duration) {
 		if ctx.Err() == nil {
 			tflog.Info(ctx, "Scheduling SSE reconnection after error",
@@ -171,7 +447,8 @@ func (pm *NuoDbaasProviderModel) createSseClient(ctx context.Context) *sse.Clien
 			_ = reader.Close()
 		}
 	})
-	// Use response validator to set or update reader
+	// Use response validator to set or update reader, and to notify that
+	// the connection was established
 	sseClient.ResponseValidator = func(resp *http.Response) error {
 		err := sse.DefaultValidator(resp)
 		if err == nil {
@@ -180,6 +457,7 @@ func (pm *NuoDbaasProviderModel) createSseClient(ctx context.Context) *sse.Clien
 			if ctx.Err() != nil {
 				resp.Body.Close()
 			}
+			onConnect()
 		}
 		return err
 	}
@@ -188,9 +466,9 @@ func (pm *NuoDbaasProviderModel) createSseClient(ctx context.Context) *sse.Clien
 		InitialInterval: time.Millisecond * 500,
 		Multiplier:      1.5,
 		Jitter:          0.5,
-		MaxInterval:     framework.POLLING_INTERVAL,
+		MaxInterval:     framework.EVENT_STREAM_RECONNECT_MAX_INTERVAL,
 	}
-	return &sseClient
+	return &sseClient, nil
 }
 
 func (pm *NuoDbaasProviderModel) ConsumeEvents(ctx context.Context, path string, callback func(sse.Event)) error {
@@ -199,8 +477,14 @@ func (pm *NuoDbaasProviderModel) ConsumeEvents(ctx context.Context, path string,
 	if err != nil {
 		return err
 	}
-	// Create SSE client and connection
-	sseClient := pm.createSseClient(ctx)
+	// Create SSE client and connection. Whenever the connection is
+	// established, notify the consumer that events may have been missed.
+	sseClient, err := pm.createSseClient(ctx, func() {
+		callback(sse.Event{Type: framework.SSE_EVENT_RESYNC})
+	})
+	if err != nil {
+		return err
+	}
 	sseConnection := sseClient.NewConnection(req)
 	// Register callback and consume SSE messages synchronously until a
 	// non-retriable error occurs. Suppress error due to context being
@@ -228,7 +512,8 @@ func (p *NuoDbaasProvider) Schema(ctx context.Context, req provider.SchemaReques
 			},
 			"password": schema.StringAttribute{
 				Description: "The password for the user. " +
-					"If not specified, defaults to the value of the `" + NUODB_CP_PASSWORD + "` environment variable.",
+					"If not specified, defaults to the value of the `" + NUODB_CP_PASSWORD + "` environment variable. " +
+					"The user name and password are exchanged for a session token, which is refreshed automatically, if token authentication is enabled on the server.",
 				Optional:  true,
 				Sensitive: true,
 			},
@@ -248,8 +533,91 @@ func (p *NuoDbaasProvider) Schema(ctx context.Context, req provider.SchemaReques
 					"If not specified, defaults to the value of the `" + NUODB_CP_SKIP_VERIFY + "` environment variable.",
 				Optional: true,
 			},
+			"ca_certificate": schema.StringAttribute{
+				Description: "PEM-encoded CA certificates to use to verify the server certificate, instead of the system certificate pool. " +
+					"If not specified, defaults to the value of the `" + NUODB_CP_CA_CERTIFICATE + "` environment variable.",
+				Optional: true,
+			},
+			"ca_certificate_file": schema.StringAttribute{
+				Description: "The path to a file containing PEM-encoded CA certificates to use to verify the server certificate, instead of the system certificate pool. " +
+					"If `ca_certificate` is also specified, the certificates from both are used. " +
+					"If not specified, defaults to the value of the `" + NUODB_CP_CA_CERTIFICATE_FILE + "` environment variable.",
+				Optional: true,
+			},
+			"client_certificate": schema.StringAttribute{
+				Description: "PEM-encoded client certificate to present to the server for mutual TLS authentication. " +
+					"If not specified, defaults to the value of the `" + NUODB_CP_CLIENT_CERTIFICATE + "` environment variable.",
+				Optional: true,
+			},
+			"client_key": schema.StringAttribute{
+				Description: "PEM-encoded private key for the client certificate. " +
+					"If not specified, defaults to the value of the `" + NUODB_CP_CLIENT_KEY + "` environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"tls_server_name": schema.StringAttribute{
+				Description: "The server name to use for SNI and to verify the server certificate, if it differs from the host name in `url_base`. " +
+					"If not specified, defaults to the value of the `" + NUODB_CP_TLS_SERVER_NAME + "` environment variable.",
+				Optional: true,
+			},
+			"default_organization": schema.StringAttribute{
+				Description: "The organization to use for resources and data sources that do not specify one. " +
+					"If not specified, defaults to the value of the `" + NUODB_CP_DEFAULT_ORGANIZATION + "` environment variable, " +
+					"or the organization of the user if that is not set.",
+				Optional: true,
+			},
+			"default_project": schema.StringAttribute{
+				Description: "The project to use for resources and data sources that do not specify one. " +
+					"If not specified, defaults to the value of the `" + NUODB_CP_DEFAULT_PROJECT + "` environment variable.",
+				Optional: true,
+			},
+			"default_labels": schema.MapAttribute{
+				Description: "Labels to apply to all resources that have labels. " +
+					"Labels configured for a resource take precedence over default labels with the same key. " +
+					"The labels applied to a resource, including default labels, are exposed by its `labels_all` attribute.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"list_page_size": schema.Int64Attribute{
+				Description: "The maximum number of items to request per page when listing resources in data sources. " +
+					"If not specified, defaults to the value of the `" + NUODB_CP_LIST_PAGE_SIZE + "` environment variable, " +
+					fmt.Sprintf("or %d if that is not set.", helper.DEFAULT_PAGE_SIZE),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, math.MaxInt32),
+				},
+			},
+			"heartbeat_timeout": schema.StringAttribute{
+				Description: "The maximum time to wait for an event or heartbeat on an event stream, specified as a duration with time unit suffix, e.g. `30s`. " +
+					"If no event or heartbeat is received within this time, for example because a proxy is buffering the event stream, the event stream is closed and the provider falls back to polling. " +
+					"A timeout of `0` disables this check. " +
+					"If not specified, defaults to the value of the `" + NUODB_CP_HEARTBEAT_TIMEOUT + "` environment variable, " +
+					fmt.Sprintf("or `%s` if that is not set.", framework.DEFAULT_HEARTBEAT_TIMEOUT),
+				Optional: true,
+			},
+			"poll_interval": schema.StringAttribute{
+				Description: "The delay before the first poll of a resource that is awaited by polling, because an event stream is not available, specified as a duration with time unit suffix, e.g. `5s`. " +
+					"The time that a resource can remain in a failed state before waiting for it is abandoned is also derived from this interval. " +
+					"If not specified, defaults to the value of the `" + NUODB_CP_POLL_INTERVAL + "` environment variable, " +
+					fmt.Sprintf("or `%s` if that is not set.", framework.DEFAULT_POLLING_INTERVAL),
+				Optional: true,
+			},
+			"poll_max_interval": schema.StringAttribute{
+				Description: "The maximum delay between polls of a resource, specified as a duration with time unit suffix, e.g. `1m`. " +
+					"If not specified, defaults to the value of the `" + NUODB_CP_POLL_MAX_INTERVAL + "` environment variable, " +
+					fmt.Sprintf("or the greater of `%s` and `poll_interval` if that is not set.", framework.DEFAULT_POLLING_MAX_INTERVAL),
+				Optional: true,
+			},
+			"poll_backoff_multiplier": schema.Float64Attribute{
+				Description: "The factor by which the delay between polls of a resource is multiplied after each poll, up to `poll_max_interval`. " +
+					"The delay before each poll is randomized to between half and all of the current delay. " +
+					"A multiplier of `1` polls at a fixed interval. " +
+					"If not specified, defaults to the value of the `" + NUODB_CP_POLL_BACKOFF_MULTIPLIER + "` environment variable, " +
+					fmt.Sprintf("or `%g` if that is not set.", framework.DEFAULT_POLLING_BACKOFF_MULTIPLIER),
+				Optional: true,
+			},
 			"timeouts": schema.MapNestedAttribute{
-				Description: "Timeouts by resource type and operation. A resource type of `default` is used to supply timeouts for all resources that are not specified explicitly.",
+				Description: "Timeouts by resource type and operation. A resource type of `default` is used to supply timeouts for all resources that are not specified explicitly. Timeouts configured in the `timeouts` block of a resource take precedence over these.",
 				Optional:    true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -268,6 +636,11 @@ func (p *NuoDbaasProvider) Schema(ctx context.Context, req provider.SchemaReques
 								"A timeout of `0` indicates not to wait.",
 							Optional: true,
 						},
+						framework.READ_OPERATION: schema.StringAttribute{
+							Description: "The timeout for reading a resource, including when it is imported, specified as a duration with time unit suffix, e.g. `1m`. " +
+								"By default, there is no timeout.",
+							Optional: true,
+						},
 					},
 				},
 			},
@@ -290,10 +663,20 @@ func (p *NuoDbaasProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	// Exchange user credentials for a session token. If this fails, e.g.
+	// because token authentication is not enabled on the server, fall back
+	// to using basic authentication.
+	err = config.startSession(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to obtain session token, using basic authentication instead",
+			map[string]any{"error": err.Error()})
+	}
+
 	// Pass client as opaque data
 	providerClient := framework.NewProviderClient(&config, client, timeouts)
 	resp.DataSourceData = providerClient
 	resp.ResourceData = providerClient
+	resp.EphemeralResourceData = providerClient
 }
 
 func parseAndValidate(ctx context.Context, rawConfig tfsdk.Config, diags *diag.Diagnostics) (NuoDbaasProviderModel, map[string]map[string]time.Duration) {
@@ -322,6 +705,16 @@ func parseAndValidate(ctx context.Context, rawConfig tfsdk.Config, diags *diag.D
 		diags.AddAttributeError(path.Empty().AtName("timeouts"), "Invalid provider configuration", err.Error())
 	}
 
+	// Validate heartbeat timeout
+	if _, err := config.parseHeartbeatTimeout(); err != nil {
+		diags.AddAttributeError(path.Empty().AtName("heartbeat_timeout"), "Invalid provider configuration", err.Error())
+	}
+
+	// Validate polling configuration
+	if _, err := config.parsePollingBackoff(); err != nil {
+		diags.AddError("Invalid provider configuration", err.Error())
+	}
+
 	// Validate credentials
 	hasUser := config.GetUser() != ""
 	hasPassword := config.GetPassword() != ""
@@ -341,6 +734,14 @@ func parseAndValidate(ctx context.Context, rawConfig tfsdk.Config, diags *diag.D
 		}
 	}
 
+	// Validate client certificate
+	hasClientCertificate := config.GetClientCertificate() != ""
+	hasClientKey := config.GetClientKey() != ""
+
+	if hasClientCertificate != hasClientKey {
+		diags.AddError("Partial client certificate", "To use mutual TLS authentication, both client certificate and key should be provided.")
+	}
+
 	return config, timeouts
 }
 
@@ -366,6 +767,13 @@ func resources() []func() resource.Resource {
 		NewDatabaseResource,
 		NewBackupPolicyResource,
 		NewBackupResource,
+		NewUserResource,
+		NewServiceTierResource,
+		NewDatabaseQuotaResource,
+		NewHelmFeatureResource,
+		NewRoleTemplateResource,
+		NewCanaryRolloutTemplateResource,
+		NewCanaryRolloutResource,
 	}
 }
 
@@ -377,8 +785,18 @@ func (p *NuoDbaasProvider) DataSources(ctx context.Context) []func() datasource.
 		NewDatabasesDataSource,
 		NewBackupPolicyDataSource,
 		NewBackupPoliciesDataSource,
+		NewBackupPolicyBackupsDataSource,
+		NewBackupPolicyDatabasesDataSource,
 		NewBackupDataSource,
 		NewBackupsDataSource,
+		NewUserDataSource,
+		NewUsersDataSource,
+	}
+}
+
+func (p *NuoDbaasProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAccessTokenEphemeralResource,
 	}
 }
 
@@ -0,0 +1,108 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package roletemplate
+
+import (
+	"context"
+
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/framework"
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/helper"
+	"github.com/nuodb/terraform-provider-nuodbaas/openapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+var (
+	_ framework.ResourceState = &RoleTemplateResourceModel{}
+)
+
+type RoleTemplateResourceModel openapi.RoleTemplateModel
+
+func (state *RoleTemplateResourceModel) Reset() {
+	*state = RoleTemplateResourceModel{}
+}
+
+func (state *RoleTemplateResourceModel) CheckReady(ctx context.Context, client openapi.ClientInterface) error {
+	return nil
+}
+
+func (state *RoleTemplateResourceModel) Create(ctx context.Context, client openapi.ClientInterface) error {
+	resp, err := client.CreateRoleTemplate(ctx, state.Name, nil, openapi.RoleTemplateModel(*state))
+	if err != nil {
+		return err
+	}
+	return helper.ParseResponse(resp, nil)
+}
+
+func (state *RoleTemplateResourceModel) Read(ctx context.Context, client openapi.ClientInterface) error {
+	resp, err := client.GetRoleTemplate(ctx, state.Name)
+	if err != nil {
+		return err
+	}
+	state.Reset()
+	return helper.ParseResponse(resp, state)
+}
+
+func (state *RoleTemplateResourceModel) Update(ctx context.Context, client openapi.ClientInterface, currentState framework.ResourceState) error {
+	latest := &RoleTemplateResourceModel{Name: state.Name}
+	return framework.RetryOnConcurrentUpdate(ctx, func() error {
+		// Fetch role template and get resourceVersion
+		err := latest.Read(ctx, client)
+		if err != nil {
+			return err
+		}
+		state.ResourceVersion = latest.ResourceVersion
+		resp, err := client.CreateRoleTemplate(ctx, state.Name, nil, openapi.RoleTemplateModel(*state))
+		if err != nil {
+			return err
+		}
+		// Decode the response and check that there is no error
+		return helper.ParseResponse(resp, nil)
+	})
+}
+
+func (state *RoleTemplateResourceModel) Delete(ctx context.Context, client openapi.ClientInterface) error {
+	resp, err := client.DeleteRoleTemplate(ctx, state.Name, nil)
+	if err != nil {
+		return err
+	}
+	return helper.ParseResponse(resp, nil)
+}
+
+func (state *RoleTemplateResourceModel) SetId(id string) error {
+	pathParts, err := framework.ParseId(id, "name")
+	if err != nil {
+		return err
+	}
+	state.Name = pathParts[0]
+	return nil
+}
+
+func (state *RoleTemplateResourceModel) GetEventPath() string {
+	// Event streams are not available for cluster-scoped resources
+	return ""
+}
+
+func GetRoleTemplateResourceAttributes() (map[string]schema.Attribute, error) {
+	return framework.GetResourceAttributes("RoleTemplateModel",
+		// Validate verbs at plan time so that invalid rules fail before apply
+		framework.WithEnumValidator("spec.allow.verb"),
+	)
+}
+
+func NewRoleTemplateResourceState() framework.ResourceState {
+	return &RoleTemplateResourceModel{}
+}
+
+func NewRoleTemplateResource() resource.Resource {
+	return &framework.GenericResource{
+		TypeName:              "role_template",
+		Description:           "Resource for managing role templates in the DBaaS Control Plane cluster",
+		GetResourceAttributes: GetRoleTemplateResourceAttributes,
+		Build:                 NewRoleTemplateResourceState,
+	}
+}
@@ -0,0 +1,105 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package servicetier
+
+import (
+	"context"
+
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/framework"
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/helper"
+	"github.com/nuodb/terraform-provider-nuodbaas/openapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+var (
+	_ framework.ResourceState = &ServiceTierResourceModel{}
+)
+
+type ServiceTierResourceModel openapi.ServiceTierModel
+
+func (state *ServiceTierResourceModel) Reset() {
+	*state = ServiceTierResourceModel{}
+}
+
+func (state *ServiceTierResourceModel) CheckReady(ctx context.Context, client openapi.ClientInterface) error {
+	return nil
+}
+
+func (state *ServiceTierResourceModel) Create(ctx context.Context, client openapi.ClientInterface) error {
+	resp, err := client.CreateServiceTier(ctx, state.Name, nil, openapi.ServiceTierModel(*state))
+	if err != nil {
+		return err
+	}
+	return helper.ParseResponse(resp, nil)
+}
+
+func (state *ServiceTierResourceModel) Read(ctx context.Context, client openapi.ClientInterface) error {
+	resp, err := client.GetServiceTier(ctx, state.Name)
+	if err != nil {
+		return err
+	}
+	state.Reset()
+	return helper.ParseResponse(resp, state)
+}
+
+func (state *ServiceTierResourceModel) Update(ctx context.Context, client openapi.ClientInterface, currentState framework.ResourceState) error {
+	latest := &ServiceTierResourceModel{Name: state.Name}
+	return framework.RetryOnConcurrentUpdate(ctx, func() error {
+		// Fetch service tier and get resourceVersion
+		err := latest.Read(ctx, client)
+		if err != nil {
+			return err
+		}
+		state.ResourceVersion = latest.ResourceVersion
+		resp, err := client.CreateServiceTier(ctx, state.Name, nil, openapi.ServiceTierModel(*state))
+		if err != nil {
+			return err
+		}
+		// Decode the response and check that there is no error
+		return helper.ParseResponse(resp, nil)
+	})
+}
+
+func (state *ServiceTierResourceModel) Delete(ctx context.Context, client openapi.ClientInterface) error {
+	resp, err := client.DeleteServiceTier(ctx, state.Name, nil)
+	if err != nil {
+		return err
+	}
+	return helper.ParseResponse(resp, nil)
+}
+
+func (state *ServiceTierResourceModel) SetId(id string) error {
+	pathParts, err := framework.ParseId(id, "name")
+	if err != nil {
+		return err
+	}
+	state.Name = pathParts[0]
+	return nil
+}
+
+func (state *ServiceTierResourceModel) GetEventPath() string {
+	// Event streams are not available for cluster-scoped resources
+	return ""
+}
+
+func GetServiceTierResourceAttributes() (map[string]schema.Attribute, error) {
+	return framework.GetResourceAttributes("ServiceTierModel")
+}
+
+func NewServiceTierResourceState() framework.ResourceState {
+	return &ServiceTierResourceModel{}
+}
+
+func NewServiceTierResource() resource.Resource {
+	return &framework.GenericResource{
+		TypeName:              "service_tier",
+		Description:           "Resource for managing service tiers in the DBaaS Control Plane cluster",
+		GetResourceAttributes: GetServiceTierResourceAttributes,
+		Build:                 NewServiceTierResourceState,
+	}
+}
@@ -0,0 +1,137 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/helper"
+	"github.com/nuodb/terraform-provider-nuodbaas/openapi"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// TOKEN_REFRESH_MARGIN is the amount of time before the session token expires
+// at which it is refreshed.
+const TOKEN_REFRESH_MARGIN = time.Minute
+
+// session manages the token obtained by exchanging the user name and password
+// in the provider configuration using the login endpoint, so that the password
+// does not have to be sent with every request.
+type session struct {
+	lock      sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+// getToken returns the session token, refreshing it if it is about to expire.
+// If there is no valid session token, an empty string is returned.
+func (s *session) getToken(ctx context.Context, pm *NuoDbaasProviderModel) string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.token != "" && !s.expiresAt.IsZero() && time.Now().Add(TOKEN_REFRESH_MARGIN).After(s.expiresAt) {
+		err := s.login(ctx, pm)
+		if err != nil {
+			tflog.Warn(ctx, "Unable to refresh session token", map[string]any{"error": err.Error()})
+			// Stop using session token if it has already expired
+			if time.Now().After(s.expiresAt) {
+				s.token = ""
+			}
+		}
+	}
+	return s.token
+}
+
+// login obtains a new session token using basic authentication. The caller
+// must hold the session lock.
+func (s *session) login(ctx context.Context, pm *NuoDbaasProviderModel) error {
+	// Create a client that does not use the session, so that the user name
+	// and password are supplied to the login endpoint
+	httpClient, err := pm.getHttpClient()
+	if err != nil {
+		return err
+	}
+	client, err := openapi.NewClient(pm.GetUrlBase(), openapi.WithHTTPClient(httpClient))
+	if err != nil {
+		return err
+	}
+	resp, err := client.Login(ctx, openapi.LoginRequestModel{}, func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", pm.getBasicAuthHeader())
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	var loginResponse openapi.LoginResponseModel
+	err = helper.ParseResponse(resp, &loginResponse)
+	if err != nil {
+		return err
+	}
+	if loginResponse.Token == nil || *loginResponse.Token == "" {
+		return errors.New("No token returned by login endpoint")
+	}
+	s.token = *loginResponse.Token
+	s.expiresAt = time.Time{}
+	// If the expiration time cannot be parsed, the token is not refreshed
+	// proactively and will only be refreshed if a request is rejected
+	if loginResponse.ExpiresAtTime != nil {
+		expiresAt, err := time.Parse(time.RFC3339, *loginResponse.ExpiresAtTime)
+		if err == nil {
+			s.expiresAt = expiresAt
+		} else {
+			tflog.Warn(ctx, "Unable to parse expiration time of session token",
+				map[string]any{"expiresAtTime": *loginResponse.ExpiresAtTime, "error": err.Error()})
+		}
+	}
+	tflog.Debug(ctx, "Obtained session token", map[string]any{"expiresAtTime": s.expiresAt})
+	return nil
+}
+
+// startSession exchanges the user name and password in the provider
+// configuration for a session token, which is used to authenticate all
+// subsequent requests. If a token was supplied explicitly or no credentials
+// were supplied, this does nothing.
+func (pm *NuoDbaasProviderModel) startSession(ctx context.Context) error {
+	if pm.GetToken() != "" || pm.GetUser() == "" {
+		return nil
+	}
+	pm.session = &session{}
+	return pm.Login(ctx)
+}
+
+// Login obtains a new session token if a session was started, which can be
+// used to recover from the session token being rejected by the server.
+func (pm *NuoDbaasProviderModel) Login(ctx context.Context) error {
+	if pm.session == nil {
+		return nil
+	}
+	pm.session.lock.Lock()
+	defer pm.session.lock.Unlock()
+	return pm.session.login(ctx, pm)
+}
+
+// authTransport sets the Authorization header on each request that it sends,
+// so that requests that are retried, such as SSE reconnections, use the
+// latest session token.
+type authTransport struct {
+	base http.RoundTripper
+	pm   *NuoDbaasProviderModel
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	// Do not modify the supplied request, as required by RoundTripper
+	req = req.Clone(req.Context())
+	t.pm.prepareRequest(req)
+	return base.RoundTrip(req)
+}
@@ -0,0 +1,48 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package user
+
+import (
+	"context"
+
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/framework"
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/helper"
+	"github.com/nuodb/terraform-provider-nuodbaas/openapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var (
+	_ framework.DataSourceState = &UserDataSourceModel{}
+)
+
+type UserDataSourceModel openapi.DbaasUserModel
+
+func (state *UserDataSourceModel) Read(ctx context.Context, client openapi.ClientInterface) error {
+	resp, err := client.GetUser(ctx, state.Organization, state.Name)
+	if err != nil {
+		return err
+	}
+	return helper.ParseResponse(resp, state)
+}
+
+func GetUserDataSourceAttributes() (map[string]schema.Attribute, error) {
+	return framework.GetDataSourceAttributes("DbaasUserModel")
+}
+
+func NewUserDataSourceState() framework.DataSourceState {
+	return &UserDataSourceModel{}
+}
+
+func NewUserDataSource() datasource.DataSource {
+	return &framework.GenericDataSource{
+		TypeName:                "user",
+		Description:             "Data source for exposing information about users created using the DBaaS Control Plane",
+		GetDataSourceAttributes: GetUserDataSourceAttributes,
+		Build:                   NewUserDataSourceState,
+	}
+}
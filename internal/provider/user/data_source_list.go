@@ -0,0 +1,133 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package user
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/framework"
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/helper"
+	"github.com/nuodb/terraform-provider-nuodbaas/openapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var (
+	_ framework.ListDataSourceState = &UsersDataSourceModel{}
+)
+
+type UserFilterModel struct {
+	Organization *string  `tfsdk:"organization" hcl:"organization" cty:"organization"`
+	Labels       []string `tfsdk:"labels" hcl:"labels" cty:"labels"`
+	Fields       []string `tfsdk:"fields" hcl:"fields" cty:"fields"`
+}
+
+type UserNameModel struct {
+	Organization string                  `tfsdk:"organization" hcl:"organization" cty:"organization"`
+	Name         string                  `tfsdk:"name" hcl:"name" cty:"name"`
+	Details      *openapi.DbaasUserModel `tfsdk:"details" hcl:"details" cty:"details"`
+}
+
+type UsersDataSourceModel struct {
+	Filter     *UserFilterModel `tfsdk:"filter" hcl:"filter" cty:"filter"`
+	MaxResults *int64           `tfsdk:"max_results" hcl:"max_results" cty:"max_results"`
+	Expand     *bool            `tfsdk:"expand" hcl:"expand" cty:"expand"`
+	Users      []UserNameModel  `tfsdk:"users" hcl:"users" cty:"users"`
+
+	// pageSize is the maximum number of items to request per page
+	pageSize int32
+}
+
+// GetUsersDataSourceSchema returns the schema for the users (plural) data
+// source. This has to be provided explicitly because there is no schema in the
+// OpenAPI spec for the REST API that corresponds to it.
+func GetUsersDataSourceSchema() (*schema.Schema, error) {
+	details, err := GetUserDataSourceAttributes()
+	if err != nil {
+		return nil, err
+	}
+	sb := framework.NewSchemaBuilder().WithDescription("Data source for listing users created using the DBaaS Control Plane")
+	sb.WithOrganizationScopeFilters("users")
+	sb.WithOrganizationScopeList("user", "users").WithNameAttribute("user").
+		WithDetailsAttribute("user", details)
+	sb.WithMaxResultsAttribute("users")
+	sb.WithExpandAttribute("users")
+	return sb.Build(), nil
+}
+
+// Read implements datasource.DataSource.
+func (state *UsersDataSourceModel) Read(ctx context.Context, client openapi.ClientInterface) error {
+	var organization string
+	var labelFilter, fieldFilter *string
+	if state.Filter != nil {
+		if state.Filter.Organization != nil {
+			organization = *state.Filter.Organization
+		}
+		if state.Filter.Labels != nil {
+			labelFilterStr := strings.Join(state.Filter.Labels, ",")
+			labelFilter = &labelFilterStr
+		}
+		if state.Filter.Fields != nil {
+			fieldFilterStr := strings.Join(state.Filter.Fields, ",")
+			fieldFilter = &fieldFilterStr
+		}
+	}
+	users, err := helper.GetUsers(ctx, client, organization, helper.ListOptions{
+		LabelFilter:    labelFilter,
+		FieldFilter:    fieldFilter,
+		ListAccessible: true,
+		MaxResults:     state.MaxResults,
+		Expand:         state.Expand != nil && *state.Expand,
+		PageSize:       state.pageSize,
+	})
+	if err != nil {
+		return err
+	}
+	state.Users, err = GetUserDataSourceResponse(users)
+	return err
+}
+
+// SetPageSize implements framework.ListDataSourceState.
+func (state *UsersDataSourceModel) SetPageSize(pageSize int32) {
+	state.pageSize = pageSize
+}
+
+func GetUserDataSourceResponse(users []helper.ListItem) ([]UserNameModel, error) {
+	var ret []UserNameModel
+	for _, user := range users {
+		parts := strings.Split(user.Name, "/")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("Unexpected format for user name: %s", user.Name)
+		}
+		model := UserNameModel{
+			Organization: parts[0],
+			Name:         parts[1],
+		}
+		var details openapi.DbaasUserModel
+		if ok, err := user.DecodePayload(&details); err != nil {
+			return nil, err
+		} else if ok {
+			model.Details = &details
+		}
+		ret = append(ret, model)
+	}
+	return ret, nil
+}
+
+func NewUsersDataSourceState() framework.DataSourceState {
+	return &UsersDataSourceModel{}
+}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &framework.GenericDataSource{
+		TypeName:          "users",
+		GetSchemaOverride: GetUsersDataSourceSchema,
+		Build:             NewUsersDataSourceState,
+	}
+}
@@ -0,0 +1,114 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/framework"
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/helper"
+	"github.com/nuodb/terraform-provider-nuodbaas/openapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+var (
+	_ framework.ResourceState = &UserResourceModel{}
+)
+
+type UserResourceModel openapi.DbaasUserCreateUpdateModel
+
+func (state *UserResourceModel) Reset() {
+	*state = UserResourceModel{}
+}
+
+func (state *UserResourceModel) CheckReady(ctx context.Context, client openapi.ClientInterface) error {
+	return nil
+}
+
+func (state *UserResourceModel) Create(ctx context.Context, client openapi.ClientInterface) error {
+	resp, err := client.CreateUser(ctx, state.Organization, state.Name, nil, openapi.DbaasUserCreateUpdateModel(*state))
+	if err != nil {
+		return err
+	}
+	return helper.ParseResponse(resp, nil)
+}
+
+func (state *UserResourceModel) Read(ctx context.Context, client openapi.ClientInterface) error {
+	// If password is set, then this is invoked in the context of create or
+	// update, to refresh the state. Make sure to save the password, since it
+	// is not returned by GET response.
+	password := state.Password
+	resp, err := client.GetUser(ctx, state.Organization, state.Name)
+	if err != nil {
+		return err
+	}
+	state.Reset()
+	state.Password = password
+	return helper.ParseResponse(resp, state)
+}
+
+func (state *UserResourceModel) Update(ctx context.Context, client openapi.ClientInterface, currentState framework.ResourceState) error {
+	latest := &UserResourceModel{
+		Organization: state.Organization,
+		Name:         state.Name,
+	}
+	return framework.RetryOnConcurrentUpdate(ctx, func() error {
+		// Fetch user and get resourceVersion
+		err := latest.Read(ctx, client)
+		if err != nil {
+			return err
+		}
+		state.ResourceVersion = latest.ResourceVersion
+		resp, err := client.CreateUser(ctx, state.Organization, state.Name, nil, openapi.DbaasUserCreateUpdateModel(*state))
+		if err != nil {
+			return err
+		}
+		// Decode the response and check that there is no error
+		return helper.ParseResponse(resp, nil)
+	})
+}
+
+func (state *UserResourceModel) Delete(ctx context.Context, client openapi.ClientInterface) error {
+	resp, err := client.DeleteUser(ctx, state.Organization, state.Name, nil)
+	if err != nil {
+		return err
+	}
+	return helper.ParseResponse(resp, nil)
+}
+
+func (state *UserResourceModel) SetId(id string) error {
+	pathParts, err := framework.ParseId(id, "organization", "name")
+	if err != nil {
+		return err
+	}
+	state.Organization = pathParts[0]
+	state.Name = pathParts[1]
+	return nil
+}
+
+func (state *UserResourceModel) GetEventPath() string {
+	return fmt.Sprintf("events/users/%s/%s", state.Organization, state.Name)
+}
+
+func GetUserResourceAttributes() (map[string]schema.Attribute, error) {
+	return framework.GetResourceAttributes("DbaasUserCreateUpdateModel")
+}
+
+func NewUserResourceState() framework.ResourceState {
+	return &UserResourceModel{}
+}
+
+func NewUserResource() resource.Resource {
+	return &framework.GenericResource{
+		TypeName:              "user",
+		Description:           "Resource for managing users created using the DBaaS Control Plane",
+		GetResourceAttributes: GetUserResourceAttributes,
+		Build:                 NewUserResourceState,
+	}
+}
@@ -7,8 +7,12 @@ package provider_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/nuodb/terraform-provider-nuodbaas/internal/framework"
@@ -194,6 +198,7 @@ func TestBackup(t *testing.T) {
 				"project":      backup.Project,
 				"database":     backup.Database,
 				"name":         backup.Name,
+				"details":      nil,
 			},
 		})
 	tf.CheckStateResource(t, "data.nuodbaas_backups.org_backups").
@@ -203,6 +208,7 @@ func TestBackup(t *testing.T) {
 				"project":      backup.Project,
 				"database":     backup.Database,
 				"name":         backup.Name,
+				"details":      nil,
 			},
 		})
 	tf.CheckStateResource(t, "data.nuodbaas_backups.proj_backups").
@@ -212,6 +218,7 @@ func TestBackup(t *testing.T) {
 				"project":      backup.Project,
 				"database":     backup.Database,
 				"name":         backup.Name,
+				"details":      nil,
 			},
 		})
 	tf.CheckStateResource(t, "data.nuodbaas_backups.db_backups").
@@ -221,6 +228,7 @@ func TestBackup(t *testing.T) {
 				"project":      backup.Project,
 				"database":     backup.Database,
 				"name":         backup.Name,
+				"details":      nil,
 			},
 		})
 	tf.CheckStateResource(t, "data.nuodbaas_backups.otherorg_backups").
@@ -273,6 +281,7 @@ func TestBackup(t *testing.T) {
 				"project":      backup.Project,
 				"database":     backup.Database,
 				"name":         backup.Name,
+				"details":      nil,
 			},
 		})
 	tf.CheckStateResource(t, "data.nuodbaas_backups.org_backups").
@@ -282,6 +291,7 @@ func TestBackup(t *testing.T) {
 				"project":      backup.Project,
 				"database":     backup.Database,
 				"name":         backup.Name,
+				"details":      nil,
 			},
 		})
 	tf.CheckStateResource(t, "data.nuodbaas_backups.proj_backups").
@@ -291,6 +301,7 @@ func TestBackup(t *testing.T) {
 				"project":      backup.Project,
 				"database":     backup.Database,
 				"name":         backup.Name,
+				"details":      nil,
 			},
 		})
 	tf.CheckStateResource(t, "data.nuodbaas_backups.db_backups").
@@ -300,6 +311,7 @@ func TestBackup(t *testing.T) {
 				"project":      backup.Project,
 				"database":     backup.Database,
 				"name":         backup.Name,
+				"details":      nil,
 			},
 		})
 	tf.CheckStateResource(t, "data.nuodbaas_backups.otherorg_backups").
@@ -315,6 +327,7 @@ func TestBackup(t *testing.T) {
 				"project":      backup.Project,
 				"database":     backup.Database,
 				"name":         backup.Name,
+				"details":      nil,
 			},
 		})
 
@@ -502,6 +515,104 @@ func TestImportBackup(t *testing.T) {
 	require.Contains(t, string(out), "Your infrastructure matches the configuration.")
 }
 
+func TestOnDemandBackup(t *testing.T) {
+	// Skip test if /backups resource is not implemented by REST server
+	var providerCfg NuoDbaasProviderModel
+	client, err := providerCfg.CreateClient()
+	require.NoError(t, err)
+	ctx := context.Background()
+	skipIfBackupsNotSupported(t, ctx, client)
+
+	// Disable readiness checks so that database and backup do not have to
+	// become ready
+	vars := newTestVars(false)
+	vars.providerCfg.Timeouts = map[string]framework.OperationTimeouts{
+		"default": {
+			Create: ptr("0"),
+			Update: ptr("0"),
+		},
+	}
+
+	// Create provider server that runs within test
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace and initialize it with config
+	tf := CreateTerraformWorkspace(t)
+	err = tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+
+	// Specify backup without a name, which should be generated by the server
+	backup := &openapi.BackupCreateModel{
+		Organization: vars.database.Organization,
+		Project:      vars.database.Project,
+		Database:     vars.database.Name,
+		Labels: &map[string]string{
+			"purpose": "pre-migration",
+		},
+	}
+	vars.builder.WithResource("nuodbaas_backup.on_demand", backup, "nuodbaas_database.db")
+	tf.WriteConfigT(t, vars.builder.Build())
+	_, err = tf.Init()
+	require.NoError(t, err)
+
+	// Run `terraform apply` to create on-demand backup
+	_, err = tf.Apply()
+	defer tf.DestroySilently()
+	require.NoError(t, err)
+
+	// Check attributes in resource and get generated name
+	tf.CheckStateResource(t, "nuodbaas_backup.on_demand").
+		HasAttributeValue("organization", backup.Organization).
+		HasAttributeValue("project", backup.Project).
+		HasAttributeValue("database", backup.Database).
+		HasAttributeValue("labels", map[string]any{"purpose": "pre-migration"}).
+		HasAttribute("name")
+	resource, err := tf.GetStateResource("nuodbaas_backup.on_demand")
+	require.NoError(t, err)
+	name, err := FindChildNode(resource, "name")
+	require.NoError(t, err)
+	require.IsType(t, "", name)
+
+	// Check that backup with generated name exists
+	actualBackup := BackupResourceModel{
+		Organization: backup.Organization,
+		Project:      backup.Project,
+		Database:     backup.Database,
+		Name:         name.(string),
+	}
+	err = actualBackup.Read(ctx, client)
+	require.NoError(t, err)
+	require.Equal(t, backup.Labels, actualBackup.Labels)
+
+	// Run `terraform apply` again and verify that it does nothing
+	out, err := tf.Apply()
+	require.NoError(t, err)
+	require.Contains(t, string(out), "No changes.")
+	require.Contains(t, string(out), "Your infrastructure matches the configuration.")
+
+	// Update backup labels and verify that backup is updated in-place
+	backup.Labels = &map[string]string{
+		"purpose": "test",
+	}
+	tf.WriteConfigT(t, vars.builder.Build())
+	out, err = tf.Apply()
+	require.NoError(t, err)
+	require.Contains(t, string(out), "nuodbaas_backup.on_demand: Modifying...")
+	tf.CheckStateResource(t, "nuodbaas_backup.on_demand").
+		HasAttributeValue("name", name).
+		HasAttributeValue("labels", map[string]any{"purpose": "test"})
+
+	// Run `terraform destroy` to delete backup
+	_, err = tf.Destroy()
+	require.NoError(t, err)
+
+	// Obtain actual backup state and check that 404 is returned
+	err = actualBackup.Read(ctx, client)
+	require.Error(t, err)
+	require.True(t, helper.IsNotFound(err), "Unexpected error: "+err.Error())
+}
+
 func TestBackupPolicy(t *testing.T) {
 	// Skip test if /backuppolicies resource is not implemented by REST server
 	var providerCfg NuoDbaasProviderModel
@@ -545,6 +656,10 @@ func TestBackupPolicy(t *testing.T) {
 				Organization: ptr(policy.Organization),
 				Labels:       []string{"rpo"},
 			},
+		}, "nuodbaas_backuppolicy.pol").
+		WithBackupPolicyBackupsDataSource("pol_backups", &BackupPolicyBackupsDataSourceModel{
+			Organization: policy.Organization,
+			Policy:       policy.Name,
 		}, "nuodbaas_backuppolicy.pol")
 	tf.WriteConfigT(t, builder.Build())
 	_, err = tf.Init()
@@ -591,6 +706,7 @@ func TestBackupPolicy(t *testing.T) {
 			map[string]any{
 				"organization": policy.Organization,
 				"name":         policy.Name,
+				"details":      nil,
 			},
 		})
 	tf.CheckStateResource(t, "data.nuodbaas_backuppolicies.org_policies").
@@ -598,12 +714,18 @@ func TestBackupPolicy(t *testing.T) {
 			map[string]any{
 				"organization": policy.Organization,
 				"name":         policy.Name,
+				"details":      nil,
 			},
 		})
 	tf.CheckStateResource(t, "data.nuodbaas_backuppolicies.otherorg_policies").
 		HasAttributeValue("policies", nil)
 	tf.CheckStateResource(t, "data.nuodbaas_backuppolicies.labelled_policies").
 		HasAttributeValue("policies", nil)
+	// Check that no backups have been created by the policy yet
+	tf.CheckStateResource(t, "data.nuodbaas_backuppolicy_backups.pol_backups").
+		HasAttributeValue("organization", policy.Organization).
+		HasAttributeValue("policy", policy.Name).
+		HasAttributeValue("backups", nil)
 
 	// Update backup policy resource
 	policy.Labels = &map[string]string{
@@ -665,6 +787,7 @@ func TestBackupPolicy(t *testing.T) {
 			map[string]any{
 				"organization": policy.Organization,
 				"name":         policy.Name,
+				"details":      nil,
 			},
 		})
 	tf.CheckStateResource(t, "data.nuodbaas_backuppolicies.org_policies").
@@ -672,6 +795,7 @@ func TestBackupPolicy(t *testing.T) {
 			map[string]any{
 				"organization": policy.Organization,
 				"name":         policy.Name,
+				"details":      nil,
 			},
 		})
 	tf.CheckStateResource(t, "data.nuodbaas_backuppolicies.otherorg_policies").
@@ -682,6 +806,7 @@ func TestBackupPolicy(t *testing.T) {
 			map[string]any{
 				"organization": policy.Organization,
 				"name":         policy.Name,
+				"details":      nil,
 			},
 		})
 
@@ -702,6 +827,70 @@ func TestBackupPolicy(t *testing.T) {
 	require.True(t, helper.IsNotFound(err), "Unexpected error: "+err.Error())
 }
 
+func TestBackupPolicyDatabases(t *testing.T) {
+	// Skip test if /backuppolicies resource is not implemented by REST server
+	vars := newTestVars(true)
+	client, err := vars.providerCfg.CreateClient()
+	require.NoError(t, err)
+	ctx := context.Background()
+	skipIfBackupPoliciesNotSupported(t, ctx, client)
+
+	// Create provider server that runs within test
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace and initialize it with config
+	tf := CreateTerraformWorkspace(t)
+	err = tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+
+	// Create a policy that selects all databases in the project and a policy
+	// that only selects databases in projects with the prod SLA
+	projPolicy := newBackupPolicy()
+	projPolicy.Selector.Scope = vars.project.Organization + "/" + vars.project.Name
+	prodPolicy := newBackupPolicy()
+	prodPolicy.Selector.Scope = vars.project.Organization + "/" + vars.project.Name
+	prodPolicy.Selector.Slas = &[]string{"prod"}
+	vars.builder.
+		WithBackupPolicyResource("proj_pol", projPolicy).
+		WithBackupPolicyResource("prod_pol", prodPolicy).
+		WithBackupPolicyDatabasesDataSource("proj_pol", &BackupPolicyDatabasesDataSourceModel{
+			Organization: projPolicy.Organization,
+			Policy:       projPolicy.Name,
+		}, "nuodbaas_backuppolicy.proj_pol", "nuodbaas_database.db").
+		WithBackupPolicyDatabasesDataSource("prod_pol", &BackupPolicyDatabasesDataSourceModel{
+			Organization: prodPolicy.Organization,
+			Policy:       prodPolicy.Name,
+		}, "nuodbaas_backuppolicy.prod_pol", "nuodbaas_database.db")
+	tf.WriteConfigT(t, vars.builder.Build())
+	_, err = tf.Init()
+	require.NoError(t, err)
+
+	// Run `terraform apply` to create project, database, and backup policies
+	_, err = tf.Apply()
+	defer tf.DestroySilently()
+	require.NoError(t, err)
+
+	// Check that the database is only selected by the project policy
+	tf.CheckStateResource(t, "data.nuodbaas_backuppolicy_databases.proj_pol").
+		HasAttributeValue("organization", projPolicy.Organization).
+		HasAttributeValue("policy", projPolicy.Name).
+		HasAttributeValue("databases", []any{
+			map[string]any{
+				"organization": vars.database.Organization,
+				"project":      vars.database.Project,
+				"name":         vars.database.Name,
+				"details":      nil,
+			},
+		})
+	tf.CheckStateResource(t, "data.nuodbaas_backuppolicy_databases.prod_pol").
+		HasAttributeValue("databases", nil)
+
+	// Run `terraform destroy` to delete policies, database, and project
+	_, err = tf.Destroy()
+	require.NoError(t, err)
+}
+
 func TestImportBackupPolicy(t *testing.T) {
 	// Skip test if /backuppolicies resource is not implemented by REST server
 	var providerCfg NuoDbaasProviderModel
@@ -770,3 +959,137 @@ func TestImportBackupPolicy(t *testing.T) {
 	require.Contains(t, string(out), "No changes.")
 	require.Contains(t, string(out), "Your infrastructure matches the configuration.")
 }
+
+func TestBackupPolicyBackupsRetainedAs(t *testing.T) {
+	// Create server that returns backups created by a backup policy, one of
+	// which is deleted after being listed, and that records the backups
+	// that are requested individually
+	backups := map[string]map[string]any{
+		"org/proj/db/backup1": {
+			"organization": "org",
+			"project":      "proj",
+			"database":     "db",
+			"name":         "backup1",
+			"status":       map[string]any{"state": "Succeeded", "retainedAs": []string{"daily", "weekly"}},
+		},
+		"org/proj/db/backup2": {
+			"organization": "org",
+			"project":      "proj",
+			"database":     "db",
+			"name":         "backup2",
+			"status":       map[string]any{"state": "Succeeded"},
+		},
+	}
+	names := []string{"org/proj/db/backup1", "org/proj/db/backup2", "org/proj/db/deleted"}
+	var lock sync.Mutex
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/backuppolicies/org/pol/backups":
+			var items []any
+			for _, name := range names {
+				if backup, ok := backups[name]; !ok {
+					continue
+				} else if r.URL.Query().Get("expand") == "true" {
+					item := map[string]any{"$ref": name}
+					for key, value := range backup {
+						item[key] = value
+					}
+					items = append(items, item)
+				} else {
+					items = append(items, name)
+				}
+			}
+			// Include deleted backup only if not expanded, since expanded
+			// payloads reflect the backups that exist at the time of the
+			// request
+			if r.URL.Query().Get("expand") != "true" {
+				items = append(items, "org/proj/db/deleted")
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": items})
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/backups/"):
+			name := strings.TrimPrefix(r.URL.Path, "/backups/")
+			lock.Lock()
+			requested = append(requested, name)
+			lock.Unlock()
+			if backup, ok := backups[name]; ok {
+				_ = json.NewEncoder(w).Encode(backup)
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":"HTTP_ERROR","status":"HTTP 404 Not Found"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":"HTTP_ERROR","status":"HTTP 404 Not Found"}`))
+		}
+	}))
+	defer server.Close()
+
+	// Create provider server that runs within test
+	ctx := context.Background()
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace with data sources that list backups created
+	// by the policy with and without expanding them
+	tf := CreateTerraformWorkspace(t)
+	err := tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+	providerCfg := NuoDbaasProviderModel{
+		UrlBase:  ptr(server.URL),
+		User:     ptr("org/user"),
+		Password: ptr("secret"),
+	}
+	tf.WriteConfigT(t, NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).
+		WithBackupPolicyBackupsDataSource("pol_backups", &BackupPolicyBackupsDataSourceModel{
+			Organization: "org",
+			Policy:       "pol",
+		}).
+		WithBackupPolicyBackupsDataSource("expanded", &BackupPolicyBackupsDataSourceModel{
+			Organization: "org",
+			Policy:       "pol",
+			Expand:       ptr(true),
+		}).Build())
+	_, err = tf.Init()
+	require.NoError(t, err)
+
+	// Run `terraform apply` and check that the retention cycles of each
+	// backup are populated, and that the deleted backup was skipped
+	_, err = tf.Apply()
+	require.NoError(t, err)
+	tf.CheckStateResource(t, "data.nuodbaas_backuppolicy_backups.pol_backups").
+		HasAttributeValue("backups", []any{
+			map[string]any{
+				"organization": "org",
+				"project":      "proj",
+				"database":     "db",
+				"name":         "backup1",
+				"retained_as":  []any{"daily", "weekly"},
+				"details":      nil,
+			},
+			map[string]any{
+				"organization": "org",
+				"project":      "proj",
+				"database":     "db",
+				"name":         "backup2",
+				"retained_as":  []any{},
+				"details":      nil,
+			},
+		})
+	tf.CheckStateResource(t, "data.nuodbaas_backuppolicy_backups.expanded").
+		ForEach("backups", 2, func(ac *AttributeChecker) {
+			ac.HasAttribute("details")
+		}).
+		HasAttributeValue("backups.0.name", "backup1").
+		HasAttributeValue("backups.0.retained_as", []any{"daily", "weekly"}).
+		HasAttributeValue("backups.0.details.status.retained_as", []any{"daily", "weekly"}).
+		HasAttributeValue("backups.1.name", "backup2").
+		HasAttributeValue("backups.1.retained_as", []any{})
+
+	// Check that backups were only requested individually if they were not
+	// expanded
+	lock.Lock()
+	defer lock.Unlock()
+	require.ElementsMatch(t, []string{"org/proj/db/backup1", "org/proj/db/backup2", "org/proj/db/deleted"}, requested)
+}
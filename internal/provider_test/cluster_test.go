@@ -0,0 +1,790 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package provider_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/framework"
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/helper"
+	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider"
+	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/canaryrollout"
+	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/databasequota"
+	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/helmfeature"
+	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/roletemplate"
+	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/servicetier"
+	"github.com/nuodb/terraform-provider-nuodbaas/openapi"
+
+	"github.com/stretchr/testify/require"
+)
+
+// skipIfClusterResourceNotAccessible skips the test if the server does not
+// support the cluster-scoped resource or if the user is not permitted to
+// manage cluster-scoped resources.
+func skipIfClusterResourceNotAccessible(t *testing.T, requestFn func() (*http.Response, error)) {
+	resp, err := requestFn()
+	if resp != nil && resp.StatusCode == http.StatusForbidden {
+		t.Skipf("User is not permitted to access '%s %s'", resp.Request.Method, resp.Request.URL.Path)
+	}
+	skipIfNotSupported(t, func() (*http.Response, error) {
+		return resp, err
+	})
+}
+
+func newServiceTier() *ServiceTierResourceModel {
+	// Generate a random service tier name to avoid collisions
+	tierName := withRandomSuffix("n0.tier")
+	return &ServiceTierResourceModel{
+		Name:        tierName,
+		Description: ptr("Service tier for testing"),
+		Spec: openapi.ServiceTierSpec{
+			Features: &[]openapi.Features{
+				{Name: "minimal-resources"},
+			},
+		},
+	}
+}
+
+func TestServiceTier(t *testing.T) {
+	// Skip test if /cluster/servicetiers resource is not accessible
+	var providerCfg NuoDbaasProviderModel
+	client, err := providerCfg.CreateClient()
+	require.NoError(t, err)
+	ctx := context.Background()
+	skipIfClusterResourceNotAccessible(t, func() (*http.Response, error) {
+		return client.GetServiceTiers(ctx, nil)
+	})
+
+	// Create provider server that runs within test
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace and initialize it with config
+	tf := CreateTerraformWorkspace(t)
+	err = tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+
+	tier := newServiceTier()
+	builder := NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).
+		WithServiceTierResource("tier", tier)
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Init()
+	require.NoError(t, err)
+
+	// Run `terraform apply` to create service tier
+	_, err = tf.Apply()
+	defer tf.DestroySilently()
+	require.NoError(t, err)
+
+	// Check attributes in resource
+	tf.CheckStateResource(t, "nuodbaas_service_tier.tier").
+		HasAttributeValue("name", tier.Name).
+		HasAttributeValue("description", *tier.Description).
+		HasAttributeValue("spec.features", []any{
+			map[string]any{
+				"name":      "minimal-resources",
+				"namespace": nil,
+				"revision":  nil,
+			},
+		})
+
+	// Update service tier resource
+	tier.Description = ptr("Updated service tier for testing")
+	tf.WriteConfigT(t, builder.Build())
+
+	// Run `terraform apply` again to update resource
+	out, err := tf.Apply()
+	require.NoError(t, err)
+	require.Contains(t, string(out), "nuodbaas_service_tier.tier: Modifying...")
+	tf.CheckStateResource(t, "nuodbaas_service_tier.tier").
+		HasAttributeValue("description", *tier.Description)
+
+	// Remove service tier from state and import it by name
+	_, err = tf.Run("state", "rm", "nuodbaas_service_tier.tier")
+	require.NoError(t, err)
+	out, err = tf.Run("import", "nuodbaas_service_tier.tier", tier.Name)
+	require.NoError(t, err)
+	require.Contains(t, string(out), "Import successful!")
+	tf.CheckStateResource(t, "nuodbaas_service_tier.tier").
+		HasAttributeValue("name", tier.Name).
+		HasAttributeValue("description", *tier.Description)
+
+	// Run `terraform destroy` to delete service tier
+	_, err = tf.Destroy()
+	require.NoError(t, err)
+
+	// Obtain actual service tier state and check that 404 is returned
+	actualTier := *tier
+	err = actualTier.Read(ctx, client)
+	require.Error(t, err)
+	require.True(t, helper.IsNotFound(err), "Unexpected error: "+err.Error())
+}
+
+func newDatabaseQuota() *DatabaseQuotaResourceModel {
+	// Generate a random database quota name to avoid collisions
+	quotaName := withRandomSuffix("quota")
+	return &DatabaseQuotaResourceModel{
+		Name: quotaName,
+		Spec: openapi.DatabaseQuotaSpec{
+			Hard: &map[string]openapi.IntOrString{
+				"databases": "100",
+			},
+			Scope: &openapi.Scope{
+				GroupByLabels: &[]string{"cp.nuodb.com/organization"},
+			},
+		},
+	}
+}
+
+func TestDatabaseQuota(t *testing.T) {
+	// Skip test if /cluster/databasequotas resource is not accessible
+	var providerCfg NuoDbaasProviderModel
+	client, err := providerCfg.CreateClient()
+	require.NoError(t, err)
+	ctx := context.Background()
+	skipIfClusterResourceNotAccessible(t, func() (*http.Response, error) {
+		return client.GetDatabaseQuotas(ctx, nil)
+	})
+
+	// Disable readiness check so that quota controller does not have to be
+	// enabled
+	providerCfg.Timeouts = map[string]framework.OperationTimeouts{
+		"database_quota": {
+			Create: ptr("0"),
+			Update: ptr("0"),
+		},
+	}
+
+	// Create provider server that runs within test
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace and initialize it with config
+	tf := CreateTerraformWorkspace(t)
+	err = tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+
+	quota := newDatabaseQuota()
+	builder := NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).
+		WithDatabaseQuotaResource("quota", quota)
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Init()
+	require.NoError(t, err)
+
+	// Run `terraform apply` to create database quota
+	_, err = tf.Apply()
+	defer tf.DestroySilently()
+	require.NoError(t, err)
+
+	// Check attributes in resource
+	tf.CheckStateResource(t, "nuodbaas_database_quota.quota").
+		HasAttributeValue("name", quota.Name).
+		HasAttributeValue("spec.hard", map[string]any{"databases": "100"}).
+		HasAttributeValue("spec.scope.group_by_labels", []any{"cp.nuodb.com/organization"})
+
+	// Update database quota resource
+	quota.Spec.Hard = &map[string]openapi.IntOrString{
+		"databases": "50",
+	}
+	tf.WriteConfigT(t, builder.Build())
+
+	// Run `terraform apply` again to update resource
+	out, err := tf.Apply()
+	require.NoError(t, err)
+	require.Contains(t, string(out), "nuodbaas_database_quota.quota: Modifying...")
+	tf.CheckStateResource(t, "nuodbaas_database_quota.quota").
+		HasAttributeValue("spec.hard", map[string]any{"databases": "50"})
+
+	// Remove database quota from state and import it by name
+	_, err = tf.Run("state", "rm", "nuodbaas_database_quota.quota")
+	require.NoError(t, err)
+	out, err = tf.Run("import", "nuodbaas_database_quota.quota", quota.Name)
+	require.NoError(t, err)
+	require.Contains(t, string(out), "Import successful!")
+	tf.CheckStateResource(t, "nuodbaas_database_quota.quota").
+		HasAttributeValue("name", quota.Name).
+		HasAttributeValue("spec.hard", map[string]any{"databases": "50"})
+
+	// Run `terraform destroy` to delete database quota
+	_, err = tf.Destroy()
+	require.NoError(t, err)
+
+	// Obtain actual database quota state and check that 404 is returned
+	actualQuota := *quota
+	err = actualQuota.Read(ctx, client)
+	require.Error(t, err)
+	require.True(t, helper.IsNotFound(err), "Unexpected error: "+err.Error())
+}
+
+func TestDatabaseQuotaCheckReady(t *testing.T) {
+	// Create server that stores a database quota whose status was observed
+	// by the quota controller at generation 1
+	stored := map[string]any{
+		"name":            "quota",
+		"resourceVersion": "1",
+		"spec":            map[string]any{"hard": map[string]any{"databases": "100"}},
+		"status":          map[string]any{"observedGeneration": 1},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/cluster/databasequotas/quota":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(stored)
+		case r.Method == http.MethodPut && r.URL.Path == "/cluster/databasequotas/quota":
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	providerCfg := NuoDbaasProviderModel{
+		UrlBase:  ptr(server.URL),
+		User:     ptr("org/user"),
+		Password: ptr("secret"),
+	}
+	client, err := providerCfg.CreateClient()
+	require.NoError(t, err)
+	ctx := context.Background()
+	quota := func(databases openapi.IntOrString, observedGeneration int64) *DatabaseQuotaResourceModel {
+		return &DatabaseQuotaResourceModel{
+			Name: "quota",
+			Spec: openapi.DatabaseQuotaSpec{
+				Hard: &map[string]openapi.IntOrString{"databases": databases},
+			},
+			Status: &openapi.DatabaseQuotaStatus{
+				ObservedGeneration: &observedGeneration,
+			},
+		}
+	}
+
+	// Update description only and check that status of the generation that
+	// was observed before the update is current
+	current := quota("100", 1)
+	described := quota("100", 1)
+	described.Description = ptr("description")
+	err = described.Update(ctx, client, current)
+	require.NoError(t, err)
+	require.NoError(t, quota("100", 1).CheckReady(ctx, nil))
+
+	// Update spec and check that status of the generation that was observed
+	// before the update is not current, until a newer generation is observed
+	err = quota("50", 1).Update(ctx, client, current)
+	require.NoError(t, err)
+	err = quota("50", 1).CheckReady(ctx, nil)
+	require.ErrorContains(t, err, "has not been observed by the quota controller since it was updated")
+	require.NoError(t, quota("50", 2).CheckReady(ctx, nil))
+}
+
+func newHelmFeature() *HelmFeatureResourceModel {
+	// Generate a random Helm feature name to avoid collisions
+	featureName := withRandomSuffix("feature")
+	// Use non-canonical JSON encoding for values, with extra whitespace and
+	// keys that are not in sorted order
+	values := openapi.JsonValue(`{"admin": {"replicas": 1, "affinity": {}}}`)
+	return &HelmFeatureResourceModel{
+		Name:        featureName,
+		Description: ptr("Helm feature for testing"),
+		Spec: openapi.HelmFeatureSpec{
+			Values: &values,
+		},
+	}
+}
+
+func TestHelmFeature(t *testing.T) {
+	// Skip test if /cluster/helmfeatures resource is not accessible
+	var providerCfg NuoDbaasProviderModel
+	client, err := providerCfg.CreateClient()
+	require.NoError(t, err)
+	ctx := context.Background()
+	skipIfClusterResourceNotAccessible(t, func() (*http.Response, error) {
+		return client.GetHelmFeatures(ctx, nil)
+	})
+
+	// Create provider server that runs within test
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace and initialize it with config
+	tf := CreateTerraformWorkspace(t)
+	err = tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+
+	feature := newHelmFeature()
+	builder := NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).
+		WithHelmFeatureResource("feature", feature)
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Init()
+	require.NoError(t, err)
+
+	// Run `terraform apply` to create Helm feature
+	_, err = tf.Apply()
+	defer tf.DestroySilently()
+	require.NoError(t, err)
+
+	// Check attributes in resource. The values should be stored exactly as
+	// they appear in the configuration.
+	tf.CheckStateResource(t, "nuodbaas_helm_feature.feature").
+		HasAttributeValue("name", feature.Name).
+		HasAttributeValue("description", *feature.Description).
+		HasAttributeValue("spec.values", string(*feature.Spec.Values))
+
+	// Run `terraform apply` again and verify that it does nothing, even
+	// though the values returned by the server are encoded differently
+	out, err := tf.Apply()
+	require.NoError(t, err)
+	require.Contains(t, string(out), "No changes.")
+	require.Contains(t, string(out), "Your infrastructure matches the configuration.")
+
+	// Update Helm feature resource with parameterized values
+	values := openapi.JsonValue(`{"admin":{"replicas":"<< .meta.params.replicas >>"}}`)
+	feature.Spec.Values = &values
+	feature.Spec.Parameters = &map[string]openapi.Parameters{
+		"replicas": {
+			Default:     ptr("1"),
+			Description: ptr("The number of admin replicas"),
+		},
+	}
+	tf.WriteConfigT(t, builder.Build())
+
+	// Run `terraform apply` again to update resource
+	out, err = tf.Apply()
+	require.NoError(t, err)
+	require.Contains(t, string(out), "nuodbaas_helm_feature.feature: Modifying...")
+	tf.CheckStateResource(t, "nuodbaas_helm_feature.feature").
+		HasAttributeValue("spec.values", string(values)).
+		HasAttributeValue("spec.parameters.replicas.default", "1")
+
+	// Remove Helm feature from state and import it by name
+	_, err = tf.Run("state", "rm", "nuodbaas_helm_feature.feature")
+	require.NoError(t, err)
+	out, err = tf.Run("import", "nuodbaas_helm_feature.feature", feature.Name)
+	require.NoError(t, err)
+	require.Contains(t, string(out), "Import successful!")
+	tf.CheckStateResource(t, "nuodbaas_helm_feature.feature").
+		HasAttributeValue("name", feature.Name).
+		HasAttributeValue("spec.parameters.replicas.default", "1")
+
+	// Run `terraform apply` again after import and verify that it does
+	// nothing, which means that the imported values are equivalent to the
+	// ones in the configuration
+	out, err = tf.Apply()
+	require.NoError(t, err)
+	require.Contains(t, string(out), "No changes.")
+
+	// Run `terraform destroy` to delete Helm feature
+	_, err = tf.Destroy()
+	require.NoError(t, err)
+
+	// Obtain actual Helm feature state and check that 404 is returned
+	actualFeature := *feature
+	err = actualFeature.Read(ctx, client)
+	require.Error(t, err)
+	require.True(t, helper.IsNotFound(err), "Unexpected error: "+err.Error())
+}
+
+func newRoleTemplate() *RoleTemplateResourceModel {
+	// Generate a random role template name to avoid collisions
+	templateName := withRandomSuffix("role")
+	return &RoleTemplateResourceModel{
+		Name:        templateName,
+		Description: ptr("Role template for testing"),
+		Spec: openapi.RoleTemplateSpec{
+			Allow: &[]openapi.Allow{
+				{Verb: "read", Resource: "{organization}"},
+			},
+		},
+	}
+}
+
+func TestRoleTemplate(t *testing.T) {
+	// Skip test if /cluster/roletemplates resource is not accessible
+	var providerCfg NuoDbaasProviderModel
+	client, err := providerCfg.CreateClient()
+	require.NoError(t, err)
+	ctx := context.Background()
+	skipIfClusterResourceNotAccessible(t, func() (*http.Response, error) {
+		return client.GetRoleTemplates(ctx, nil)
+	})
+
+	// Create provider server that runs within test
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace and initialize it with config
+	tf := CreateTerraformWorkspace(t)
+	err = tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+
+	// Specify role template with invalid verb and resource specifier
+	template := newRoleTemplate()
+	template.Spec.Allow = &[]openapi.Allow{
+		{Verb: "admin", Resource: "org/proj/db/extra"},
+	}
+	builder := NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).
+		WithRoleTemplateResource("template", template)
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Init()
+	require.NoError(t, err)
+
+	// Run `terraform plan` and verify that it fails due to validation
+	out, err := tf.Plan()
+	require.Error(t, err)
+	require.Contains(t, string(out), "spec.allow[0].verb")
+	require.Contains(t, string(out), "value must be one of")
+	require.Contains(t, string(out), "spec.allow[0].resource")
+
+	// Fix role template and run `terraform apply` to create it
+	template.Spec.Allow = &[]openapi.Allow{
+		{Verb: "read", Resource: "{organization}"},
+	}
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Apply()
+	defer tf.DestroySilently()
+	require.NoError(t, err)
+
+	// Check attributes in resource
+	tf.CheckStateResource(t, "nuodbaas_role_template.template").
+		HasAttributeValue("name", template.Name).
+		HasAttributeValue("description", *template.Description).
+		HasAttributeValue("spec.allow", []any{
+			map[string]any{
+				"verb":     "read",
+				"resource": "{organization}",
+				"sla":      nil,
+			},
+		})
+
+	// Update role template resource
+	template.Spec.Allow = &[]openapi.Allow{
+		{Verb: "read", Resource: "{organization}"},
+		{Verb: "all", Resource: "{organization}/{project}", Sla: ptr("dev")},
+	}
+	tf.WriteConfigT(t, builder.Build())
+
+	// Run `terraform apply` again to update resource
+	out, err = tf.Apply()
+	require.NoError(t, err)
+	require.Contains(t, string(out), "nuodbaas_role_template.template: Modifying...")
+	tf.CheckStateResource(t, "nuodbaas_role_template.template").
+		HasAttributeValue("spec.allow", []any{
+			map[string]any{
+				"verb":     "read",
+				"resource": "{organization}",
+				"sla":      nil,
+			},
+			map[string]any{
+				"verb":     "all",
+				"resource": "{organization}/{project}",
+				"sla":      "dev",
+			},
+		})
+
+	// Remove role template from state and import it by name
+	_, err = tf.Run("state", "rm", "nuodbaas_role_template.template")
+	require.NoError(t, err)
+	out, err = tf.Run("import", "nuodbaas_role_template.template", template.Name)
+	require.NoError(t, err)
+	require.Contains(t, string(out), "Import successful!")
+	tf.CheckStateResource(t, "nuodbaas_role_template.template").
+		HasAttributeValue("name", template.Name).
+		HasAttributeValue("description", *template.Description)
+
+	// Run `terraform destroy` to delete role template
+	_, err = tf.Destroy()
+	require.NoError(t, err)
+
+	// Obtain actual role template state and check that 404 is returned
+	actualTemplate := *template
+	err = actualTemplate.Read(ctx, client)
+	require.Error(t, err)
+	require.True(t, helper.IsNotFound(err), "Unexpected error: "+err.Error())
+}
+
+func newCanaryRolloutTemplate() *CanaryRolloutTemplateResourceModel {
+	// Generate a random canary rollout template name to avoid collisions
+	templateName := withRandomSuffix("template")
+	return &CanaryRolloutTemplateResourceModel{
+		Name:        templateName,
+		Description: ptr("Canary rollout template for testing"),
+		Spec: openapi.CanaryRolloutTemplateSpec{
+			Steps: []openapi.Steps{
+				{
+					PromoteTo: &openapi.PromoteTo{
+						LimitCount: ptr(int32(1)),
+						Rollback: &openapi.Rollback{
+							Strategy: openapi.RollbackStrategyStep,
+						},
+					},
+				},
+				{
+					Pause: &openapi.Pause{
+						Duration: ptr("1m"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func newCanaryRollout(template *CanaryRolloutTemplateResourceModel) *CanaryRolloutResourceModel {
+	// Generate a random canary rollout name to avoid collisions, and use it
+	// in the selector so that the rollout does not match any resources
+	rolloutName := withRandomSuffix("rollout")
+	return &CanaryRolloutResourceModel{
+		Name: rolloutName,
+		Spec: openapi.CanaryRolloutSpec{
+			RolloutTemplate: openapi.RolloutTemplate{
+				Name: template.Name,
+			},
+			Selector: &openapi.CanaryrolloutspecSelector{
+				MatchLabels: &map[string]string{
+					"rollout": rolloutName,
+				},
+			},
+			Patch: openapi.JsonValue(`{"spec":{"productVersion":"6.0"}}`),
+		},
+	}
+}
+
+func TestCanaryRollout(t *testing.T) {
+	// Skip test if /cluster/canaryrollouttemplates and /cluster/canaryrollouts
+	// resources are not accessible
+	var providerCfg NuoDbaasProviderModel
+	client, err := providerCfg.CreateClient()
+	require.NoError(t, err)
+	ctx := context.Background()
+	skipIfClusterResourceNotAccessible(t, func() (*http.Response, error) {
+		return client.GetCanaryRolloutTemplates(ctx, nil)
+	})
+	skipIfClusterResourceNotAccessible(t, func() (*http.Response, error) {
+		return client.GetCanaryRollouts(ctx, nil)
+	})
+
+	// Disable readiness check so that rollout controller does not have to be
+	// enabled
+	providerCfg.Timeouts = map[string]framework.OperationTimeouts{
+		"canary_rollout": {
+			Create: ptr("0"),
+			Update: ptr("0"),
+		},
+	}
+
+	// Create provider server that runs within test
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace and initialize it with config
+	tf := CreateTerraformWorkspace(t)
+	err = tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+
+	template := newCanaryRolloutTemplate()
+	rollout := newCanaryRollout(template)
+	builder := NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).
+		WithCanaryRolloutTemplateResource("template", template).
+		WithCanaryRolloutResource("rollout", rollout, "nuodbaas_canary_rollout_template.template")
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Init()
+	require.NoError(t, err)
+
+	// Run `terraform apply` to create canary rollout template and rollout
+	_, err = tf.Apply()
+	defer tf.DestroySilently()
+	require.NoError(t, err)
+
+	// Check attributes in resources
+	tf.CheckStateResource(t, "nuodbaas_canary_rollout_template.template").
+		HasAttributeValue("name", template.Name).
+		HasAttributeValue("description", *template.Description).
+		HasAttributeValue("spec.steps.0.promote_to.limit_count", float64(1)).
+		HasAttributeValue("spec.steps.0.promote_to.rollback.strategy", "Step").
+		HasAttributeValue("spec.steps.1.pause.duration", "1m")
+	tf.CheckStateResource(t, "nuodbaas_canary_rollout.rollout").
+		HasAttributeValue("name", rollout.Name).
+		HasAttributeValue("spec.rollout_template.name", template.Name).
+		HasAttributeValue("spec.selector.match_labels", map[string]any{"rollout": rollout.Name}).
+		HasAttributeValue("spec.patch", string(rollout.Spec.Patch))
+
+	// Update canary rollout template and rollout resources
+	template.Spec.Steps = append(template.Spec.Steps, openapi.Steps{
+		PromoteTo: &openapi.PromoteTo{
+			LimitPercentage: ptr(int32(100)),
+		},
+	})
+	rollout.Spec.Patch = openapi.JsonValue(`{"spec": {"productVersion": "6.1"}}`)
+	tf.WriteConfigT(t, builder.Build())
+
+	// Run `terraform apply` again to update resources
+	out, err := tf.Apply()
+	require.NoError(t, err)
+	require.Contains(t, string(out), "nuodbaas_canary_rollout_template.template: Modifying...")
+	require.Contains(t, string(out), "nuodbaas_canary_rollout.rollout: Modifying...")
+	tf.CheckStateResource(t, "nuodbaas_canary_rollout_template.template").
+		HasAttributeValue("spec.steps.2.promote_to.limit_percentage", float64(100))
+	tf.CheckStateResource(t, "nuodbaas_canary_rollout.rollout").
+		HasAttributeValue("spec.patch", string(rollout.Spec.Patch))
+
+	// Run `terraform apply` again and verify that it does nothing
+	out, err = tf.Apply()
+	require.NoError(t, err)
+	require.Contains(t, string(out), "No changes.")
+
+	// Remove canary rollout from state and import it by name
+	_, err = tf.Run("state", "rm", "nuodbaas_canary_rollout.rollout")
+	require.NoError(t, err)
+	out, err = tf.Run("import", "nuodbaas_canary_rollout.rollout", rollout.Name)
+	require.NoError(t, err)
+	require.Contains(t, string(out), "Import successful!")
+	tf.CheckStateResource(t, "nuodbaas_canary_rollout.rollout").
+		HasAttributeValue("name", rollout.Name).
+		HasAttributeValue("spec.rollout_template.name", template.Name)
+
+	// Run `terraform destroy` to delete canary rollout and template
+	_, err = tf.Destroy()
+	require.NoError(t, err)
+
+	// Obtain actual canary rollout and template state and check that 404 is
+	// returned
+	actualRollout := *rollout
+	err = actualRollout.Read(ctx, client)
+	require.Error(t, err)
+	require.True(t, helper.IsNotFound(err), "Unexpected error: "+err.Error())
+	actualTemplate := *template
+	err = actualTemplate.Read(ctx, client)
+	require.Error(t, err)
+	require.True(t, helper.IsNotFound(err), "Unexpected error: "+err.Error())
+}
+
+func TestCanaryRolloutCheckReady(t *testing.T) {
+	ctx := context.Background()
+	condition := func(conditionType string, status openapi.CanaryrolloutstatusConditionsStatus, reason string, generation int64) openapi.CanaryrolloutstatusConditions {
+		return openapi.CanaryrolloutstatusConditions{
+			Type:               conditionType,
+			Status:             status,
+			Reason:             reason,
+			Message:            "message",
+			ObservedGeneration: &generation,
+		}
+	}
+	rollout := func(conditions ...openapi.CanaryrolloutstatusConditions) *CanaryRolloutResourceModel {
+		return &CanaryRolloutResourceModel{
+			Name: "rollout",
+			Status: &openapi.CanaryRolloutStatus{
+				Conditions:         &conditions,
+				ObservedGeneration: ptr(int64(2)),
+			},
+		}
+	}
+	ready := condition("Ready", openapi.CanaryrolloutstatusConditionsStatusTrue, "Promoted", 2)
+
+	// Check that rollout that was rolled back in the current generation fails
+	err := rollout(ready, condition("RolledBack", openapi.CanaryrolloutstatusConditionsStatusTrue, "StepFailed", 2)).CheckReady(ctx, nil)
+	require.ErrorContains(t, err, "was rolled back")
+
+	// Check that rollout that was rolled back in a previous generation, or
+	// has a RolledBack condition that is not True or a RolledBack reason on
+	// another condition, is ready
+	err = rollout(ready, condition("RolledBack", openapi.CanaryrolloutstatusConditionsStatusTrue, "StepFailed", 1)).CheckReady(ctx, nil)
+	require.NoError(t, err)
+	err = rollout(ready, condition("RolledBack", openapi.CanaryrolloutstatusConditionsStatusFalse, "RolledBack", 2)).CheckReady(ctx, nil)
+	require.NoError(t, err)
+	err = rollout(ready, condition("Progressing", openapi.CanaryrolloutstatusConditionsStatusFalse, "RolledBack", 2)).CheckReady(ctx, nil)
+	require.NoError(t, err)
+}
+
+func TestCanaryRolloutConditions(t *testing.T) {
+	// Create server that returns canary rollouts with the status set by the
+	// canary rollout controller once the change was promoted to all targets,
+	// and once it was rolled back due to a failed step
+	statuses := map[string]string{
+		"promoted": `{
+			"conditions": [
+				{"type": "Ready", "status": "True", "reason": "Promoted", "message": "Rollout completed", "lastTransitionTime": "2024-01-01T00:00:00Z", "observedGeneration": 1}
+			],
+			"currentStepIndex": 2,
+			"observedGeneration": 1
+		}`,
+		"rolledback": `{
+			"conditions": [
+				{"type": "Ready", "status": "False", "reason": "RolledBack", "message": "Rollout failed", "lastTransitionTime": "2024-01-01T00:00:00Z", "observedGeneration": 1},
+				{"type": "RolledBack", "status": "True", "reason": "StepFailed", "message": "Analysis run failed", "lastTransitionTime": "2024-01-01T00:00:00Z", "observedGeneration": 1}
+			],
+			"currentStepIndex": 0,
+			"observedGeneration": 1
+		}`,
+	}
+	var lock sync.Mutex
+	rollouts := make(map[string]map[string]any)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		name := strings.TrimPrefix(r.URL.Path, "/cluster/canaryrollouts/")
+		switch {
+		case r.Method == http.MethodPut && statuses[name] != "":
+			var rollout map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&rollout)
+			rollout["resourceVersion"] = "1"
+			var status map[string]any
+			_ = json.Unmarshal([]byte(statuses[name]), &status)
+			rollout["status"] = status
+			rollouts[name] = rollout
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && rollouts[name] != nil:
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(rollouts[name])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	// Create provider server that runs within test
+	ctx := context.Background()
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace with both canary rollouts
+	tf := CreateTerraformWorkspace(t)
+	err := tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+	providerCfg := NuoDbaasProviderModel{
+		UrlBase:  ptr(server.URL),
+		User:     ptr("org/user"),
+		Password: ptr("secret"),
+	}
+	builder := NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg)
+	for name := range statuses {
+		builder.WithCanaryRolloutResource(name, &CanaryRolloutResourceModel{
+			Name: name,
+			Spec: openapi.CanaryRolloutSpec{
+				RolloutTemplate: openapi.RolloutTemplate{Name: "template"},
+				Patch:           openapi.JsonValue(`{"spec":{"productVersion":"6.0"}}`),
+			},
+		})
+	}
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Init()
+	require.NoError(t, err)
+
+	// Run `terraform apply` and check that the promoted rollout became ready
+	// and that the rolled back rollout failed immediately, rather than after
+	// remaining in a failed state for some time
+	start := time.Now()
+	out, err := tf.Apply()
+	require.Error(t, err)
+	require.Less(t, time.Since(start), framework.DEFAULT_POLLING_INTERVAL)
+	require.Contains(t, string(out), "Canary rollout rolledback was rolled back: Analysis run failed")
+	require.NotContains(t, string(out), "Canary rollout promoted")
+	tf.CheckStateResource(t, "nuodbaas_canary_rollout.promoted").
+		HasAttributeValue("name", "promoted")
+}
@@ -44,13 +44,23 @@ func TestExamples(t *testing.T) {
 	reattachCfg, closeFn := CreateProviderServer(t, ctx)
 	defer closeFn()
 
-	// Disable readiness check for backup resource so that backup controller does not have to be enabled
+	// Disable readiness check for backup, database quota, and canary rollout
+	// resources so that backup, quota, and rollout controllers do not have to
+	// be enabled
 	var providerCfg NuoDbaasProviderModel
 	providerCfg.Timeouts = map[string]OperationTimeouts{
 		"backup": {
 			Create: ptr("0"),
 			Update: ptr("0"),
 		},
+		"database_quota": {
+			Create: ptr("0"),
+			Update: ptr("0"),
+		},
+		"canary_rollout": {
+			Create: ptr("0"),
+			Update: ptr("0"),
+		},
 	}
 
 	// Combine all example resource and data source configs
@@ -7,22 +7,40 @@ package provider_test
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	cryptorand "crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"math/big"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/nuodb/terraform-provider-nuodbaas/internal/framework"
 	"github.com/nuodb/terraform-provider-nuodbaas/internal/helper"
 	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider"
 	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/database"
 	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/project"
+	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/roletemplate"
 	"github.com/nuodb/terraform-provider-nuodbaas/openapi"
 
 	semver "github.com/Masterminds/semver/v3"
 	"github.com/stretchr/testify/require"
+	"github.com/tmaxmax/go-sse"
 )
 
 type TestOption string
@@ -1744,6 +1762,39 @@ func TestValidation(t *testing.T) {
 		require.Contains(t, string(out), errorDescription)
 	})
 
+	t.Run("partial client certificate", func(t *testing.T) {
+		// Clear any client certificate that might exist in the environment
+		t.Setenv(NUODB_CP_CLIENT_CERTIFICATE, "")
+		t.Setenv(NUODB_CP_CLIENT_KEY, "")
+
+		vars := newTestVars(false)
+
+		errorString := "Partial client certificate"
+		errorDescription := "To use mutual TLS authentication, both client certificate and key should be provided"
+
+		// Test client certificate without a key
+		vars.providerCfg.ClientCertificate = ptr("certificate")
+		tf.WriteConfigT(t, vars.builder.Build())
+
+		// Run `terraform validate`
+		out, err := tf.Validate()
+		require.Error(t, err)
+		require.Contains(t, string(out), errorString)
+		require.Contains(t, string(out), errorDescription)
+
+		vars.providerCfg.ClientCertificate = nil
+
+		// Test client key without a certificate, passed via the environment
+		t.Setenv(NUODB_CP_CLIENT_KEY, "key")
+		tf.WriteConfigT(t, vars.builder.Build())
+
+		// Run `terraform validate`
+		out, err = tf.Validate()
+		require.Error(t, err)
+		require.Contains(t, string(out), errorString)
+		require.Contains(t, string(out), errorDescription)
+	})
+
 	t.Run("validate url and timeout", func(t *testing.T) {
 		// There is more extensive testing in TestNegative so only test that
 		// they are checked by `terraform validate`
@@ -1794,4 +1845,1502 @@ func TestValidation(t *testing.T) {
 		_, err = tf.Validate()
 		require.NoError(t, err)
 	})
+
+	t.Run("validate role template verb", func(t *testing.T) {
+		vars := newTestVars(false)
+
+		// Specify role template rule with a verb that is not in the enum
+		template := &RoleTemplateResourceModel{
+			Name: "role",
+			Spec: openapi.RoleTemplateSpec{
+				Allow: &[]openapi.Allow{
+					{Verb: "admin", Resource: "{organization}"},
+				},
+			},
+		}
+		vars.builder.WithRoleTemplateResource("template", template)
+		tf.WriteConfigT(t, vars.builder.Build())
+
+		// Run `terraform validate`
+		out, err := tf.Validate()
+		require.Error(t, err)
+		require.Contains(t, string(out), "spec.allow[0].verb")
+		require.Contains(t, string(out), "value must be one of")
+
+		// Specify valid verb
+		(*template.Spec.Allow)[0].Verb = "read"
+		tf.WriteConfigT(t, vars.builder.Build())
+
+		// Run `terraform validate`
+		_, err = tf.Validate()
+		require.NoError(t, err)
+	})
+}
+
+// generateClientCertificate generates a self-signed client certificate and
+// returns the PEM-encoded certificate and private key.
+func generateClientCertificate(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), cryptorand.Reader)
+	require.NoError(t, err)
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "client"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	certBytes, err := x509.CreateCertificate(cryptorand.Reader, &template, &template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes})
+	return string(certPem), string(keyPem)
+}
+
+func TestTlsConfiguration(t *testing.T) {
+	// Create server that requires a client certificate
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/projects/org":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"items": ["proj"]}`))
+		case "/events/projects/org/proj":
+			w.Header().Set("Content-Type", "text/event-stream")
+			_, _ = w.Write([]byte("event: HEARTBEAT\ndata: {}\n\n"))
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	clientCert, clientKey := generateClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	require.True(t, clientCAs.AppendCertsFromPEM([]byte(clientCert)))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	// The server certificate is valid for example.com and 127.0.0.1, so use
+	// localhost as the host name to exercise tls_server_name
+	urlBase := strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+	serverCert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	require.NoError(t, os.WriteFile(caFile, []byte(serverCert), 0600))
+
+	// Create provider server that runs within test
+	ctx := context.Background()
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace and initialize it with config
+	tf := CreateTerraformWorkspace(t)
+	err := tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+	providerCfg := NuoDbaasProviderModel{
+		UrlBase:           ptr(urlBase),
+		User:              ptr("org/user"),
+		Password:          ptr("secret"),
+		CaCertificate:     ptr(serverCert),
+		ClientCertificate: ptr(clientCert),
+		ClientKey:         ptr(clientKey),
+		TlsServerName:     ptr("example.com"),
+	}
+	builder := NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).
+		WithProjectsDataSource("projects", &ProjectsDataSourceModel{
+			Filter: &ProjectFilterModel{
+				Organization: ptr("org"),
+			},
+		})
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Init()
+	require.NoError(t, err)
+
+	// Run `terraform apply` and check that data source was read
+	_, err = tf.Apply()
+	require.NoError(t, err)
+	tf.CheckStateResource(t, "data.nuodbaas_projects.projects").
+		HasAttributeValue("projects", []any{
+			map[string]any{
+				"organization": "org",
+				"name":         "proj",
+				"details":      nil,
+			},
+		})
+
+	// Check that SSE connections use the same TLS configuration
+	eventCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	var eventType string
+	err = providerCfg.ConsumeEvents(eventCtx, "/events/projects/org/proj", func(event sse.Event) {
+		eventType = event.Type
+		cancel()
+	})
+	require.NoError(t, err)
+	require.Equal(t, framework.SSE_EVENT_HEARTBEAT, eventType)
+
+	// Check that CA certificate can be supplied as a file
+	providerCfg.CaCertificate = nil
+	providerCfg.CaCertificateFile = ptr(caFile)
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Apply()
+	require.NoError(t, err)
+
+	// Check that server certificate is not trusted without the CA certificate
+	providerCfg.CaCertificateFile = nil
+	tf.WriteConfigT(t, builder.Build())
+	out, err := tf.Apply()
+	require.Error(t, err)
+	require.Contains(t, string(out), "certificate signed by unknown authority")
+
+	// Check that server certificate is not valid for the host name in the
+	// URL without the TLS server name
+	providerCfg.CaCertificateFile = ptr(caFile)
+	providerCfg.TlsServerName = nil
+	tf.WriteConfigT(t, builder.Build())
+	out, err = tf.Apply()
+	require.Error(t, err)
+	require.Contains(t, string(out), "not localhost")
+
+	// Check that request is rejected without a client certificate
+	providerCfg.TlsServerName = ptr("example.com")
+	providerCfg.ClientCertificate = nil
+	providerCfg.ClientKey = nil
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Apply()
+	require.Error(t, err)
+}
+
+func TestProviderDefaults(t *testing.T) {
+	// Create server that stores projects in memory and returns a database
+	var lock sync.Mutex
+	resources := map[string]map[string]any{
+		"/databases/org/proj/db": {
+			"organization": "org",
+			"project":      "proj",
+			"name":         "db",
+			"tier":         "n0.nano",
+		},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/projects/"):
+			var project map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&project)
+			project["resourceVersion"] = "1"
+			project["status"] = map[string]any{"state": "Available"}
+			resources[r.URL.Path] = project
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && resources[r.URL.Path] != nil:
+			_ = json.NewEncoder(w).Encode(resources[r.URL.Path])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":"HTTP_ERROR","status":"HTTP 404 Not Found"}`))
+		}
+	}))
+	defer server.Close()
+
+	// Create provider server that runs within test
+	ctx := context.Background()
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace and initialize it with config that does
+	// not specify organization or project for any resource or data source
+	tf := CreateTerraformWorkspace(t)
+	err := tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+	providerCfg := NuoDbaasProviderModel{
+		UrlBase:        ptr(server.URL),
+		User:           ptr("org/user"),
+		Password:       ptr("secret"),
+		DefaultProject: ptr("proj"),
+	}
+	resourceCfg := `
+resource "nuodbaas_project" "proj" {
+  name = "proj"
+  sla  = "dev"
+  tier = "n0.nano"
+}
+`
+	dataSourcesCfg := `
+data "nuodbaas_project" "proj" {
+  name = nuodbaas_project.proj.name
+}
+
+data "nuodbaas_database" "db" {
+  name = "db"
+}
+`
+	tf.WriteConfigT(t, NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).Build()+resourceCfg+dataSourcesCfg)
+	_, err = tf.Init()
+	require.NoError(t, err)
+
+	// Run `terraform apply` and check that organization defaults to the
+	// organization of the user
+	_, err = tf.Apply()
+	require.NoError(t, err)
+	tf.CheckStateResource(t, "nuodbaas_project.proj").
+		HasAttributeValue("organization", "org").
+		HasAttributeValue("name", "proj")
+	tf.CheckStateResource(t, "data.nuodbaas_project.proj").
+		HasAttributeValue("organization", "org").
+		HasAttributeValue("name", "proj")
+	tf.CheckStateResource(t, "data.nuodbaas_database.db").
+		HasAttributeValue("organization", "org").
+		HasAttributeValue("project", "proj").
+		HasAttributeValue("name", "db")
+
+	// Check that there is no diff when the default organization is
+	// specified explicitly
+	providerCfg.DefaultOrganization = ptr("org")
+	tf.WriteConfigT(t, NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).Build()+resourceCfg+dataSourcesCfg)
+	out, err := tf.Plan()
+	require.NoError(t, err)
+	require.Contains(t, string(out), "No changes.")
+
+	// Check that changing the default organization is treated as a change
+	// to the immutable organization attribute. Data sources are omitted
+	// because they are read during planning.
+	providerCfg.DefaultOrganization = ptr("other")
+	tf.WriteConfigT(t, NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).Build()+resourceCfg)
+	out, err = tf.Plan()
+	require.NoError(t, err)
+	require.Contains(t, string(out), "Immutable Attribute Change")
+
+	// Check that plan fails if there is no default organization
+	providerCfg.DefaultOrganization = nil
+	providerCfg.User = ptr("")
+	providerCfg.Password = ptr("")
+	providerCfg.Token = ptr("token")
+	tf.WriteConfigT(t, NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).Build()+resourceCfg)
+	out, err = tf.Plan()
+	require.Error(t, err)
+	require.Contains(t, string(out), "Missing organization")
+}
+
+// applyJsonPatch applies a JSON Patch containing test, add, replace and remove
+// operations on object fields to the supplied object, and increments its
+// resourceVersion. If a test operation fails, the object is not modified and
+// false is returned.
+func applyJsonPatch(t *testing.T, obj map[string]any, patch []map[string]any) bool {
+	for _, op := range patch {
+		if op["op"] == "test" {
+			require.Equal(t, "/resourceVersion", op["path"], "Unsupported test operation")
+			if op["value"] != obj["resourceVersion"] {
+				return false
+			}
+		}
+	}
+	for _, op := range patch {
+		segments := strings.Split(strings.TrimPrefix(op["path"].(string), "/"), "/")
+		for i, segment := range segments {
+			segments[i] = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+		}
+		parent := obj
+		for _, segment := range segments[:len(segments)-1] {
+			child, ok := parent[segment].(map[string]any)
+			require.True(t, ok, "No object at %s", op["path"])
+			parent = child
+		}
+		key := segments[len(segments)-1]
+		switch op["op"] {
+		case "test":
+		case "add", "replace":
+			parent[key] = op["value"]
+		case "remove":
+			delete(parent, key)
+		default:
+			require.Fail(t, "Unsupported patch operation", op["op"])
+		}
+	}
+	version, _ := strconv.Atoi(obj["resourceVersion"].(string))
+	obj["resourceVersion"] = strconv.Itoa(version + 1)
+	return true
+}
+
+// writeConcurrentUpdate writes the response for a request that was rejected
+// due to a concurrent update.
+func writeConcurrentUpdate(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusConflict)
+	_, _ = w.Write([]byte(`{"code":"CONCURRENT_UPDATE","status":"HTTP 409 Conflict","detail":"Resource version mismatch"}`))
+}
+
+func TestDefaultLabels(t *testing.T) {
+	// Create server that stores projects in memory and records the patches
+	// that were applied to them
+	var lock sync.Mutex
+	projects := make(map[string]map[string]any)
+	var patches []any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/projects/"):
+			var project map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&project)
+			project["resourceVersion"] = "1"
+			project["status"] = map[string]any{"state": "Available"}
+			projects[r.URL.Path] = project
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodPatch && projects[r.URL.Path] != nil:
+			var patch []map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&patch)
+			patches = append(patches, patch)
+			if !applyJsonPatch(t, projects[r.URL.Path], patch) {
+				writeConcurrentUpdate(w)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(projects[r.URL.Path])
+		case r.Method == http.MethodGet && projects[r.URL.Path] != nil:
+			_ = json.NewEncoder(w).Encode(projects[r.URL.Path])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":"HTTP_ERROR","status":"HTTP 404 Not Found"}`))
+		}
+	}))
+	defer server.Close()
+	getLabels := func(name string) any {
+		lock.Lock()
+		defer lock.Unlock()
+		return projects["/projects/org/"+name]["labels"]
+	}
+	getPatches := func() []any {
+		lock.Lock()
+		defer lock.Unlock()
+		ret := patches
+		patches = nil
+		return ret
+	}
+
+	// Create provider server that runs within test
+	ctx := context.Background()
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace and initialize it with config containing a
+	// project with labels and a project without labels
+	tf := CreateTerraformWorkspace(t)
+	err := tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+	providerCfg := NuoDbaasProviderModel{
+		UrlBase:  ptr(server.URL),
+		User:     ptr("org/user"),
+		Password: ptr("secret"),
+		DefaultLabels: &map[string]string{
+			"team": "db",
+			"env":  "dev",
+		},
+	}
+	labelled := &ProjectResourceModel{
+		Organization: "org",
+		Name:         "labelled",
+		Sla:          "dev",
+		Tier:         "n0.nano",
+		Labels: &map[string]string{
+			"env": "prod",
+			"app": "inventory",
+		},
+	}
+	unlabelled := &ProjectResourceModel{
+		Organization: "org",
+		Name:         "unlabelled",
+		Sla:          "dev",
+		Tier:         "n0.nano",
+	}
+	builder := NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).
+		WithProjectResource("labelled", labelled).
+		WithProjectResource("unlabelled", unlabelled)
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Init()
+	require.NoError(t, err)
+
+	// Run `terraform apply` and check that default labels were merged into
+	// the labels of each project, with configured labels taking precedence
+	_, err = tf.Apply()
+	require.NoError(t, err)
+	tf.CheckStateResource(t, "nuodbaas_project.labelled").
+		HasAttributeValue("labels", map[string]any{"env": "prod", "app": "inventory"}).
+		HasAttributeValue("labels_all", map[string]any{"team": "db", "env": "prod", "app": "inventory"})
+	tf.CheckStateResource(t, "nuodbaas_project.unlabelled").
+		HasAttributeValue("labels", nil).
+		HasAttributeValue("labels_all", map[string]any{"team": "db", "env": "dev"})
+	require.Equal(t, map[string]any{"team": "db", "env": "prod", "app": "inventory"}, getLabels("labelled"))
+	require.Equal(t, map[string]any{"team": "db", "env": "dev"}, getLabels("unlabelled"))
+
+	// Check that there is no diff between the configured and merged labels
+	out, err := tf.Plan()
+	require.NoError(t, err)
+	require.Contains(t, string(out), "No changes.")
+
+	// Change default labels and check that projects are updated
+	(*providerCfg.DefaultLabels)["team"] = "ops"
+	tf.WriteConfigT(t, builder.Build())
+	out, err = tf.Plan()
+	require.NoError(t, err)
+	require.Contains(t, string(out), "2 to change")
+	_, err = tf.Apply()
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"team": "ops", "env": "prod", "app": "inventory"}, getLabels("labelled"))
+	require.Equal(t, map[string]any{"team": "ops", "env": "dev"}, getLabels("unlabelled"))
+	// Check that only the changed label was sent to the server, guarded by
+	// the version of the project that was read before sending the patch
+	expectedPatch := []any{
+		[]map[string]any{
+			{"op": "test", "path": "/resourceVersion", "value": "1"},
+			{"op": "replace", "path": "/labels/team", "value": "ops"},
+		},
+	}
+	require.Equal(t, append(expectedPatch, expectedPatch...), getPatches())
+	out, err = tf.Plan()
+	require.NoError(t, err)
+	require.Contains(t, string(out), "No changes.")
+
+	// Remove default label from project on server and check that it is
+	// restored
+	lock.Lock()
+	delete(projects["/projects/org/unlabelled"]["labels"].(map[string]any), "team")
+	lock.Unlock()
+	out, err = tf.Plan()
+	require.NoError(t, err)
+	require.Contains(t, string(out), "1 to change")
+	_, err = tf.Apply()
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"team": "ops", "env": "dev"}, getLabels("unlabelled"))
+
+	// Remove default labels and check that they are removed from projects
+	providerCfg.DefaultLabels = nil
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Apply()
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"env": "prod", "app": "inventory"}, getLabels("labelled"))
+	tf.CheckStateResource(t, "nuodbaas_project.labelled").
+		HasAttributeValue("labels", map[string]any{"env": "prod", "app": "inventory"}).
+		HasAttributeValue("labels_all", map[string]any{"env": "prod", "app": "inventory"})
+}
+
+func TestPagination(t *testing.T) {
+	// Create server that returns a list of projects in pages, honoring the
+	// limit, cursor, expand and fieldFilter query parameters
+	numProjects := 7
+	var projects []string
+	for i := 0; i < numProjects; i++ {
+		projects = append(projects, fmt.Sprintf("proj%d", i))
+	}
+	var lock sync.Mutex
+	var limits []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodGet || r.URL.Path != "/projects/org" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":"HTTP_ERROR","status":"HTTP 404 Not Found"}`))
+			return
+		}
+		lock.Lock()
+		limits = append(limits, r.URL.Query().Get("limit"))
+		lock.Unlock()
+		matching := projects
+		if fieldFilter := r.URL.Query().Get("fieldFilter"); fieldFilter != "" {
+			matching = nil
+			for _, name := range projects {
+				if fieldFilter == "name="+name {
+					matching = append(matching, name)
+				}
+			}
+		}
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		if err != nil {
+			limit = len(matching)
+		}
+		start := 0
+		if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+			start = slices.Index(matching, cursor) + 1
+		}
+		end := min(start+limit, len(matching))
+		var items []any
+		for _, name := range matching[start:end] {
+			if r.URL.Query().Get("expand") == "true" {
+				items = append(items, map[string]any{
+					"$ref":         name,
+					"organization": "org",
+					"name":         name,
+					"sla":          "dev",
+					"tier":         "n0.nano",
+					"status":       map[string]any{"state": "Available"},
+				})
+			} else {
+				items = append(items, name)
+			}
+		}
+		response := map[string]any{"items": items}
+		if end < len(matching) {
+			response["next"] = "/projects/org?cursor=" + matching[end-1]
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+	getLimits := func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+		ret := limits
+		limits = nil
+		return ret
+	}
+
+	// Create provider server that runs within test
+	ctx := context.Background()
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace and initialize it with config that uses a
+	// page size smaller than the number of projects
+	tf := CreateTerraformWorkspace(t)
+	err := tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+	providerCfg := NuoDbaasProviderModel{
+		UrlBase:      ptr(server.URL),
+		User:         ptr("org/user"),
+		Password:     ptr("secret"),
+		ListPageSize: ptr(int64(3)),
+	}
+	tf.WriteConfigT(t, NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).Build()+`
+data "nuodbaas_projects" "all" {
+  filter = {
+    organization = "org"
+  }
+}
+`)
+	_, err = tf.Init()
+	require.NoError(t, err)
+
+	// Check that all projects are returned by following the cursor
+	_, err = tf.Apply()
+	require.NoError(t, err)
+	checkDataSourceList(t, "projects", "all", numProjects, tf, func(ac *AttributeChecker) {
+		ac.HasAttributeValue("organization", "org").HasAttributeValue("details", nil)
+	})
+	require.Equal(t, []string{"3", "3", "3"}, getLimits())
+
+	// Check that only the maximum number of results are requested
+	tf.WriteConfigT(t, NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).Build()+`
+data "nuodbaas_projects" "limited" {
+  filter = {
+    organization = "org"
+  }
+  max_results = 5
+}
+`)
+	_, err = tf.Apply()
+	require.NoError(t, err)
+	checkDataSourceList(t, "projects", "limited", 5, tf, func(ac *AttributeChecker) {
+		ac.HasAttributeValue("organization", "org")
+	})
+	require.Equal(t, []string{"3", "2"}, getLimits())
+
+	// Check that details are populated from expanded payloads, and that the
+	// cursor is advanced using the `$ref` of each item
+	tf.WriteConfigT(t, NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).Build()+`
+data "nuodbaas_projects" "expanded" {
+  filter = {
+    organization = "org"
+  }
+  expand = true
+}
+`)
+	_, err = tf.Apply()
+	require.NoError(t, err)
+	checkDataSourceList(t, "projects", "expanded", numProjects, tf, func(ac *AttributeChecker) {
+		ac.HasAttributeValue("organization", "org").
+			HasAttributeValue("details.organization", "org").
+			HasAttributeValue("details.tier", "n0.nano").
+			HasAttributeValue("details.status.state", "Available")
+	})
+	require.Equal(t, []string{"3", "3", "3"}, getLimits())
+
+	// Check that field filters are passed to the server
+	tf.WriteConfigT(t, NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).Build()+`
+data "nuodbaas_projects" "filtered" {
+  filter = {
+    organization = "org"
+    fields       = ["name=proj4"]
+  }
+}
+`)
+	_, err = tf.Apply()
+	require.NoError(t, err)
+	checkDataSourceList(t, "projects", "filtered", 1, tf, func(ac *AttributeChecker) {
+		ac.HasAttributeValue("organization", "org").HasAttributeValue("name", "proj4")
+	})
+}
+
+func TestConcurrentUpdate(t *testing.T) {
+	// Create server that stores a project in memory and simulates a
+	// configurable number of concurrent updates, each of which is made just
+	// before a patch is received, so that the patch is rejected
+	var lock sync.Mutex
+	var stored map[string]any
+	conflicts := 0
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/projects/org/proj":
+			_ = json.NewDecoder(r.Body).Decode(&stored)
+			stored["resourceVersion"] = "1"
+			stored["status"] = map[string]any{"state": "Available"}
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodPatch && stored != nil:
+			attempts++
+			if conflicts > 0 {
+				conflicts--
+				applyJsonPatch(t, stored, nil)
+			}
+			var patch []map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&patch)
+			if !applyJsonPatch(t, stored, patch) {
+				writeConcurrentUpdate(w)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(stored)
+		case r.Method == http.MethodGet && r.URL.Path == "/projects/org/proj" && stored != nil:
+			_ = json.NewEncoder(w).Encode(stored)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":"HTTP_ERROR","status":"HTTP 404 Not Found"}`))
+		}
+	}))
+	defer server.Close()
+	setConflicts := func(n int) {
+		lock.Lock()
+		defer lock.Unlock()
+		conflicts = n
+		attempts = 0
+	}
+	getAttempts := func() int {
+		lock.Lock()
+		defer lock.Unlock()
+		return attempts
+	}
+
+	// Create provider server that runs within test
+	ctx := context.Background()
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace and create project
+	tf := CreateTerraformWorkspace(t)
+	err := tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+	providerCfg := NuoDbaasProviderModel{
+		UrlBase:  ptr(server.URL),
+		User:     ptr("org/user"),
+		Password: ptr("secret"),
+	}
+	project := &ProjectResourceModel{
+		Organization: "org",
+		Name:         "proj",
+		Sla:          "dev",
+		Tier:         "n0.nano",
+	}
+	builder := NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).
+		WithProjectResource("proj", project)
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Init()
+	require.NoError(t, err)
+	_, err = tf.Apply()
+	require.NoError(t, err)
+
+	// Update project with the server rejecting the first few attempts, and
+	// check that the update is retried with a patch based on the latest
+	// version of the project until it succeeds
+	setConflicts(3)
+	project.Tier = "n0.small"
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Apply()
+	require.NoError(t, err)
+	require.Equal(t, 4, getAttempts())
+	tf.CheckStateResource(t, "nuodbaas_project.proj").
+		HasAttributeValue("tier", "n0.small")
+
+	// Update project with the server rejecting every attempt, and check that
+	// the number of attempts is bounded and a clear error is reported
+	setConflicts(1000)
+	project.Tier = "n1.small"
+	tf.WriteConfigT(t, builder.Build())
+	out, err := tf.Apply()
+	require.Error(t, err)
+	require.Equal(t, framework.CONCURRENT_UPDATE_MAX_ATTEMPTS, getAttempts())
+	require.Contains(t, string(out), "Unable to update project due to concurrent updates")
+}
+
+func TestUpdatePreservesConcurrentChanges(t *testing.T) {
+	// Create server that stores a project in memory, records the patches
+	// applied to it, and can simulate another client changing a field that
+	// is not managed by the configuration just before a patch is received
+	var lock sync.Mutex
+	var stored map[string]any
+	var patches [][]map[string]any
+	concurrentChange := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/projects/org/proj":
+			_ = json.NewDecoder(r.Body).Decode(&stored)
+			stored["resourceVersion"] = "1"
+			stored["status"] = map[string]any{"state": "Available"}
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodPatch && stored != nil:
+			if concurrentChange {
+				concurrentChange = false
+				applyJsonPatch(t, stored, []map[string]any{
+					{"op": "add", "path": "/properties", "value": map[string]any{"productVersion": "6.0"}},
+				})
+			}
+			var patch []map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&patch)
+			patches = append(patches, patch)
+			if !applyJsonPatch(t, stored, patch) {
+				writeConcurrentUpdate(w)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(stored)
+		case r.Method == http.MethodGet && r.URL.Path == "/projects/org/proj" && stored != nil:
+			_ = json.NewEncoder(w).Encode(stored)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":"HTTP_ERROR","status":"HTTP 404 Not Found"}`))
+		}
+	}))
+	defer server.Close()
+
+	// Create provider server that runs within test
+	ctx := context.Background()
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace and create project
+	tf := CreateTerraformWorkspace(t)
+	err := tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+	providerCfg := NuoDbaasProviderModel{
+		UrlBase:  ptr(server.URL),
+		User:     ptr("org/user"),
+		Password: ptr("secret"),
+	}
+	project := &ProjectResourceModel{
+		Organization: "org",
+		Name:         "proj",
+		Sla:          "dev",
+		Tier:         "n0.nano",
+	}
+	builder := NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).
+		WithProjectResource("proj", project)
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Init()
+	require.NoError(t, err)
+	_, err = tf.Apply()
+	require.NoError(t, err)
+
+	// Update project tier with another client setting the product version
+	// after the project was refreshed, and check that the retried patch
+	// only contains the configured change, so that the concurrent change
+	// is not overwritten
+	lock.Lock()
+	concurrentChange = true
+	lock.Unlock()
+	project.Tier = "n0.small"
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Apply()
+	require.NoError(t, err)
+
+	lock.Lock()
+	defer lock.Unlock()
+	require.Equal(t, [][]map[string]any{
+		{
+			{"op": "test", "path": "/resourceVersion", "value": "1"},
+			{"op": "replace", "path": "/tier", "value": "n0.small"},
+		},
+		{
+			{"op": "test", "path": "/resourceVersion", "value": "2"},
+			{"op": "replace", "path": "/tier", "value": "n0.small"},
+		},
+	}, patches)
+	require.Equal(t, "n0.small", stored["tier"])
+	require.Equal(t, map[string]any{"productVersion": "6.0"}, stored["properties"])
+}
+
+func TestResourceTimeouts(t *testing.T) {
+	// Create server that stores a project in memory, with configurable
+	// project state and delay when getting the project
+	var lock sync.Mutex
+	var stored map[string]any
+	projectState := "Creating"
+	var delay time.Duration
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		currentDelay := delay
+		lock.Unlock()
+		if r.Method == http.MethodGet && r.URL.Path == "/projects/org/proj" {
+			time.Sleep(currentDelay)
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/projects/org/proj":
+			_ = json.NewDecoder(r.Body).Decode(&stored)
+			stored["resourceVersion"] = "1"
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodDelete && stored != nil:
+			stored = nil
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == http.MethodGet && r.URL.Path == "/projects/org/proj" && stored != nil:
+			stored["status"] = map[string]any{"state": projectState}
+			_ = json.NewEncoder(w).Encode(stored)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":"HTTP_ERROR","status":"HTTP 404 Not Found"}`))
+		}
+	}))
+	defer server.Close()
+	setServerState := func(state string, getDelay time.Duration) {
+		lock.Lock()
+		defer lock.Unlock()
+		projectState = state
+		delay = getDelay
+	}
+
+	// Create provider server that runs within test
+	ctx := context.Background()
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace with a project that has a shorter create
+	// timeout than the one configured for projects in the provider
+	tf := CreateTerraformWorkspace(t)
+	err := tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+	providerCfg := NuoDbaasProviderModel{
+		UrlBase:  ptr(server.URL),
+		User:     ptr("org/user"),
+		Password: ptr("secret"),
+		Timeouts: map[string]framework.OperationTimeouts{
+			"project": {Create: ptr("10m")},
+		},
+	}
+	project := &ProjectResourceModel{
+		Organization: "org",
+		Name:         "proj",
+		Sla:          "dev",
+		Tier:         "n0.nano",
+	}
+	tf.WriteConfigT(t, NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).Build()+`
+resource "nuodbaas_project" "proj" {
+  organization = "org"
+  name         = "proj"
+  sla          = "dev"
+  tier         = "n0.nano"
+
+  timeouts {
+    create = "1s"
+    read   = "1s"
+  }
+}
+`)
+	_, err = tf.Init()
+	require.NoError(t, err)
+
+	// Check that the create timeout of the resource takes precedence
+	out, err := tf.Apply()
+	require.Error(t, err)
+	require.Contains(t, string(out), "Unable to achieve desired state for project")
+	require.Contains(t, string(out), "Timed out after 1s")
+	tf.CheckStateResource(t, "nuodbaas_project.proj").
+		HasAttributeValue("timeouts.create", "1s").
+		HasAttributeValue("timeouts.read", "1s")
+
+	// Check that the read timeout of the resource is used on refresh
+	setServerState("Available", 3*time.Second)
+	out, err = tf.Plan()
+	require.Error(t, err)
+	require.Contains(t, string(out), "Unable to read project")
+	require.Contains(t, string(out), "Timed out after 1s")
+
+	// Remove the timeouts block and replace the tainted project
+	setServerState("Available", 0)
+	builder := NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).
+		WithProjectResource("proj", project)
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Apply()
+	require.NoError(t, err)
+	tf.CheckStateResource(t, "nuodbaas_project.proj").
+		HasAttributeValue("timeouts", nil)
+
+	// Check that the read timeout configured for projects in the provider is
+	// used if the resource does not have one
+	providerCfg.Timeouts = map[string]framework.OperationTimeouts{
+		"project": {Read: ptr("1s")},
+	}
+	tf.WriteConfigT(t, builder.Build())
+	setServerState("Available", 3*time.Second)
+	out, err = tf.Plan()
+	require.Error(t, err)
+	require.Contains(t, string(out), "Timed out after 1s")
+
+	// Check that the default read timeout configured in the provider is
+	// used on import
+	providerCfg.Timeouts = map[string]framework.OperationTimeouts{
+		"default": {Read: ptr("1s")},
+	}
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Run("state", "rm", "nuodbaas_project.proj")
+	require.NoError(t, err)
+	out, err = tf.Run("import", "nuodbaas_project.proj", "org/proj")
+	require.Error(t, err)
+	require.Contains(t, string(out), "Timed out after 1s")
+
+	// Check that import succeeds once the project can be read in time
+	setServerState("Available", 0)
+	_, err = tf.Run("import", "nuodbaas_project.proj", "org/proj")
+	require.NoError(t, err)
+	tf.CheckStateResource(t, "nuodbaas_project.proj").
+		HasAttributeValue("name", "proj").
+		HasAttributeValue("timeouts", nil)
+}
+
+func TestSharedEventStream(t *testing.T) {
+	// Create server that stores projects in memory and makes them available
+	// after a delay, which is notified on the event stream for projects in
+	// the organization. Only events on the first project have an ID, to check
+	// that events are routed based on their data rather than the ID of the
+	// previous event.
+	var lock sync.Mutex
+	projects := make(map[string]map[string]any)
+	subscribers := make(map[chan string]struct{})
+	collectionConnections := 0
+	resourceConnections := 0
+	activeConnections := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/events/projects/org":
+			require.Equal(t, "true", r.URL.Query().Get("watchAll"))
+			collectionConnections++
+			activeConnections++
+			events := make(chan string, 10)
+			subscribers[events] = struct{}{}
+			lock.Unlock()
+			defer func() {
+				lock.Lock()
+				defer lock.Unlock()
+				activeConnections--
+				delete(subscribers, events)
+			}()
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.(http.Flusher).Flush()
+			for {
+				select {
+				case event := <-events:
+					_, _ = w.Write([]byte(event))
+					w.(http.Flusher).Flush()
+				case <-r.Context().Done():
+					return
+				}
+			}
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/events/"):
+			resourceConnections++
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/projects/org/"):
+			name := strings.TrimPrefix(r.URL.Path, "/projects/org/")
+			var project map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&project)
+			project["resourceVersion"] = "1"
+			project["status"] = map[string]any{"state": "Creating"}
+			projects[name] = project
+			w.WriteHeader(http.StatusCreated)
+			time.AfterFunc(2*time.Second, func() {
+				lock.Lock()
+				defer lock.Unlock()
+				project["status"] = map[string]any{"state": "Available"}
+				data, _ := json.Marshal(project)
+				event := fmt.Sprintf("event: UPDATED\ndata: %s\n\n", data)
+				if name == "proj1" {
+					event = "id: proj1\n" + event
+				}
+				for events := range subscribers {
+					events <- event
+				}
+			})
+		case r.Method == http.MethodGet && projects[strings.TrimPrefix(r.URL.Path, "/projects/org/")] != nil:
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(projects[strings.TrimPrefix(r.URL.Path, "/projects/org/")])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+		lock.Unlock()
+	}))
+	defer server.Close()
+	getConnections := func() (int, int, int) {
+		lock.Lock()
+		defer lock.Unlock()
+		return collectionConnections, resourceConnections, activeConnections
+	}
+
+	// Create provider server that runs within test
+	ctx := context.Background()
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace with several projects in the same
+	// organization
+	tf := CreateTerraformWorkspace(t)
+	err := tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+	providerCfg := NuoDbaasProviderModel{
+		UrlBase:  ptr(server.URL),
+		User:     ptr("org/user"),
+		Password: ptr("secret"),
+	}
+	builder := NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg)
+	for _, name := range []string{"proj1", "proj2", "proj3"} {
+		builder.WithProjectResource(name, &ProjectResourceModel{
+			Organization: "org",
+			Name:         name,
+			Sla:          "dev",
+			Tier:         "n0.nano",
+		})
+	}
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Init()
+	require.NoError(t, err)
+
+	// Run `terraform apply` and check that projects became ready without
+	// falling back to polling, which would take at least the polling
+	// interval
+	start := time.Now()
+	_, err = tf.Apply()
+	require.NoError(t, err)
+	require.Less(t, time.Since(start), framework.DEFAULT_POLLING_INTERVAL)
+	for _, name := range []string{"proj1", "proj2", "proj3"} {
+		tf.CheckStateResource(t, "nuodbaas_project."+name).
+			HasAttributeValue("status.state", "Available")
+	}
+
+	// Check that a single event stream was shared by all projects and was
+	// closed once they were ready
+	collection, resource, _ := getConnections()
+	require.Equal(t, 1, collection)
+	require.Equal(t, 0, resource)
+	require.Eventually(t, func() bool {
+		_, _, active := getConnections()
+		return active == 0
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
+func TestEventStreamReconnect(t *testing.T) {
+	// Create server whose event stream for projects is interrupted after
+	// sending an event with an ID, and fails to reconnect once, during which
+	// the project becomes available
+	var lock sync.Mutex
+	var stored map[string]any
+	var lastEventIds []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/events/projects/org":
+			lastEventIds = append(lastEventIds, r.Header.Get("Last-Event-ID"))
+			connection := len(lastEventIds)
+			lock.Unlock()
+			switch connection {
+			case 1:
+				// Send RESYNC event whose data differs from the project, to
+				// check that the project is read instead
+				w.Header().Set("Content-Type", "text/event-stream")
+				_, _ = w.Write([]byte("id: proj\nevent: RESYNC\ndata: {\"organization\": \"org\", \"name\": \"proj\", \"status\": {\"state\": \"Available\"}}\n\n"))
+				w.(http.Flusher).Flush()
+				time.Sleep(500 * time.Millisecond)
+			case 2:
+				lock.Lock()
+				stored["status"] = map[string]any{"state": "Available"}
+				lock.Unlock()
+				w.WriteHeader(http.StatusServiceUnavailable)
+			default:
+				w.Header().Set("Content-Type", "text/event-stream")
+				w.(http.Flusher).Flush()
+				<-r.Context().Done()
+			}
+			return
+		case r.Method == http.MethodPut && r.URL.Path == "/projects/org/proj":
+			_ = json.NewDecoder(r.Body).Decode(&stored)
+			stored["resourceVersion"] = "1"
+			stored["status"] = map[string]any{"state": "Creating"}
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/projects/org/proj" && stored != nil:
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(stored)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+		lock.Unlock()
+	}))
+	defer server.Close()
+
+	// Create provider server that runs within test
+	ctx := context.Background()
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace with project
+	tf := CreateTerraformWorkspace(t)
+	err := tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+	providerCfg := NuoDbaasProviderModel{
+		UrlBase:  ptr(server.URL),
+		User:     ptr("org/user"),
+		Password: ptr("secret"),
+	}
+	tf.WriteConfigT(t, NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).
+		WithProjectResource("proj", &ProjectResourceModel{
+			Organization: "org",
+			Name:         "proj",
+			Sla:          "dev",
+			Tier:         "n0.nano",
+		}).Build())
+	_, err = tf.Init()
+	require.NoError(t, err)
+
+	// Run `terraform apply` and check that the project became ready after
+	// the event stream was re-established, and that it was not resumed from
+	// the event ID, which is the name of a resource rather than a position in
+	// the event stream
+	_, err = tf.Apply()
+	require.NoError(t, err)
+	tf.CheckStateResource(t, "nuodbaas_project.proj").
+		HasAttributeValue("status.state", "Available")
+	lock.Lock()
+	defer lock.Unlock()
+	require.Equal(t, []string{"", "", ""}, lastEventIds)
+}
+
+func TestStalledEventStream(t *testing.T) {
+	// Create server whose event stream for projects is held open without
+	// delivering any events, as a buffering proxy would do, and which makes
+	// projects available after a delay
+	var lock sync.Mutex
+	var stored map[string]any
+	activeConnections := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/events/projects/org":
+			activeConnections++
+			lock.Unlock()
+			defer func() {
+				lock.Lock()
+				defer lock.Unlock()
+				activeConnections--
+			}()
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+			return
+		case r.Method == http.MethodPut && r.URL.Path == "/projects/org/proj":
+			_ = json.NewDecoder(r.Body).Decode(&stored)
+			stored["resourceVersion"] = "1"
+			stored["status"] = map[string]any{"state": "Creating"}
+			w.WriteHeader(http.StatusCreated)
+			time.AfterFunc(500*time.Millisecond, func() {
+				lock.Lock()
+				defer lock.Unlock()
+				stored["status"] = map[string]any{"state": "Available"}
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/projects/org/proj" && stored != nil:
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(stored)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+		lock.Unlock()
+	}))
+	defer server.Close()
+
+	// Create provider server that runs within test
+	ctx := context.Background()
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace with project and an invalid heartbeat
+	// timeout, and check that it is rejected
+	tf := CreateTerraformWorkspace(t)
+	err := tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+	providerCfg := NuoDbaasProviderModel{
+		UrlBase:          ptr(server.URL),
+		User:             ptr("org/user"),
+		Password:         ptr("secret"),
+		HeartbeatTimeout: ptr("-1s"),
+	}
+	builder := NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).
+		WithProjectResource("proj", &ProjectResourceModel{
+			Organization: "org",
+			Name:         "proj",
+			Sla:          "dev",
+			Tier:         "n0.nano",
+		})
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Init()
+	require.NoError(t, err)
+	out, err := tf.Validate()
+	require.Error(t, err)
+	require.Contains(t, string(out), "heartbeat_timeout must be non-negative")
+
+	// Run `terraform apply` with a short heartbeat timeout and check that
+	// the stalled event stream was closed and that the project became ready
+	// by polling, without waiting for the readiness timeout
+	providerCfg.HeartbeatTimeout = ptr("1s")
+	tf.WriteConfigT(t, builder.Build())
+	start := time.Now()
+	_, err = tf.Apply()
+	require.NoError(t, err)
+	require.Less(t, time.Since(start), framework.DEFAULT_POLLING_INTERVAL)
+	tf.CheckStateResource(t, "nuodbaas_project.proj").
+		HasAttributeValue("status.state", "Available")
+	require.Eventually(t, func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return activeConnections == 0
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
+func TestSlowEventSubscriber(t *testing.T) {
+	// Create server that stores projects in memory and makes them available
+	// after a delay, which is notified on the event stream for projects in
+	// the organization along with heartbeats. Reads of one of the projects
+	// are slow after it was created, and more events are sent for it than
+	// can be queued, so that its subscriber falls behind the event stream.
+	var lock sync.Mutex
+	projects := make(map[string]map[string]any)
+	subscribers := make(map[chan string]struct{})
+	collectionConnections := 0
+	slowReads := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/events/projects/org":
+			collectionConnections++
+			events := make(chan string, 100)
+			subscribers[events] = struct{}{}
+			lock.Unlock()
+			defer func() {
+				lock.Lock()
+				defer lock.Unlock()
+				delete(subscribers, events)
+			}()
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.(http.Flusher).Flush()
+			heartbeats := time.NewTicker(200 * time.Millisecond)
+			defer heartbeats.Stop()
+			for {
+				select {
+				case event := <-events:
+					_, _ = w.Write([]byte(event))
+				case <-heartbeats.C:
+					_, _ = w.Write([]byte("event: HEARTBEAT\ndata: {}\n\n"))
+				case <-r.Context().Done():
+					return
+				}
+				w.(http.Flusher).Flush()
+			}
+		case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/projects/org/"):
+			name := strings.TrimPrefix(r.URL.Path, "/projects/org/")
+			var project map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&project)
+			project["resourceVersion"] = "1"
+			project["status"] = map[string]any{"state": "Creating"}
+			projects[name] = project
+			w.WriteHeader(http.StatusCreated)
+			time.AfterFunc(time.Second, func() {
+				lock.Lock()
+				defer lock.Unlock()
+				if name == "slow" {
+					data, _ := json.Marshal(project)
+					for i := 0; i < 2*framework.EVENT_BUFFER_SIZE; i++ {
+						for events := range subscribers {
+							events <- fmt.Sprintf("event: UPDATED\ndata: %s\n\n", data)
+						}
+					}
+				}
+				project["status"] = map[string]any{"state": "Available"}
+				data, _ := json.Marshal(project)
+				for events := range subscribers {
+					events <- fmt.Sprintf("event: UPDATED\ndata: %s\n\n", data)
+				}
+			})
+		case r.Method == http.MethodGet && projects[strings.TrimPrefix(r.URL.Path, "/projects/org/")] != nil:
+			name := strings.TrimPrefix(r.URL.Path, "/projects/org/")
+			if name == "slow" {
+				slowReads++
+				if slowReads > 1 {
+					lock.Unlock()
+					time.Sleep(2 * time.Second)
+					lock.Lock()
+				}
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(projects[name])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+		lock.Unlock()
+	}))
+	defer server.Close()
+
+	// Create provider server that runs within test
+	ctx := context.Background()
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace with several projects in the same
+	// organization and a heartbeat timeout that is shorter than reads of the
+	// slow project
+	tf := CreateTerraformWorkspace(t)
+	err := tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+	providerCfg := NuoDbaasProviderModel{
+		UrlBase:          ptr(server.URL),
+		User:             ptr("org/user"),
+		Password:         ptr("secret"),
+		HeartbeatTimeout: ptr("1s"),
+	}
+	builder := NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg)
+	for _, name := range []string{"slow", "proj1", "proj2"} {
+		builder.WithProjectResource(name, &ProjectResourceModel{
+			Organization: "org",
+			Name:         name,
+			Sla:          "dev",
+			Tier:         "n0.nano",
+		})
+	}
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Init()
+	require.NoError(t, err)
+
+	// Run `terraform apply` and check that all projects became ready without
+	// falling back to polling, which would take at least the polling
+	// interval, and that the event stream was not closed as stalled while
+	// the slow project was read
+	start := time.Now()
+	_, err = tf.Apply()
+	require.NoError(t, err)
+	require.Less(t, time.Since(start), framework.DEFAULT_POLLING_INTERVAL)
+	for _, name := range []string{"slow", "proj1", "proj2"} {
+		tf.CheckStateResource(t, "nuodbaas_project."+name).
+			HasAttributeValue("status.state", "Available")
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	require.Equal(t, 1, collectionConnections)
+}
+
+func TestPollingBackoff(t *testing.T) {
+	// Create server that does not support event streams and makes projects
+	// available after a delay, which records the times at which the project
+	// is polled while it is not available
+	var lock sync.Mutex
+	var stored map[string]any
+	var polls []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/projects/org/proj":
+			_ = json.NewDecoder(r.Body).Decode(&stored)
+			stored["resourceVersion"] = "1"
+			stored["status"] = map[string]any{"state": "Creating"}
+			w.WriteHeader(http.StatusCreated)
+			time.AfterFunc(3*time.Second, func() {
+				lock.Lock()
+				defer lock.Unlock()
+				stored["status"] = map[string]any{"state": "Available"}
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/projects/org/proj" && stored != nil:
+			if stored["status"].(map[string]any)["state"] == "Creating" {
+				polls = append(polls, time.Now())
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(stored)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	// Create provider server that runs within test
+	ctx := context.Background()
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace with project and a maximum polling interval
+	// that is less than the initial interval, and check that it is rejected
+	tf := CreateTerraformWorkspace(t)
+	err := tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+	providerCfg := NuoDbaasProviderModel{
+		UrlBase:         ptr(server.URL),
+		User:            ptr("org/user"),
+		Password:        ptr("secret"),
+		PollInterval:    ptr("200ms"),
+		PollMaxInterval: ptr("100ms"),
+	}
+	builder := NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).
+		WithProjectResource("proj", &ProjectResourceModel{
+			Organization: "org",
+			Name:         "proj",
+			Sla:          "dev",
+			Tier:         "n0.nano",
+		})
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Init()
+	require.NoError(t, err)
+	out, err := tf.Validate()
+	require.Error(t, err)
+	require.Contains(t, string(out), "poll_max_interval must not be less than poll_interval")
+
+	// Run `terraform apply` and check that the project became ready without
+	// waiting for the default polling interval
+	providerCfg.PollMaxInterval = ptr("1s")
+	providerCfg.PollBackoffMultiplier = ptr(2.0)
+	tf.WriteConfigT(t, builder.Build())
+	start := time.Now()
+	_, err = tf.Apply()
+	require.NoError(t, err)
+	require.Less(t, time.Since(start), framework.DEFAULT_POLLING_INTERVAL)
+	tf.CheckStateResource(t, "nuodbaas_project.proj").
+		HasAttributeValue("status.state", "Available")
+
+	// Check that the delay between polls increased beyond the initial
+	// interval, but did not exceed the maximum interval
+	lock.Lock()
+	defer lock.Unlock()
+	require.Greater(t, len(polls), 2)
+	var maxDelay time.Duration
+	for i := 1; i < len(polls); i++ {
+		maxDelay = max(maxDelay, polls[i].Sub(polls[i-1]))
+	}
+	require.Greater(t, maxDelay, 300*time.Millisecond)
+	require.Less(t, maxDelay, 1500*time.Millisecond)
 }
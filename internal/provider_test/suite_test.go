@@ -17,6 +17,7 @@ import (
 	"regexp"
 	"runtime"
 	"sort"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -24,8 +25,14 @@ import (
 	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider"
 	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/backup"
 	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/backuppolicy"
+	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/canaryrollout"
 	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/database"
+	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/databasequota"
+	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/helmfeature"
 	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/project"
+	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/roletemplate"
+	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/servicetier"
+	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/user"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/hcl/v2/gohcl"
@@ -157,6 +164,14 @@ func (b *TfConfigBuilder) WithBackupPoliciesDataSource(name string, policies *Ba
 	return b.WithDataSource("nuodbaas_backuppolicies."+name, policies, dependsOn...)
 }
 
+func (b *TfConfigBuilder) WithBackupPolicyBackupsDataSource(name string, backups *BackupPolicyBackupsDataSourceModel, dependsOn ...string) *TfConfigBuilder {
+	return b.WithDataSource("nuodbaas_backuppolicy_backups."+name, backups, dependsOn...)
+}
+
+func (b *TfConfigBuilder) WithBackupPolicyDatabasesDataSource(name string, databases *BackupPolicyDatabasesDataSourceModel, dependsOn ...string) *TfConfigBuilder {
+	return b.WithDataSource("nuodbaas_backuppolicy_databases."+name, databases, dependsOn...)
+}
+
 //
 // Helper functions for backup resource and data sources
 //
@@ -177,6 +192,50 @@ func (b *TfConfigBuilder) WithoutBackupsDataSource(name string) *TfConfigBuilder
 	return b.WithoutDataSource("nuodbaas_backups." + name)
 }
 
+//
+// Helper functions for user resource and data sources
+//
+
+func (b *TfConfigBuilder) WithUserResource(name string, user *UserResourceModel, dependsOn ...string) *TfConfigBuilder {
+	return b.WithResource("nuodbaas_user."+name, user, dependsOn...)
+}
+
+func (b *TfConfigBuilder) WithUserDataSource(name string, user *UserNameModel, dependsOn ...string) *TfConfigBuilder {
+	return b.WithDataSource("nuodbaas_user."+name, user, dependsOn...)
+}
+
+func (b *TfConfigBuilder) WithUsersDataSource(name string, users *UsersDataSourceModel, dependsOn ...string) *TfConfigBuilder {
+	return b.WithDataSource("nuodbaas_users."+name, users, dependsOn...)
+}
+
+//
+// Helper functions for cluster-scoped resources
+//
+
+func (b *TfConfigBuilder) WithServiceTierResource(name string, tier *ServiceTierResourceModel, dependsOn ...string) *TfConfigBuilder {
+	return b.WithResource("nuodbaas_service_tier."+name, tier, dependsOn...)
+}
+
+func (b *TfConfigBuilder) WithDatabaseQuotaResource(name string, quota *DatabaseQuotaResourceModel, dependsOn ...string) *TfConfigBuilder {
+	return b.WithResource("nuodbaas_database_quota."+name, quota, dependsOn...)
+}
+
+func (b *TfConfigBuilder) WithHelmFeatureResource(name string, feature *HelmFeatureResourceModel, dependsOn ...string) *TfConfigBuilder {
+	return b.WithResource("nuodbaas_helm_feature."+name, feature, dependsOn...)
+}
+
+func (b *TfConfigBuilder) WithRoleTemplateResource(name string, template *RoleTemplateResourceModel, dependsOn ...string) *TfConfigBuilder {
+	return b.WithResource("nuodbaas_role_template."+name, template, dependsOn...)
+}
+
+func (b *TfConfigBuilder) WithCanaryRolloutTemplateResource(name string, template *CanaryRolloutTemplateResourceModel, dependsOn ...string) *TfConfigBuilder {
+	return b.WithResource("nuodbaas_canary_rollout_template."+name, template, dependsOn...)
+}
+
+func (b *TfConfigBuilder) WithCanaryRolloutResource(name string, rollout *CanaryRolloutResourceModel, dependsOn ...string) *TfConfigBuilder {
+	return b.WithResource("nuodbaas_canary_rollout."+name, rollout, dependsOn...)
+}
+
 func (b *TfConfigBuilder) Build() string {
 	f := hclwrite.NewEmptyFile()
 	ForEachInOrder(b.providers, func(key string, value any) {
@@ -468,6 +527,19 @@ func FindChildNode(node any, path string) (any, error) {
 	// Traverse field path
 	var ret any
 	for _, field := range strings.Split(path, ".") {
+		// If current node is a list, get element at index
+		if list, ok := node.([]any); ok {
+			index, err := strconv.Atoi(field)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid field path: %s", path)
+			}
+			if index < 0 || index >= len(list) {
+				return nil, nil
+			}
+			ret = list[index]
+			node = ret
+			continue
+		}
 		// Check that current node is an object
 		object, ok := node.(map[string]any)
 		if !ok {
@@ -638,6 +710,7 @@ func TestTfConfigBuilder(t *testing.T) {
     default = {
       create = "5s"
       delete = "5s"
+      read   = null
       update = "5s"
     }
   }
@@ -0,0 +1,383 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package provider_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nuodb/terraform-provider-nuodbaas/internal/helper"
+	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider"
+	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/project"
+	. "github.com/nuodb/terraform-provider-nuodbaas/internal/provider/user"
+	"github.com/nuodb/terraform-provider-nuodbaas/openapi"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newUser() *UserResourceModel {
+	// Get organization from user name
+	orgName := getOrganization()
+	// Generate a random user name to avoid collisions
+	userName := withRandomSuffix("user")
+	return &UserResourceModel{
+		Organization: orgName,
+		Name:         userName,
+		Password:     ptr("secret"),
+		AccessRule: openapi.DbaasAccessRuleModel{
+			Allow: &[]string{"read:" + orgName},
+		},
+	}
+}
+
+func TestUser(t *testing.T) {
+	var providerCfg NuoDbaasProviderModel
+	client, err := providerCfg.CreateClient()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// Create provider server that runs within test
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace and initialize it with config
+	tf := CreateTerraformWorkspace(t)
+	err = tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+
+	user := newUser()
+	builder := NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).
+		WithUserResource("user", user).
+		WithUserDataSource("user", &UserNameModel{
+			Organization: user.Organization,
+			Name:         user.Name,
+		}, "nuodbaas_user.user").
+		WithUsersDataSource("otherorg_users", &UsersDataSourceModel{
+			Filter: &UserFilterModel{
+				Organization: ptr("otherorg"),
+			},
+		}, "nuodbaas_user.user").
+		WithUsersDataSource("labelled_users", &UsersDataSourceModel{
+			Filter: &UserFilterModel{
+				Organization: ptr(user.Organization),
+				Labels:       []string{"purpose=" + user.Name},
+			},
+		}, "nuodbaas_user.user")
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Init()
+	require.NoError(t, err)
+
+	// Run `terraform apply` to create user
+	_, err = tf.Apply()
+	defer tf.DestroySilently()
+	require.NoError(t, err)
+
+	// Check attributes in resource
+	tf.CheckStateResource(t, "nuodbaas_user.user").
+		HasAttributeValue("organization", user.Organization).
+		HasAttributeValue("name", user.Name).
+		HasAttributeValue("password", *user.Password).
+		HasAttributeValue("labels", map[string]any{}).
+		HasAttributeValue("access_rule.allow", []any{"read:" + user.Organization})
+
+	// Check attributes in data sources
+	tf.CheckStateResource(t, "data.nuodbaas_user.user").
+		HasAttributeValue("organization", user.Organization).
+		HasAttributeValue("name", user.Name).
+		DoesNotHaveAttribute("password").
+		HasAttributeValue("access_rule.allow", []any{"read:" + user.Organization})
+	tf.CheckStateResource(t, "data.nuodbaas_users.otherorg_users").
+		HasAttributeValue("users", nil)
+	tf.CheckStateResource(t, "data.nuodbaas_users.labelled_users").
+		HasAttributeValue("users", nil)
+
+	// Update user resource
+	user.Labels = &map[string]string{
+		"purpose": user.Name,
+	}
+	user.AccessRule.Allow = &[]string{"all:" + user.Organization}
+	user.AccessRule.Deny = &[]string{"delete:" + user.Organization}
+	tf.WriteConfigT(t, builder.Build())
+
+	// Run `terraform apply` again to update resource
+	out, err := tf.Apply()
+	require.NoError(t, err)
+	require.Contains(t, string(out), "nuodbaas_user.user: Modifying...")
+
+	// Check attributes in resource
+	tf.CheckStateResource(t, "nuodbaas_user.user").
+		HasAttributeValue("organization", user.Organization).
+		HasAttributeValue("name", user.Name).
+		HasAttributeValue("password", *user.Password).
+		HasAttributeValue("labels", map[string]any{"purpose": user.Name}).
+		HasAttributeValue("access_rule.allow", []any{"all:" + user.Organization}).
+		HasAttributeValue("access_rule.deny", []any{"delete:" + user.Organization})
+
+	// Check attributes in data sources
+	tf.CheckStateResource(t, "data.nuodbaas_user.user").
+		HasAttributeValue("organization", user.Organization).
+		HasAttributeValue("name", user.Name).
+		HasAttributeValue("labels", map[string]any{"purpose": user.Name}).
+		HasAttributeValue("access_rule.allow", []any{"all:" + user.Organization}).
+		HasAttributeValue("access_rule.deny", []any{"delete:" + user.Organization})
+	// Check that data source with label filter returns user with label
+	tf.CheckStateResource(t, "data.nuodbaas_users.labelled_users").
+		HasAttributeValue("users", []any{
+			map[string]any{
+				"organization": user.Organization,
+				"name":         user.Name,
+				"details":      nil,
+			},
+		})
+
+	// Run `terraform apply` again and verify that it does nothing
+	out, err = tf.Apply()
+	require.NoError(t, err)
+	require.Contains(t, string(out), "No changes.")
+	require.Contains(t, string(out), "Your infrastructure matches the configuration.")
+
+	// Run `terraform destroy` to delete user
+	_, err = tf.Destroy()
+	require.NoError(t, err)
+
+	// Obtain actual user state and check that 404 is returned
+	actualUser := *user
+	err = actualUser.Read(ctx, client)
+	require.Error(t, err)
+	require.True(t, helper.IsNotFound(err), "Unexpected error: "+err.Error())
+}
+
+func TestImportUser(t *testing.T) {
+	var providerCfg NuoDbaasProviderModel
+	client, err := providerCfg.CreateClient()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// Create user
+	user := newUser()
+	err = user.Create(ctx, client)
+	require.NoError(t, err)
+	defer func() {
+		_, _ = client.DeleteUser(ctx, user.Organization, user.Name, nil)
+	}()
+
+	// Create provider server that runs within test
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace
+	tf := CreateTerraformWorkspace(t)
+	err = tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+
+	// Initialize Terraform workspace with config
+	builder := NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).
+		WithUserResource("user", user)
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Init()
+	require.NoError(t, err)
+	defer tf.DestroySilently()
+
+	// Run `terraform apply` and verify that it fails due to the user
+	// already existing
+	out, err := tf.Apply()
+	require.Error(t, err)
+	require.Contains(t, string(out), "Unable to create user")
+	require.Contains(t, string(out), "409 Conflict")
+
+	// Run `terraform import` for user
+	out, err = tf.Run("import", "nuodbaas_user.user", user.Organization+"/"+user.Name)
+	require.NoError(t, err)
+	require.Contains(t, string(out), "Import successful!")
+
+	// Verify that user is in state. The password is not returned by the
+	// server, so it is not populated by import.
+	tf.CheckStateResource(t, "nuodbaas_user.user").
+		HasAttributeValue("organization", user.Organization).
+		HasAttributeValue("name", user.Name).
+		HasAttributeValue("access_rule.allow", []any{"read:" + user.Organization}).
+		DoesNotHaveAttribute("password")
+}
+
+func TestAccessToken(t *testing.T) {
+	// Ephemeral resources are not supported by OpenTofu
+	if USE_TOFU.IsTrue() {
+		t.Skip("Ephemeral resources are not supported by OpenTofu")
+	}
+	// Skip test if token authentication is not enabled on REST server
+	var providerCfg NuoDbaasProviderModel
+	client, err := providerCfg.CreateClient()
+	require.NoError(t, err)
+	ctx := context.Background()
+	skipIfNotSupported(t, func() (*http.Response, error) {
+		return client.Login(ctx, openapi.LoginRequestModel{
+			ExpiresIn: ptr("1m"),
+		})
+	})
+
+	// Create provider server that runs within test
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace
+	tf := CreateTerraformWorkspace(t)
+	err = tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+
+	// Obtain a read-only token for the organization and use it to configure
+	// another provider instance, which is used to list projects
+	orgName := getOrganization()
+	tfConfig := NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).Build() + `
+ephemeral "nuodbaas_access_token" "scoped" {
+  limit_allow = ["read:` + orgName + `"]
+  expires_in  = "10m"
+}
+
+provider "nuodbaas" {
+  alias    = "scoped"
+  user     = ""
+  password = ""
+  token    = ephemeral.nuodbaas_access_token.scoped.token
+}
+
+data "nuodbaas_projects" "scoped" {
+  provider = nuodbaas.scoped
+  filter = {
+    organization = "` + orgName + `"
+  }
+}
+`
+	tf.WriteConfigT(t, tfConfig)
+	_, err = tf.Init()
+	require.NoError(t, err)
+
+	// Run `terraform apply` and check that data source was read using token
+	_, err = tf.Apply()
+	defer tf.DestroySilently()
+	require.NoError(t, err)
+	_, err = tf.GetStateResource("data.nuodbaas_projects.scoped")
+	require.NoError(t, err)
+
+	// Check that the ephemeral resource was not persisted in state
+	out, err := tf.ShowJson()
+	require.NoError(t, err)
+	require.NotContains(t, string(out), "nuodbaas_access_token")
+
+	// Try to create a project using the read-only token, which should fail
+	tfConfig += `
+resource "nuodbaas_project" "proj" {
+  provider     = nuodbaas.scoped
+  organization = "` + orgName + `"
+  name         = "` + withRandomSuffix("proj") + `"
+  sla          = "dev"
+  tier         = "n0.nano"
+}
+`
+	tf.WriteConfigT(t, tfConfig)
+	out, err = tf.Apply()
+	require.Error(t, err)
+	require.Contains(t, string(out), "status='HTTP 403 Forbidden'")
+
+	// Specifying both expires_in and expires_at_time should fail validation
+	tf.WriteConfigT(t, NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).Build()+`
+ephemeral "nuodbaas_access_token" "invalid" {
+  expires_in      = "10m"
+  expires_at_time = "2030-01-01T00:00:00Z"
+}
+`)
+	out, err = tf.Validate()
+	require.Error(t, err)
+	require.Contains(t, string(out), "Invalid Attribute Combination")
+}
+
+func TestSessionToken(t *testing.T) {
+	ctx := context.Background()
+
+	// Create server that issues session tokens and records the
+	// Authorization header of each request
+	var lock sync.Mutex
+	var authHeaders []string
+	loginEnabled := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		authHeaders = append(authHeaders, r.Method+" "+r.URL.Path+" "+r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/login" && loginEnabled:
+			_ = json.NewEncoder(w).Encode(openapi.LoginResponseModel{
+				Token:         ptr("session-token"),
+				ExpiresAtTime: ptr(time.Now().Add(time.Hour).UTC().Format(time.RFC3339)),
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/projects/org":
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": []string{"proj"}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":"HTTP_ERROR","status":"HTTP 404 Not Found"}`))
+		}
+	}))
+	defer server.Close()
+
+	// Create provider server that runs within test
+	reattachCfg, closeFn := CreateProviderServer(t, ctx)
+	defer closeFn()
+
+	// Create Terraform workspace and initialize it with config
+	tf := CreateTerraformWorkspace(t)
+	err := tf.SetReattachConfig(reattachCfg)
+	require.NoError(t, err)
+
+	providerCfg := NuoDbaasProviderModel{
+		UrlBase:  ptr(server.URL),
+		User:     ptr("org/user"),
+		Password: ptr("secret"),
+	}
+	builder := NewTfConfigBuilder().WithProviderConfig("nuodbaas", &providerCfg).
+		WithProjectsDataSource("projects", &ProjectsDataSourceModel{
+			Filter: &ProjectFilterModel{
+				Organization: ptr("org"),
+			},
+		})
+	tf.WriteConfigT(t, builder.Build())
+	_, err = tf.Init()
+	require.NoError(t, err)
+
+	// Run `terraform apply` and check that the user credentials are only
+	// supplied to the login endpoint
+	basicAuth := "Basic " + base64.StdEncoding.EncodeToString([]byte("org/user:secret"))
+	_, err = tf.Apply()
+	require.NoError(t, err)
+	tf.CheckStateResource(t, "data.nuodbaas_projects.projects").
+		HasAttributeValue("projects", []any{
+			map[string]any{
+				"organization": "org",
+				"name":         "proj",
+				"details":      nil,
+			},
+		})
+	lock.Lock()
+	require.Contains(t, authHeaders, "POST /login "+basicAuth)
+	require.Contains(t, authHeaders, "GET /projects/org Bearer session-token")
+	require.NotContains(t, authHeaders, "GET /projects/org "+basicAuth)
+
+	// Disable login endpoint and check that basic authentication is used
+	authHeaders = nil
+	loginEnabled = false
+	lock.Unlock()
+	_, err = tf.Apply()
+	require.NoError(t, err)
+	lock.Lock()
+	defer lock.Unlock()
+	require.Contains(t, authHeaders, "POST /login "+basicAuth)
+	require.Contains(t, authHeaders, "GET /projects/org "+basicAuth)
+	require.NotContains(t, authHeaders, "GET /projects/org Bearer session-token")
+}
@@ -150,6 +150,120 @@ type ClientInterface interface {
 
 	CreateOrUpdateBackup(ctx context.Context, organization string, project string, database string, backup string, body CreateOrUpdateBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetCanaryRollouts request
+	GetCanaryRollouts(ctx context.Context, params *GetCanaryRolloutsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteCanaryRollout request
+	DeleteCanaryRollout(ctx context.Context, name string, params *DeleteCanaryRolloutParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCanaryRollout request
+	GetCanaryRollout(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchCanaryRolloutWithBody request with any body
+	PatchCanaryRolloutWithBody(ctx context.Context, name string, params *PatchCanaryRolloutParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchCanaryRolloutWithApplicationJSONPatchPlusJSONBody(ctx context.Context, name string, params *PatchCanaryRolloutParams, body PatchCanaryRolloutApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateCanaryRolloutWithBody request with any body
+	CreateCanaryRolloutWithBody(ctx context.Context, name string, params *CreateCanaryRolloutParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateCanaryRollout(ctx context.Context, name string, params *CreateCanaryRolloutParams, body CreateCanaryRolloutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCanaryRolloutTemplates request
+	GetCanaryRolloutTemplates(ctx context.Context, params *GetCanaryRolloutTemplatesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteCanaryRolloutTemplate request
+	DeleteCanaryRolloutTemplate(ctx context.Context, name string, params *DeleteCanaryRolloutTemplateParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCanaryRolloutTemplate request
+	GetCanaryRolloutTemplate(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchCanaryRolloutTemplateWithBody request with any body
+	PatchCanaryRolloutTemplateWithBody(ctx context.Context, name string, params *PatchCanaryRolloutTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchCanaryRolloutTemplateWithApplicationJSONPatchPlusJSONBody(ctx context.Context, name string, params *PatchCanaryRolloutTemplateParams, body PatchCanaryRolloutTemplateApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateCanaryRolloutTemplateWithBody request with any body
+	CreateCanaryRolloutTemplateWithBody(ctx context.Context, name string, params *CreateCanaryRolloutTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateCanaryRolloutTemplate(ctx context.Context, name string, params *CreateCanaryRolloutTemplateParams, body CreateCanaryRolloutTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDatabaseQuotas request
+	GetDatabaseQuotas(ctx context.Context, params *GetDatabaseQuotasParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteDatabaseQuota request
+	DeleteDatabaseQuota(ctx context.Context, name string, params *DeleteDatabaseQuotaParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDatabaseQuota request
+	GetDatabaseQuota(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchDatabaseQuotaWithBody request with any body
+	PatchDatabaseQuotaWithBody(ctx context.Context, name string, params *PatchDatabaseQuotaParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchDatabaseQuotaWithApplicationJSONPatchPlusJSONBody(ctx context.Context, name string, params *PatchDatabaseQuotaParams, body PatchDatabaseQuotaApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateDatabaseQuotaWithBody request with any body
+	CreateDatabaseQuotaWithBody(ctx context.Context, name string, params *CreateDatabaseQuotaParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateDatabaseQuota(ctx context.Context, name string, params *CreateDatabaseQuotaParams, body CreateDatabaseQuotaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHelmFeatures request
+	GetHelmFeatures(ctx context.Context, params *GetHelmFeaturesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteHelmFeature request
+	DeleteHelmFeature(ctx context.Context, name string, params *DeleteHelmFeatureParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHelmFeature request
+	GetHelmFeature(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchHelmFeatureWithBody request with any body
+	PatchHelmFeatureWithBody(ctx context.Context, name string, params *PatchHelmFeatureParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchHelmFeatureWithApplicationJSONPatchPlusJSONBody(ctx context.Context, name string, params *PatchHelmFeatureParams, body PatchHelmFeatureApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateHelmFeatureWithBody request with any body
+	CreateHelmFeatureWithBody(ctx context.Context, name string, params *CreateHelmFeatureParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateHelmFeature(ctx context.Context, name string, params *CreateHelmFeatureParams, body CreateHelmFeatureJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRoleTemplates request
+	GetRoleTemplates(ctx context.Context, params *GetRoleTemplatesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteRoleTemplate request
+	DeleteRoleTemplate(ctx context.Context, name string, params *DeleteRoleTemplateParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRoleTemplate request
+	GetRoleTemplate(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchRoleTemplateWithBody request with any body
+	PatchRoleTemplateWithBody(ctx context.Context, name string, params *PatchRoleTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchRoleTemplateWithApplicationJSONPatchPlusJSONBody(ctx context.Context, name string, params *PatchRoleTemplateParams, body PatchRoleTemplateApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateRoleTemplateWithBody request with any body
+	CreateRoleTemplateWithBody(ctx context.Context, name string, params *CreateRoleTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateRoleTemplate(ctx context.Context, name string, params *CreateRoleTemplateParams, body CreateRoleTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetServiceTiers request
+	GetServiceTiers(ctx context.Context, params *GetServiceTiersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteServiceTier request
+	DeleteServiceTier(ctx context.Context, name string, params *DeleteServiceTierParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetServiceTier request
+	GetServiceTier(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchServiceTierWithBody request with any body
+	PatchServiceTierWithBody(ctx context.Context, name string, params *PatchServiceTierParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchServiceTierWithApplicationJSONPatchPlusJSONBody(ctx context.Context, name string, params *PatchServiceTierParams, body PatchServiceTierApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateServiceTierWithBody request with any body
+	CreateServiceTierWithBody(ctx context.Context, name string, params *CreateServiceTierParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateServiceTier(ctx context.Context, name string, params *CreateServiceTierParams, body CreateServiceTierJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetAllDatabases request
 	GetAllDatabases(ctx context.Context, params *GetAllDatabasesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -180,6 +294,11 @@ type ClientInterface interface {
 
 	UpdateDbaPassword(ctx context.Context, organization string, project string, database string, params *UpdateDbaPasswordParams, body UpdateDbaPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// LoginWithBody request with any body
+	LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Login(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetAllProjects request
 	GetAllProjects(ctx context.Context, params *GetAllProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -201,6 +320,28 @@ type ClientInterface interface {
 	CreateProjectWithBody(ctx context.Context, organization string, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	CreateProject(ctx context.Context, organization string, project string, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAllUsers request
+	GetAllUsers(ctx context.Context, params *GetAllUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsers request
+	GetUsers(ctx context.Context, organization string, params *GetUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUser request
+	DeleteUser(ctx context.Context, organization string, user string, params *DeleteUserParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUser request
+	GetUser(ctx context.Context, organization string, user string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchUserWithBody request with any body
+	PatchUserWithBody(ctx context.Context, organization string, user string, params *PatchUserParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchUserWithApplicationJSONPatchPlusJSONBody(ctx context.Context, organization string, user string, params *PatchUserParams, body PatchUserApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateUserWithBody request with any body
+	CreateUserWithBody(ctx context.Context, organization string, user string, params *CreateUserParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateUser(ctx context.Context, organization string, user string, params *CreateUserParams, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) GetAllBackupPolicies(ctx context.Context, params *GetAllBackupPoliciesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -467,8 +608,8 @@ func (c *Client) CreateOrUpdateBackup(ctx context.Context, organization string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAllDatabases(ctx context.Context, params *GetAllDatabasesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAllDatabasesRequest(c.Server, params)
+func (c *Client) GetCanaryRollouts(ctx context.Context, params *GetCanaryRolloutsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCanaryRolloutsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -479,8 +620,8 @@ func (c *Client) GetAllDatabases(ctx context.Context, params *GetAllDatabasesPar
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOrganizationDatabases(ctx context.Context, organization string, params *GetOrganizationDatabasesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOrganizationDatabasesRequest(c.Server, organization, params)
+func (c *Client) DeleteCanaryRollout(ctx context.Context, name string, params *DeleteCanaryRolloutParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCanaryRolloutRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
@@ -491,8 +632,8 @@ func (c *Client) GetOrganizationDatabases(ctx context.Context, organization stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDatabases(ctx context.Context, organization string, project string, params *GetDatabasesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDatabasesRequest(c.Server, organization, project, params)
+func (c *Client) GetCanaryRollout(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCanaryRolloutRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
@@ -503,8 +644,8 @@ func (c *Client) GetDatabases(ctx context.Context, organization string, project
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteDatabase(ctx context.Context, organization string, project string, database string, params *DeleteDatabaseParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteDatabaseRequest(c.Server, organization, project, database, params)
+func (c *Client) PatchCanaryRolloutWithBody(ctx context.Context, name string, params *PatchCanaryRolloutParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchCanaryRolloutRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -515,8 +656,8 @@ func (c *Client) DeleteDatabase(ctx context.Context, organization string, projec
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDatabase(ctx context.Context, organization string, project string, database string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDatabaseRequest(c.Server, organization, project, database)
+func (c *Client) PatchCanaryRolloutWithApplicationJSONPatchPlusJSONBody(ctx context.Context, name string, params *PatchCanaryRolloutParams, body PatchCanaryRolloutApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchCanaryRolloutRequestWithApplicationJSONPatchPlusJSONBody(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -527,8 +668,8 @@ func (c *Client) GetDatabase(ctx context.Context, organization string, project s
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchDatabaseWithBody(ctx context.Context, organization string, project string, database string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchDatabaseRequestWithBody(c.Server, organization, project, database, contentType, body)
+func (c *Client) CreateCanaryRolloutWithBody(ctx context.Context, name string, params *CreateCanaryRolloutParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCanaryRolloutRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -539,8 +680,8 @@ func (c *Client) PatchDatabaseWithBody(ctx context.Context, organization string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchDatabaseWithApplicationJSONPatchPlusJSONBody(ctx context.Context, organization string, project string, database string, body PatchDatabaseApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchDatabaseRequestWithApplicationJSONPatchPlusJSONBody(c.Server, organization, project, database, body)
+func (c *Client) CreateCanaryRollout(ctx context.Context, name string, params *CreateCanaryRolloutParams, body CreateCanaryRolloutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCanaryRolloutRequest(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -551,8 +692,8 @@ func (c *Client) PatchDatabaseWithApplicationJSONPatchPlusJSONBody(ctx context.C
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDatabaseWithBody(ctx context.Context, organization string, project string, database string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDatabaseRequestWithBody(c.Server, organization, project, database, contentType, body)
+func (c *Client) GetCanaryRolloutTemplates(ctx context.Context, params *GetCanaryRolloutTemplatesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCanaryRolloutTemplatesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -563,8 +704,8 @@ func (c *Client) CreateDatabaseWithBody(ctx context.Context, organization string
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDatabase(ctx context.Context, organization string, project string, database string, body CreateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDatabaseRequest(c.Server, organization, project, database, body)
+func (c *Client) DeleteCanaryRolloutTemplate(ctx context.Context, name string, params *DeleteCanaryRolloutTemplateParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCanaryRolloutTemplateRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
@@ -575,8 +716,8 @@ func (c *Client) CreateDatabase(ctx context.Context, organization string, projec
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDbaPasswordWithBody(ctx context.Context, organization string, project string, database string, params *UpdateDbaPasswordParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDbaPasswordRequestWithBody(c.Server, organization, project, database, params, contentType, body)
+func (c *Client) GetCanaryRolloutTemplate(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCanaryRolloutTemplateRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
@@ -587,8 +728,8 @@ func (c *Client) UpdateDbaPasswordWithBody(ctx context.Context, organization str
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDbaPassword(ctx context.Context, organization string, project string, database string, params *UpdateDbaPasswordParams, body UpdateDbaPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDbaPasswordRequest(c.Server, organization, project, database, params, body)
+func (c *Client) PatchCanaryRolloutTemplateWithBody(ctx context.Context, name string, params *PatchCanaryRolloutTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchCanaryRolloutTemplateRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -599,8 +740,8 @@ func (c *Client) UpdateDbaPassword(ctx context.Context, organization string, pro
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAllProjects(ctx context.Context, params *GetAllProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAllProjectsRequest(c.Server, params)
+func (c *Client) PatchCanaryRolloutTemplateWithApplicationJSONPatchPlusJSONBody(ctx context.Context, name string, params *PatchCanaryRolloutTemplateParams, body PatchCanaryRolloutTemplateApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchCanaryRolloutTemplateRequestWithApplicationJSONPatchPlusJSONBody(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -611,8 +752,8 @@ func (c *Client) GetAllProjects(ctx context.Context, params *GetAllProjectsParam
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetProjects(ctx context.Context, organization string, params *GetProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetProjectsRequest(c.Server, organization, params)
+func (c *Client) CreateCanaryRolloutTemplateWithBody(ctx context.Context, name string, params *CreateCanaryRolloutTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCanaryRolloutTemplateRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -623,8 +764,8 @@ func (c *Client) GetProjects(ctx context.Context, organization string, params *G
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteProject(ctx context.Context, organization string, project string, params *DeleteProjectParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteProjectRequest(c.Server, organization, project, params)
+func (c *Client) CreateCanaryRolloutTemplate(ctx context.Context, name string, params *CreateCanaryRolloutTemplateParams, body CreateCanaryRolloutTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCanaryRolloutTemplateRequest(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -635,8 +776,8 @@ func (c *Client) DeleteProject(ctx context.Context, organization string, project
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetProject(ctx context.Context, organization string, project string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetProjectRequest(c.Server, organization, project)
+func (c *Client) GetDatabaseQuotas(ctx context.Context, params *GetDatabaseQuotasParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseQuotasRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -647,8 +788,8 @@ func (c *Client) GetProject(ctx context.Context, organization string, project st
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchProjectWithBody(ctx context.Context, organization string, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchProjectRequestWithBody(c.Server, organization, project, contentType, body)
+func (c *Client) DeleteDatabaseQuota(ctx context.Context, name string, params *DeleteDatabaseQuotaParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDatabaseQuotaRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
@@ -659,8 +800,8 @@ func (c *Client) PatchProjectWithBody(ctx context.Context, organization string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchProjectWithApplicationJSONPatchPlusJSONBody(ctx context.Context, organization string, project string, body PatchProjectApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchProjectRequestWithApplicationJSONPatchPlusJSONBody(c.Server, organization, project, body)
+func (c *Client) GetDatabaseQuota(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseQuotaRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
@@ -671,8 +812,8 @@ func (c *Client) PatchProjectWithApplicationJSONPatchPlusJSONBody(ctx context.Co
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateProjectWithBody(ctx context.Context, organization string, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateProjectRequestWithBody(c.Server, organization, project, contentType, body)
+func (c *Client) PatchDatabaseQuotaWithBody(ctx context.Context, name string, params *PatchDatabaseQuotaParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchDatabaseQuotaRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -683,8 +824,8 @@ func (c *Client) CreateProjectWithBody(ctx context.Context, organization string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateProject(ctx context.Context, organization string, project string, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateProjectRequest(c.Server, organization, project, body)
+func (c *Client) PatchDatabaseQuotaWithApplicationJSONPatchPlusJSONBody(ctx context.Context, name string, params *PatchDatabaseQuotaParams, body PatchDatabaseQuotaApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchDatabaseQuotaRequestWithApplicationJSONPatchPlusJSONBody(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -695,698 +836,640 @@ func (c *Client) CreateProject(ctx context.Context, organization string, project
 	return c.Client.Do(req)
 }
 
-// NewGetAllBackupPoliciesRequest generates requests for GetAllBackupPolicies
-func NewGetAllBackupPoliciesRequest(server string, params *GetAllBackupPoliciesParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateDatabaseQuotaWithBody(ctx context.Context, name string, params *CreateDatabaseQuotaParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseQuotaRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/backuppolicies")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateDatabaseQuota(ctx context.Context, name string, params *CreateDatabaseQuotaParams, body CreateDatabaseQuotaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseQuotaRequest(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Cursor != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Expand != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.LabelFilter != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelFilter", runtime.ParamLocationQuery, *params.LabelFilter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.FieldFilter != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fieldFilter", runtime.ParamLocationQuery, *params.FieldFilter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.ListAccessible != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "listAccessible", runtime.ParamLocationQuery, *params.ListAccessible); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetBackupPoliciesRequest generates requests for GetBackupPolicies
-func NewGetBackupPoliciesRequest(server string, organization string, params *GetBackupPoliciesParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+func (c *Client) GetHelmFeatures(ctx context.Context, params *GetHelmFeaturesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHelmFeaturesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteHelmFeature(ctx context.Context, name string, params *DeleteHelmFeatureParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteHelmFeatureRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/backuppolicies/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetHelmFeature(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHelmFeatureRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Cursor != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Expand != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.LabelFilter != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelFilter", runtime.ParamLocationQuery, *params.LabelFilter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.FieldFilter != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fieldFilter", runtime.ParamLocationQuery, *params.FieldFilter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.ListAccessible != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "listAccessible", runtime.ParamLocationQuery, *params.ListAccessible); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PatchHelmFeatureWithBody(ctx context.Context, name string, params *PatchHelmFeatureParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchHelmFeatureRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteBackupPolicyRequest generates requests for DeleteBackupPolicy
-func NewDeleteBackupPolicyRequest(server string, organization string, policy string, params *DeleteBackupPolicyParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+func (c *Client) PatchHelmFeatureWithApplicationJSONPatchPlusJSONBody(ctx context.Context, name string, params *PatchHelmFeatureParams, body PatchHelmFeatureApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchHelmFeatureRequestWithApplicationJSONPatchPlusJSONBody(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "policy", runtime.ParamLocationPath, policy)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateHelmFeatureWithBody(ctx context.Context, name string, params *CreateHelmFeatureParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateHelmFeatureRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/backuppolicies/%s/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateHelmFeature(ctx context.Context, name string, params *CreateHelmFeatureParams, body CreateHelmFeatureJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateHelmFeatureRequest(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.TimeoutSeconds != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeoutSeconds", runtime.ParamLocationQuery, *params.TimeoutSeconds); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) GetRoleTemplates(ctx context.Context, params *GetRoleTemplatesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRoleTemplatesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetBackupPolicyRequest generates requests for GetBackupPolicy
-func NewGetBackupPolicyRequest(server string, organization string, policy string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+func (c *Client) DeleteRoleTemplate(ctx context.Context, name string, params *DeleteRoleTemplateParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteRoleTemplateRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "policy", runtime.ParamLocationPath, policy)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetRoleTemplate(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRoleTemplateRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/backuppolicies/%s/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PatchRoleTemplateWithBody(ctx context.Context, name string, params *PatchRoleTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchRoleTemplateRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPatchBackupPolicyRequestWithApplicationJSONPatchPlusJSONBody calls the generic PatchBackupPolicy builder with application/json-patch+json body
-func NewPatchBackupPolicyRequestWithApplicationJSONPatchPlusJSONBody(server string, organization string, policy string, body PatchBackupPolicyApplicationJSONPatchPlusJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PatchRoleTemplateWithApplicationJSONPatchPlusJSONBody(ctx context.Context, name string, params *PatchRoleTemplateParams, body PatchRoleTemplateApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchRoleTemplateRequestWithApplicationJSONPatchPlusJSONBody(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPatchBackupPolicyRequestWithBody(server, organization, policy, "application/json-patch+json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPatchBackupPolicyRequestWithBody generates requests for PatchBackupPolicy with any type of body
-func NewPatchBackupPolicyRequestWithBody(server string, organization string, policy string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+func (c *Client) CreateRoleTemplateWithBody(ctx context.Context, name string, params *CreateRoleTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRoleTemplateRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "policy", runtime.ParamLocationPath, policy)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateRoleTemplate(ctx context.Context, name string, params *CreateRoleTemplateParams, body CreateRoleTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRoleTemplateRequest(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/backuppolicies/%s/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetServiceTiers(ctx context.Context, params *GetServiceTiersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetServiceTiersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+func (c *Client) DeleteServiceTier(ctx context.Context, name string, params *DeleteServiceTierParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteServiceTierRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateBackupPolicyRequest calls the generic CreateBackupPolicy builder with application/json body
-func NewCreateBackupPolicyRequest(server string, organization string, policy string, body CreateBackupPolicyJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetServiceTier(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetServiceTierRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateBackupPolicyRequestWithBody(server, organization, policy, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateBackupPolicyRequestWithBody generates requests for CreateBackupPolicy with any type of body
-func NewCreateBackupPolicyRequestWithBody(server string, organization string, policy string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+func (c *Client) PatchServiceTierWithBody(ctx context.Context, name string, params *PatchServiceTierParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchServiceTierRequestWithBody(c.Server, name, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) PatchServiceTierWithApplicationJSONPatchPlusJSONBody(ctx context.Context, name string, params *PatchServiceTierParams, body PatchServiceTierApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchServiceTierRequestWithApplicationJSONPatchPlusJSONBody(c.Server, name, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+func (c *Client) CreateServiceTierWithBody(ctx context.Context, name string, params *CreateServiceTierParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateServiceTierRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
+func (c *Client) CreateServiceTier(ctx context.Context, name string, params *CreateServiceTierParams, body CreateServiceTierJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateServiceTierRequest(c.Server, name, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "policy", runtime.ParamLocationPath, policy)
+func (c *Client) GetAllDatabases(ctx context.Context, params *GetAllDatabasesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAllDatabasesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetOrganizationDatabases(ctx context.Context, organization string, params *GetOrganizationDatabasesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationDatabasesRequest(c.Server, organization, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/backuppolicies/%s/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) GetDatabases(ctx context.Context, organization string, project string, params *GetDatabasesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabasesRequest(c.Server, organization, project, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteDatabase(ctx context.Context, organization string, project string, database string, params *DeleteDatabaseParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDatabaseRequest(c.Server, organization, project, database, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) GetDatabase(ctx context.Context, organization string, project string, database string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseRequest(c.Server, organization, project, database)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) PatchDatabaseWithBody(ctx context.Context, organization string, project string, database string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchDatabaseRequestWithBody(c.Server, organization, project, database, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) PatchDatabaseWithApplicationJSONPatchPlusJSONBody(ctx context.Context, organization string, project string, database string, body PatchDatabaseApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchDatabaseRequestWithApplicationJSONPatchPlusJSONBody(c.Server, organization, project, database, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetBackupsFromPolicyRequest generates requests for GetBackupsFromPolicy
-func NewGetBackupsFromPolicyRequest(server string, organization string, policy string, params *GetBackupsFromPolicyParams) (*http.Request, error) {
-	var err error
+func (c *Client) CreateDatabaseWithBody(ctx context.Context, organization string, project string, database string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseRequestWithBody(c.Server, organization, project, database, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) CreateDatabase(ctx context.Context, organization string, project string, database string, body CreateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseRequest(c.Server, organization, project, database, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+func (c *Client) UpdateDbaPasswordWithBody(ctx context.Context, organization string, project string, database string, params *UpdateDbaPasswordParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDbaPasswordRequestWithBody(c.Server, organization, project, database, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
+func (c *Client) UpdateDbaPassword(ctx context.Context, organization string, project string, database string, params *UpdateDbaPasswordParams, body UpdateDbaPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDbaPasswordRequest(c.Server, organization, project, database, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "policy", runtime.ParamLocationPath, policy)
+func (c *Client) LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) Login(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/backuppolicies/%s/%s/backups", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) GetAllProjects(ctx context.Context, params *GetAllProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAllProjectsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetProjects(ctx context.Context, organization string, params *GetProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectsRequest(c.Server, organization, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+func (c *Client) DeleteProject(ctx context.Context, organization string, project string, params *DeleteProjectParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProjectRequest(c.Server, organization, project, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if params.Offset != nil {
+func (c *Client) GetProject(ctx context.Context, organization string, project string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectRequest(c.Server, organization, project)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Cursor != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Expand != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.LabelFilter != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelFilter", runtime.ParamLocationQuery, *params.LabelFilter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.FieldFilter != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fieldFilter", runtime.ParamLocationQuery, *params.FieldFilter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
+func (c *Client) PatchProjectWithBody(ctx context.Context, organization string, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchProjectRequestWithBody(c.Server, organization, project, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if params.ListAccessible != nil {
+func (c *Client) PatchProjectWithApplicationJSONPatchPlusJSONBody(ctx context.Context, organization string, project string, body PatchProjectApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchProjectRequestWithApplicationJSONPatchPlusJSONBody(c.Server, organization, project, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "listAccessible", runtime.ParamLocationQuery, *params.ListAccessible); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+func (c *Client) CreateProjectWithBody(ctx context.Context, organization string, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectRequestWithBody(c.Server, organization, project, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		}
+func (c *Client) CreateProject(ctx context.Context, organization string, project string, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectRequest(c.Server, organization, project, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		queryURL.RawQuery = queryValues.Encode()
+func (c *Client) GetAllUsers(ctx context.Context, params *GetAllUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAllUsersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetUsers(ctx context.Context, organization string, params *GetUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersRequest(c.Server, organization, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) DeleteUser(ctx context.Context, organization string, user string, params *DeleteUserParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUserRequest(c.Server, organization, user, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetMatchingDatabasesRequest generates requests for GetMatchingDatabases
-func NewGetMatchingDatabasesRequest(server string, organization string, policy string, params *GetMatchingDatabasesParams) (*http.Request, error) {
-	var err error
+func (c *Client) GetUser(ctx context.Context, organization string, user string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserRequest(c.Server, organization, user)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) PatchUserWithBody(ctx context.Context, organization string, user string, params *PatchUserParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchUserRequestWithBody(c.Server, organization, user, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+func (c *Client) PatchUserWithApplicationJSONPatchPlusJSONBody(ctx context.Context, organization string, user string, params *PatchUserParams, body PatchUserApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchUserRequestWithApplicationJSONPatchPlusJSONBody(c.Server, organization, user, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
+func (c *Client) CreateUserWithBody(ctx context.Context, organization string, user string, params *CreateUserParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserRequestWithBody(c.Server, organization, user, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "policy", runtime.ParamLocationPath, policy)
+func (c *Client) CreateUser(ctx context.Context, organization string, user string, params *CreateUserParams, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserRequest(c.Server, organization, user, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetAllBackupPoliciesRequest generates requests for GetAllBackupPolicies
+func NewGetAllBackupPoliciesRequest(server string, params *GetAllBackupPoliciesParams) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/backuppolicies/%s/%s/databases", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/backuppolicies")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1522,16 +1605,23 @@ func NewGetMatchingDatabasesRequest(server string, organization string, policy s
 	return req, nil
 }
 
-// NewGetAllBackupsRequest generates requests for GetAllBackups
-func NewGetAllBackupsRequest(server string, params *GetAllBackupsParams) (*http.Request, error) {
+// NewGetBackupPoliciesRequest generates requests for GetBackupPolicies
+func NewGetBackupPoliciesRequest(server string, organization string, params *GetBackupPoliciesParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/backups")
+	operationPath := fmt.Sprintf("/backuppolicies/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1667,8 +1757,8 @@ func NewGetAllBackupsRequest(server string, params *GetAllBackupsParams) (*http.
 	return req, nil
 }
 
-// NewGetOrganizationBackupsRequest generates requests for GetOrganizationBackups
-func NewGetOrganizationBackupsRequest(server string, organization string, params *GetOrganizationBackupsParams) (*http.Request, error) {
+// NewDeleteBackupPolicyRequest generates requests for DeleteBackupPolicy
+func NewDeleteBackupPolicyRequest(server string, organization string, policy string, params *DeleteBackupPolicyParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1678,12 +1768,19 @@ func NewGetOrganizationBackupsRequest(server string, organization string, params
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "policy", runtime.ParamLocationPath, policy)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/backups/%s", pathParam0)
+	operationPath := fmt.Sprintf("/backuppolicies/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1696,9 +1793,9 @@ func NewGetOrganizationBackupsRequest(server string, organization string, params
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Offset != nil {
+		if params.TimeoutSeconds != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeoutSeconds", runtime.ParamLocationQuery, *params.TimeoutSeconds); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1712,8 +1809,220 @@ func NewGetOrganizationBackupsRequest(server string, organization string, params
 
 		}
 
-		if params.Cursor != nil {
-
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBackupPolicyRequest generates requests for GetBackupPolicy
+func NewGetBackupPolicyRequest(server string, organization string, policy string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "policy", runtime.ParamLocationPath, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/backuppolicies/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchBackupPolicyRequestWithApplicationJSONPatchPlusJSONBody calls the generic PatchBackupPolicy builder with application/json-patch+json body
+func NewPatchBackupPolicyRequestWithApplicationJSONPatchPlusJSONBody(server string, organization string, policy string, body PatchBackupPolicyApplicationJSONPatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchBackupPolicyRequestWithBody(server, organization, policy, "application/json-patch+json", bodyReader)
+}
+
+// NewPatchBackupPolicyRequestWithBody generates requests for PatchBackupPolicy with any type of body
+func NewPatchBackupPolicyRequestWithBody(server string, organization string, policy string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "policy", runtime.ParamLocationPath, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/backuppolicies/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateBackupPolicyRequest calls the generic CreateBackupPolicy builder with application/json body
+func NewCreateBackupPolicyRequest(server string, organization string, policy string, body CreateBackupPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateBackupPolicyRequestWithBody(server, organization, policy, "application/json", bodyReader)
+}
+
+// NewCreateBackupPolicyRequestWithBody generates requests for CreateBackupPolicy with any type of body
+func NewCreateBackupPolicyRequestWithBody(server string, organization string, policy string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "policy", runtime.ParamLocationPath, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/backuppolicies/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetBackupsFromPolicyRequest generates requests for GetBackupsFromPolicy
+func NewGetBackupsFromPolicyRequest(server string, organization string, policy string, params *GetBackupsFromPolicyParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "policy", runtime.ParamLocationPath, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/backuppolicies/%s/%s/backups", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
 			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
@@ -1819,8 +2128,8 @@ func NewGetOrganizationBackupsRequest(server string, organization string, params
 	return req, nil
 }
 
-// NewGetProjectBackupsRequest generates requests for GetProjectBackups
-func NewGetProjectBackupsRequest(server string, organization string, project string, params *GetProjectBackupsParams) (*http.Request, error) {
+// NewGetMatchingDatabasesRequest generates requests for GetMatchingDatabases
+func NewGetMatchingDatabasesRequest(server string, organization string, policy string, params *GetMatchingDatabasesParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1832,7 +2141,7 @@ func NewGetProjectBackupsRequest(server string, organization string, project str
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "policy", runtime.ParamLocationPath, policy)
 	if err != nil {
 		return nil, err
 	}
@@ -1842,7 +2151,7 @@ func NewGetProjectBackupsRequest(server string, organization string, project str
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/backups/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/backuppolicies/%s/%s/databases", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1978,37 +2287,16 @@ func NewGetProjectBackupsRequest(server string, organization string, project str
 	return req, nil
 }
 
-// NewGetBackupsRequest generates requests for GetBackups
-func NewGetBackupsRequest(server string, organization string, project string, database string, params *GetBackupsParams) (*http.Request, error) {
+// NewGetAllBackupsRequest generates requests for GetAllBackups
+func NewGetAllBackupsRequest(server string, params *GetAllBackupsParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "database", runtime.ParamLocationPath, database)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/backups/%s/%s/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/backups")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2144,19 +2432,8 @@ func NewGetBackupsRequest(server string, organization string, project string, da
 	return req, nil
 }
 
-// NewCreateBackupRequest calls the generic CreateBackup builder with application/json body
-func NewCreateBackupRequest(server string, organization string, project string, database string, body CreateBackupJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateBackupRequestWithBody(server, organization, project, database, "application/json", bodyReader)
-}
-
-// NewCreateBackupRequestWithBody generates requests for CreateBackup with any type of body
-func NewCreateBackupRequestWithBody(server string, organization string, project string, database string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetOrganizationBackupsRequest generates requests for GetOrganizationBackups
+func NewGetOrganizationBackupsRequest(server string, organization string, params *GetOrganizationBackupsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2166,26 +2443,12 @@ func NewCreateBackupRequestWithBody(server string, organization string, project
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "database", runtime.ParamLocationPath, database)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/backups/%s/%s/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/backups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2195,69 +2458,108 @@ func NewCreateBackupRequestWithBody(server string, organization string, project
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	req.Header.Add("Content-Type", contentType)
+		if params.Offset != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewDeleteBackupRequest generates requests for DeleteBackup
-func NewDeleteBackupRequest(server string, organization string, project string, database string, backup string, params *DeleteBackupParams) (*http.Request, error) {
-	var err error
+		}
 
-	var pathParam0 string
+		if params.Cursor != nil {
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	var pathParam1 string
+		}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
-	if err != nil {
-		return nil, err
-	}
+		if params.Limit != nil {
 
-	var pathParam2 string
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "database", runtime.ParamLocationPath, database)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	var pathParam3 string
+		if params.Expand != nil {
 
-	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "backup", runtime.ParamLocationPath, backup)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	operationPath := fmt.Sprintf("/backups/%s/%s/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		if params.LabelFilter != nil {
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelFilter", runtime.ParamLocationQuery, *params.LabelFilter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+		}
 
-		if params.TimeoutSeconds != nil {
+		if params.FieldFilter != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeoutSeconds", runtime.ParamLocationQuery, *params.TimeoutSeconds); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fieldFilter", runtime.ParamLocationQuery, *params.FieldFilter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ListAccessible != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "listAccessible", runtime.ParamLocationQuery, *params.ListAccessible); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2274,7 +2576,7 @@ func NewDeleteBackupRequest(server string, organization string, project string,
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2282,8 +2584,8 @@ func NewDeleteBackupRequest(server string, organization string, project string,
 	return req, nil
 }
 
-// NewGetBackupRequest generates requests for GetBackup
-func NewGetBackupRequest(server string, organization string, project string, database string, backup string) (*http.Request, error) {
+// NewGetProjectBackupsRequest generates requests for GetProjectBackups
+func NewGetProjectBackupsRequest(server string, organization string, project string, params *GetProjectBackupsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2300,26 +2602,12 @@ func NewGetBackupRequest(server string, organization string, project string, dat
 		return nil, err
 	}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "database", runtime.ParamLocationPath, database)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam3 string
-
-	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "backup", runtime.ParamLocationPath, backup)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/backups/%s/%s/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/backups/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2329,221 +2617,58 @@ func NewGetBackupRequest(server string, organization string, project string, dat
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.Offset != nil {
 
-// NewPatchBackupRequestWithApplicationJSONPatchPlusJSONBody calls the generic PatchBackup builder with application/json-patch+json body
-func NewPatchBackupRequestWithApplicationJSONPatchPlusJSONBody(server string, organization string, project string, database string, backup string, body PatchBackupApplicationJSONPatchPlusJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPatchBackupRequestWithBody(server, organization, project, database, backup, "application/json-patch+json", bodyReader)
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewPatchBackupRequestWithBody generates requests for PatchBackup with any type of body
-func NewPatchBackupRequestWithBody(server string, organization string, project string, database string, backup string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+		}
 
-	var pathParam0 string
+		if params.Cursor != nil {
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	var pathParam1 string
+		}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
-	if err != nil {
-		return nil, err
-	}
+		if params.Limit != nil {
 
-	var pathParam2 string
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "database", runtime.ParamLocationPath, database)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	var pathParam3 string
-
-	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "backup", runtime.ParamLocationPath, backup)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/backups/%s/%s/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewCreateOrUpdateBackupRequest calls the generic CreateOrUpdateBackup builder with application/json body
-func NewCreateOrUpdateBackupRequest(server string, organization string, project string, database string, backup string, body CreateOrUpdateBackupJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateOrUpdateBackupRequestWithBody(server, organization, project, database, backup, "application/json", bodyReader)
-}
-
-// NewCreateOrUpdateBackupRequestWithBody generates requests for CreateOrUpdateBackup with any type of body
-func NewCreateOrUpdateBackupRequestWithBody(server string, organization string, project string, database string, backup string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "database", runtime.ParamLocationPath, database)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam3 string
-
-	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "backup", runtime.ParamLocationPath, backup)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/backups/%s/%s/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewGetAllDatabasesRequest generates requests for GetAllDatabases
-func NewGetAllDatabasesRequest(server string, params *GetAllDatabasesParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/databases")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Cursor != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Expand != nil {
+		if params.Expand != nil {
 
 			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
 				return nil, err
@@ -2618,8 +2743,8 @@ func NewGetAllDatabasesRequest(server string, params *GetAllDatabasesParams) (*h
 	return req, nil
 }
 
-// NewGetOrganizationDatabasesRequest generates requests for GetOrganizationDatabases
-func NewGetOrganizationDatabasesRequest(server string, organization string, params *GetOrganizationDatabasesParams) (*http.Request, error) {
+// NewGetBackupsRequest generates requests for GetBackups
+func NewGetBackupsRequest(server string, organization string, project string, database string, params *GetBackupsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2629,12 +2754,26 @@ func NewGetOrganizationDatabasesRequest(server string, organization string, para
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "database", runtime.ParamLocationPath, database)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/databases/%s", pathParam0)
+	operationPath := fmt.Sprintf("/backups/%s/%s/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2770,8 +2909,19 @@ func NewGetOrganizationDatabasesRequest(server string, organization string, para
 	return req, nil
 }
 
-// NewGetDatabasesRequest generates requests for GetDatabases
-func NewGetDatabasesRequest(server string, organization string, project string, params *GetDatabasesParams) (*http.Request, error) {
+// NewCreateBackupRequest calls the generic CreateBackup builder with application/json body
+func NewCreateBackupRequest(server string, organization string, project string, database string, body CreateBackupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateBackupRequestWithBody(server, organization, project, database, "application/json", bodyReader)
+}
+
+// NewCreateBackupRequestWithBody generates requests for CreateBackup with any type of body
+func NewCreateBackupRequestWithBody(server string, organization string, project string, database string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2788,12 +2938,19 @@ func NewGetDatabasesRequest(server string, organization string, project string,
 		return nil, err
 	}
 
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "database", runtime.ParamLocationPath, database)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/databases/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/backups/%s/%s/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2803,153 +2960,44 @@ func NewGetDatabasesRequest(server string, organization string, project string,
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Offset != nil {
+	req.Header.Add("Content-Type", contentType)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewDeleteBackupRequest generates requests for DeleteBackup
+func NewDeleteBackupRequest(server string, organization string, project string, database string, backup string, params *DeleteBackupParams) (*http.Request, error) {
+	var err error
 
-		if params.Cursor != nil {
+	var pathParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	var pathParam1 string
 
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Expand != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.LabelFilter != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelFilter", runtime.ParamLocationQuery, *params.LabelFilter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.FieldFilter != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fieldFilter", runtime.ParamLocationQuery, *params.FieldFilter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.ListAccessible != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "listAccessible", runtime.ParamLocationQuery, *params.ListAccessible); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewDeleteDatabaseRequest generates requests for DeleteDatabase
-func NewDeleteDatabaseRequest(server string, organization string, project string, database string, params *DeleteDatabaseParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	var pathParam2 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "database", runtime.ParamLocationPath, database)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam2 string
+	var pathParam3 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "database", runtime.ParamLocationPath, database)
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "backup", runtime.ParamLocationPath, backup)
 	if err != nil {
 		return nil, err
 	}
@@ -2959,7 +3007,7 @@ func NewDeleteDatabaseRequest(server string, organization string, project string
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/databases/%s/%s/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/backups/%s/%s/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2999,8 +3047,8 @@ func NewDeleteDatabaseRequest(server string, organization string, project string
 	return req, nil
 }
 
-// NewGetDatabaseRequest generates requests for GetDatabase
-func NewGetDatabaseRequest(server string, organization string, project string, database string) (*http.Request, error) {
+// NewGetBackupRequest generates requests for GetBackup
+func NewGetBackupRequest(server string, organization string, project string, database string, backup string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3024,12 +3072,19 @@ func NewGetDatabaseRequest(server string, organization string, project string, d
 		return nil, err
 	}
 
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "backup", runtime.ParamLocationPath, backup)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/databases/%s/%s/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/backups/%s/%s/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3047,19 +3102,19 @@ func NewGetDatabaseRequest(server string, organization string, project string, d
 	return req, nil
 }
 
-// NewPatchDatabaseRequestWithApplicationJSONPatchPlusJSONBody calls the generic PatchDatabase builder with application/json-patch+json body
-func NewPatchDatabaseRequestWithApplicationJSONPatchPlusJSONBody(server string, organization string, project string, database string, body PatchDatabaseApplicationJSONPatchPlusJSONRequestBody) (*http.Request, error) {
+// NewPatchBackupRequestWithApplicationJSONPatchPlusJSONBody calls the generic PatchBackup builder with application/json-patch+json body
+func NewPatchBackupRequestWithApplicationJSONPatchPlusJSONBody(server string, organization string, project string, database string, backup string, body PatchBackupApplicationJSONPatchPlusJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPatchDatabaseRequestWithBody(server, organization, project, database, "application/json-patch+json", bodyReader)
+	return NewPatchBackupRequestWithBody(server, organization, project, database, backup, "application/json-patch+json", bodyReader)
 }
 
-// NewPatchDatabaseRequestWithBody generates requests for PatchDatabase with any type of body
-func NewPatchDatabaseRequestWithBody(server string, organization string, project string, database string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPatchBackupRequestWithBody generates requests for PatchBackup with any type of body
+func NewPatchBackupRequestWithBody(server string, organization string, project string, database string, backup string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3083,12 +3138,19 @@ func NewPatchDatabaseRequestWithBody(server string, organization string, project
 		return nil, err
 	}
 
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "backup", runtime.ParamLocationPath, backup)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/databases/%s/%s/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/backups/%s/%s/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3108,19 +3170,19 @@ func NewPatchDatabaseRequestWithBody(server string, organization string, project
 	return req, nil
 }
 
-// NewCreateDatabaseRequest calls the generic CreateDatabase builder with application/json body
-func NewCreateDatabaseRequest(server string, organization string, project string, database string, body CreateDatabaseJSONRequestBody) (*http.Request, error) {
+// NewCreateOrUpdateBackupRequest calls the generic CreateOrUpdateBackup builder with application/json body
+func NewCreateOrUpdateBackupRequest(server string, organization string, project string, database string, backup string, body CreateOrUpdateBackupJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateDatabaseRequestWithBody(server, organization, project, database, "application/json", bodyReader)
+	return NewCreateOrUpdateBackupRequestWithBody(server, organization, project, database, backup, "application/json", bodyReader)
 }
 
-// NewCreateDatabaseRequestWithBody generates requests for CreateDatabase with any type of body
-func NewCreateDatabaseRequestWithBody(server string, organization string, project string, database string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateOrUpdateBackupRequestWithBody generates requests for CreateOrUpdateBackup with any type of body
+func NewCreateOrUpdateBackupRequestWithBody(server string, organization string, project string, database string, backup string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3144,63 +3206,9 @@ func NewCreateDatabaseRequestWithBody(server string, organization string, projec
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/databases/%s/%s/%s", pathParam0, pathParam1, pathParam2)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewUpdateDbaPasswordRequest calls the generic UpdateDbaPassword builder with application/json body
-func NewUpdateDbaPasswordRequest(server string, organization string, project string, database string, params *UpdateDbaPasswordParams, body UpdateDbaPasswordJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateDbaPasswordRequestWithBody(server, organization, project, database, params, "application/json", bodyReader)
-}
-
-// NewUpdateDbaPasswordRequestWithBody generates requests for UpdateDbaPassword with any type of body
-func NewUpdateDbaPasswordRequestWithBody(server string, organization string, project string, database string, params *UpdateDbaPasswordParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
+	var pathParam3 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "database", runtime.ParamLocationPath, database)
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "backup", runtime.ParamLocationPath, backup)
 	if err != nil {
 		return nil, err
 	}
@@ -3210,7 +3218,7 @@ func NewUpdateDbaPasswordRequestWithBody(server string, organization string, pro
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/databases/%s/%s/%s/dbaPassword", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/backups/%s/%s/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3220,29 +3228,7 @@ func NewUpdateDbaPasswordRequestWithBody(server string, organization string, pro
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.TimeoutSeconds != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeoutSeconds", runtime.ParamLocationQuery, *params.TimeoutSeconds); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3252,8 +3238,8 @@ func NewUpdateDbaPasswordRequestWithBody(server string, organization string, pro
 	return req, nil
 }
 
-// NewGetAllProjectsRequest generates requests for GetAllProjects
-func NewGetAllProjectsRequest(server string, params *GetAllProjectsParams) (*http.Request, error) {
+// NewGetCanaryRolloutsRequest generates requests for GetCanaryRollouts
+func NewGetCanaryRolloutsRequest(server string, params *GetCanaryRolloutsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3261,7 +3247,7 @@ func NewGetAllProjectsRequest(server string, params *GetAllProjectsParams) (*htt
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects")
+	operationPath := fmt.Sprintf("/cluster/canaryrollouts")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3338,41 +3324,49 @@ func NewGetAllProjectsRequest(server string, params *GetAllProjectsParams) (*htt
 
 		}
 
-		if params.LabelFilter != nil {
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelFilter", runtime.ParamLocationQuery, *params.LabelFilter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.FieldFilter != nil {
+// NewDeleteCanaryRolloutRequest generates requests for DeleteCanaryRollout
+func NewDeleteCanaryRolloutRequest(server string, name string, params *DeleteCanaryRolloutParams) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fieldFilter", runtime.ParamLocationQuery, *params.FieldFilter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	var pathParam0 string
 
-		}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.ListAccessible != nil {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "listAccessible", runtime.ParamLocationQuery, *params.ListAccessible); err != nil {
+	operationPath := fmt.Sprintf("/cluster/canaryrollouts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.TimeoutSeconds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeoutSeconds", runtime.ParamLocationQuery, *params.TimeoutSeconds); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3389,6 +3383,40 @@ func NewGetAllProjectsRequest(server string, params *GetAllProjectsParams) (*htt
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCanaryRolloutRequest generates requests for GetCanaryRollout
+func NewGetCanaryRolloutRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cluster/canaryrollouts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -3397,13 +3425,24 @@ func NewGetAllProjectsRequest(server string, params *GetAllProjectsParams) (*htt
 	return req, nil
 }
 
-// NewGetProjectsRequest generates requests for GetProjects
-func NewGetProjectsRequest(server string, organization string, params *GetProjectsParams) (*http.Request, error) {
+// NewPatchCanaryRolloutRequestWithApplicationJSONPatchPlusJSONBody calls the generic PatchCanaryRollout builder with application/json-patch+json body
+func NewPatchCanaryRolloutRequestWithApplicationJSONPatchPlusJSONBody(server string, name string, params *PatchCanaryRolloutParams, body PatchCanaryRolloutApplicationJSONPatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchCanaryRolloutRequestWithBody(server, name, params, "application/json-patch+json", bodyReader)
+}
+
+// NewPatchCanaryRolloutRequestWithBody generates requests for PatchCanaryRollout with any type of body
+func NewPatchCanaryRolloutRequestWithBody(server string, name string, params *PatchCanaryRolloutParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -3413,7 +3452,7 @@ func NewGetProjectsRequest(server string, organization string, params *GetProjec
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s", pathParam0)
+	operationPath := fmt.Sprintf("/cluster/canaryrollouts/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3426,9 +3465,9 @@ func NewGetProjectsRequest(server string, organization string, params *GetProjec
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Offset != nil {
+		if params.UpdateStatus != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "updateStatus", runtime.ParamLocationQuery, *params.UpdateStatus); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3442,25 +3481,62 @@ func NewGetProjectsRequest(server string, organization string, params *GetProjec
 
 		}
 
-		if params.Cursor != nil {
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	req.Header.Add("Content-Type", contentType)
 
-		if params.Limit != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+// NewCreateCanaryRolloutRequest calls the generic CreateCanaryRollout builder with application/json body
+func NewCreateCanaryRolloutRequest(server string, name string, params *CreateCanaryRolloutParams, body CreateCanaryRolloutJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateCanaryRolloutRequestWithBody(server, name, params, "application/json", bodyReader)
+}
+
+// NewCreateCanaryRolloutRequestWithBody generates requests for CreateCanaryRollout with any type of body
+func NewCreateCanaryRolloutRequestWithBody(server string, name string, params *CreateCanaryRolloutParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cluster/canaryrollouts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UpdateStatus != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "updateStatus", runtime.ParamLocationQuery, *params.UpdateStatus); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3474,9 +3550,44 @@ func NewGetProjectsRequest(server string, organization string, params *GetProjec
 
 		}
 
-		if params.Expand != nil {
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetCanaryRolloutTemplatesRequest generates requests for GetCanaryRolloutTemplates
+func NewGetCanaryRolloutTemplatesRequest(server string, params *GetCanaryRolloutTemplatesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cluster/canaryrollouttemplates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3490,9 +3601,9 @@ func NewGetProjectsRequest(server string, organization string, params *GetProjec
 
 		}
 
-		if params.LabelFilter != nil {
+		if params.Cursor != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelFilter", runtime.ParamLocationQuery, *params.LabelFilter); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3506,9 +3617,9 @@ func NewGetProjectsRequest(server string, organization string, params *GetProjec
 
 		}
 
-		if params.FieldFilter != nil {
+		if params.Limit != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fieldFilter", runtime.ParamLocationQuery, *params.FieldFilter); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3522,9 +3633,9 @@ func NewGetProjectsRequest(server string, organization string, params *GetProjec
 
 		}
 
-		if params.ListAccessible != nil {
+		if params.Expand != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "listAccessible", runtime.ParamLocationQuery, *params.ListAccessible); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3549,20 +3660,13 @@ func NewGetProjectsRequest(server string, organization string, params *GetProjec
 	return req, nil
 }
 
-// NewDeleteProjectRequest generates requests for DeleteProject
-func NewDeleteProjectRequest(server string, organization string, project string, params *DeleteProjectParams) (*http.Request, error) {
+// NewDeleteCanaryRolloutTemplateRequest generates requests for DeleteCanaryRolloutTemplate
+func NewDeleteCanaryRolloutTemplateRequest(server string, name string, params *DeleteCanaryRolloutTemplateParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -3572,7 +3676,7 @@ func NewDeleteProjectRequest(server string, organization string, project string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/cluster/canaryrollouttemplates/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3612,20 +3716,13 @@ func NewDeleteProjectRequest(server string, organization string, project string,
 	return req, nil
 }
 
-// NewGetProjectRequest generates requests for GetProject
-func NewGetProjectRequest(server string, organization string, project string) (*http.Request, error) {
+// NewGetCanaryRolloutTemplateRequest generates requests for GetCanaryRolloutTemplate
+func NewGetCanaryRolloutTemplateRequest(server string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -3635,7 +3732,7 @@ func NewGetProjectRequest(server string, organization string, project string) (*
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/cluster/canaryrollouttemplates/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3653,31 +3750,24 @@ func NewGetProjectRequest(server string, organization string, project string) (*
 	return req, nil
 }
 
-// NewPatchProjectRequestWithApplicationJSONPatchPlusJSONBody calls the generic PatchProject builder with application/json-patch+json body
-func NewPatchProjectRequestWithApplicationJSONPatchPlusJSONBody(server string, organization string, project string, body PatchProjectApplicationJSONPatchPlusJSONRequestBody) (*http.Request, error) {
+// NewPatchCanaryRolloutTemplateRequestWithApplicationJSONPatchPlusJSONBody calls the generic PatchCanaryRolloutTemplate builder with application/json-patch+json body
+func NewPatchCanaryRolloutTemplateRequestWithApplicationJSONPatchPlusJSONBody(server string, name string, params *PatchCanaryRolloutTemplateParams, body PatchCanaryRolloutTemplateApplicationJSONPatchPlusJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPatchProjectRequestWithBody(server, organization, project, "application/json-patch+json", bodyReader)
+	return NewPatchCanaryRolloutTemplateRequestWithBody(server, name, params, "application/json-patch+json", bodyReader)
 }
 
-// NewPatchProjectRequestWithBody generates requests for PatchProject with any type of body
-func NewPatchProjectRequestWithBody(server string, organization string, project string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPatchCanaryRolloutTemplateRequestWithBody generates requests for PatchCanaryRolloutTemplate with any type of body
+func NewPatchCanaryRolloutTemplateRequestWithBody(server string, name string, params *PatchCanaryRolloutTemplateParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -3687,7 +3777,7 @@ func NewPatchProjectRequestWithBody(server string, organization string, project
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/cluster/canaryrollouttemplates/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3697,6 +3787,28 @@ func NewPatchProjectRequestWithBody(server string, organization string, project
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UpdateStatus != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "updateStatus", runtime.ParamLocationQuery, *params.UpdateStatus); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
@@ -3707,31 +3819,24 @@ func NewPatchProjectRequestWithBody(server string, organization string, project
 	return req, nil
 }
 
-// NewCreateProjectRequest calls the generic CreateProject builder with application/json body
-func NewCreateProjectRequest(server string, organization string, project string, body CreateProjectJSONRequestBody) (*http.Request, error) {
+// NewCreateCanaryRolloutTemplateRequest calls the generic CreateCanaryRolloutTemplate builder with application/json body
+func NewCreateCanaryRolloutTemplateRequest(server string, name string, params *CreateCanaryRolloutTemplateParams, body CreateCanaryRolloutTemplateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateProjectRequestWithBody(server, organization, project, "application/json", bodyReader)
+	return NewCreateCanaryRolloutTemplateRequestWithBody(server, name, params, "application/json", bodyReader)
 }
 
-// NewCreateProjectRequestWithBody generates requests for CreateProject with any type of body
-func NewCreateProjectRequestWithBody(server string, organization string, project string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateCanaryRolloutTemplateRequestWithBody generates requests for CreateCanaryRolloutTemplate with any type of body
+func NewCreateCanaryRolloutTemplateRequestWithBody(server string, name string, params *CreateCanaryRolloutTemplateParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -3741,7 +3846,7 @@ func NewCreateProjectRequestWithBody(server string, organization string, project
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/cluster/canaryrollouttemplates/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3751,6 +3856,28 @@ func NewCreateProjectRequestWithBody(server string, organization string, project
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UpdateStatus != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "updateStatus", runtime.ParamLocationQuery, *params.UpdateStatus); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
@@ -3761,1397 +3888,8536 @@ func NewCreateProjectRequestWithBody(server string, organization string, project
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewGetDatabaseQuotasRequest generates requests for GetDatabaseQuotas
+func NewGetDatabaseQuotasRequest(server string, params *GetDatabaseQuotasParams) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	operationPath := fmt.Sprintf("/cluster/databasequotas")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetAllBackupPoliciesWithResponse request
-	GetAllBackupPoliciesWithResponse(ctx context.Context, params *GetAllBackupPoliciesParams, reqEditors ...RequestEditorFn) (*GetAllBackupPoliciesResponse, error)
-
-	// GetBackupPoliciesWithResponse request
-	GetBackupPoliciesWithResponse(ctx context.Context, organization string, params *GetBackupPoliciesParams, reqEditors ...RequestEditorFn) (*GetBackupPoliciesResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteBackupPolicyWithResponse request
-	DeleteBackupPolicyWithResponse(ctx context.Context, organization string, policy string, params *DeleteBackupPolicyParams, reqEditors ...RequestEditorFn) (*DeleteBackupPolicyResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// GetBackupPolicyWithResponse request
-	GetBackupPolicyWithResponse(ctx context.Context, organization string, policy string, reqEditors ...RequestEditorFn) (*GetBackupPolicyResponse, error)
+		if params.Offset != nil {
 
-	// PatchBackupPolicyWithBodyWithResponse request with any body
-	PatchBackupPolicyWithBodyWithResponse(ctx context.Context, organization string, policy string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchBackupPolicyResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	PatchBackupPolicyWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, organization string, policy string, body PatchBackupPolicyApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchBackupPolicyResponse, error)
+		}
 
-	// CreateBackupPolicyWithBodyWithResponse request with any body
-	CreateBackupPolicyWithBodyWithResponse(ctx context.Context, organization string, policy string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBackupPolicyResponse, error)
+		if params.Cursor != nil {
 
-	CreateBackupPolicyWithResponse(ctx context.Context, organization string, policy string, body CreateBackupPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBackupPolicyResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// GetBackupsFromPolicyWithResponse request
-	GetBackupsFromPolicyWithResponse(ctx context.Context, organization string, policy string, params *GetBackupsFromPolicyParams, reqEditors ...RequestEditorFn) (*GetBackupsFromPolicyResponse, error)
+		}
 
-	// GetMatchingDatabasesWithResponse request
-	GetMatchingDatabasesWithResponse(ctx context.Context, organization string, policy string, params *GetMatchingDatabasesParams, reqEditors ...RequestEditorFn) (*GetMatchingDatabasesResponse, error)
+		if params.Limit != nil {
 
-	// GetAllBackupsWithResponse request
-	GetAllBackupsWithResponse(ctx context.Context, params *GetAllBackupsParams, reqEditors ...RequestEditorFn) (*GetAllBackupsResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// GetOrganizationBackupsWithResponse request
-	GetOrganizationBackupsWithResponse(ctx context.Context, organization string, params *GetOrganizationBackupsParams, reqEditors ...RequestEditorFn) (*GetOrganizationBackupsResponse, error)
+		}
 
-	// GetProjectBackupsWithResponse request
-	GetProjectBackupsWithResponse(ctx context.Context, organization string, project string, params *GetProjectBackupsParams, reqEditors ...RequestEditorFn) (*GetProjectBackupsResponse, error)
+		if params.Expand != nil {
 
-	// GetBackupsWithResponse request
-	GetBackupsWithResponse(ctx context.Context, organization string, project string, database string, params *GetBackupsParams, reqEditors ...RequestEditorFn) (*GetBackupsResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// CreateBackupWithBodyWithResponse request with any body
-	CreateBackupWithBodyWithResponse(ctx context.Context, organization string, project string, database string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBackupResponse, error)
+		}
 
-	CreateBackupWithResponse(ctx context.Context, organization string, project string, database string, body CreateBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBackupResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// DeleteBackupWithResponse request
-	DeleteBackupWithResponse(ctx context.Context, organization string, project string, database string, backup string, params *DeleteBackupParams, reqEditors ...RequestEditorFn) (*DeleteBackupResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetBackupWithResponse request
-	GetBackupWithResponse(ctx context.Context, organization string, project string, database string, backup string, reqEditors ...RequestEditorFn) (*GetBackupResponse, error)
+	return req, nil
+}
 
-	// PatchBackupWithBodyWithResponse request with any body
-	PatchBackupWithBodyWithResponse(ctx context.Context, organization string, project string, database string, backup string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchBackupResponse, error)
+// NewDeleteDatabaseQuotaRequest generates requests for DeleteDatabaseQuota
+func NewDeleteDatabaseQuotaRequest(server string, name string, params *DeleteDatabaseQuotaParams) (*http.Request, error) {
+	var err error
 
-	PatchBackupWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, organization string, project string, database string, backup string, body PatchBackupApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchBackupResponse, error)
+	var pathParam0 string
 
-	// CreateOrUpdateBackupWithBodyWithResponse request with any body
-	CreateOrUpdateBackupWithBodyWithResponse(ctx context.Context, organization string, project string, database string, backup string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrUpdateBackupResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateOrUpdateBackupWithResponse(ctx context.Context, organization string, project string, database string, backup string, body CreateOrUpdateBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrUpdateBackupResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetAllDatabasesWithResponse request
-	GetAllDatabasesWithResponse(ctx context.Context, params *GetAllDatabasesParams, reqEditors ...RequestEditorFn) (*GetAllDatabasesResponse, error)
+	operationPath := fmt.Sprintf("/cluster/databasequotas/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetOrganizationDatabasesWithResponse request
-	GetOrganizationDatabasesWithResponse(ctx context.Context, organization string, params *GetOrganizationDatabasesParams, reqEditors ...RequestEditorFn) (*GetOrganizationDatabasesResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetDatabasesWithResponse request
-	GetDatabasesWithResponse(ctx context.Context, organization string, project string, params *GetDatabasesParams, reqEditors ...RequestEditorFn) (*GetDatabasesResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// DeleteDatabaseWithResponse request
-	DeleteDatabaseWithResponse(ctx context.Context, organization string, project string, database string, params *DeleteDatabaseParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseResponse, error)
+		if params.TimeoutSeconds != nil {
 
-	// GetDatabaseWithResponse request
-	GetDatabaseWithResponse(ctx context.Context, organization string, project string, database string, reqEditors ...RequestEditorFn) (*GetDatabaseResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeoutSeconds", runtime.ParamLocationQuery, *params.TimeoutSeconds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// PatchDatabaseWithBodyWithResponse request with any body
-	PatchDatabaseWithBodyWithResponse(ctx context.Context, organization string, project string, database string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchDatabaseResponse, error)
+		}
 
-	PatchDatabaseWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, organization string, project string, database string, body PatchDatabaseApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchDatabaseResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// CreateDatabaseWithBodyWithResponse request with any body
-	CreateDatabaseWithBodyWithResponse(ctx context.Context, organization string, project string, database string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateDatabaseWithResponse(ctx context.Context, organization string, project string, database string, body CreateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error)
+	return req, nil
+}
 
-	// UpdateDbaPasswordWithBodyWithResponse request with any body
-	UpdateDbaPasswordWithBodyWithResponse(ctx context.Context, organization string, project string, database string, params *UpdateDbaPasswordParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDbaPasswordResponse, error)
+// NewGetDatabaseQuotaRequest generates requests for GetDatabaseQuota
+func NewGetDatabaseQuotaRequest(server string, name string) (*http.Request, error) {
+	var err error
 
-	UpdateDbaPasswordWithResponse(ctx context.Context, organization string, project string, database string, params *UpdateDbaPasswordParams, body UpdateDbaPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDbaPasswordResponse, error)
+	var pathParam0 string
 
-	// GetAllProjectsWithResponse request
-	GetAllProjectsWithResponse(ctx context.Context, params *GetAllProjectsParams, reqEditors ...RequestEditorFn) (*GetAllProjectsResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetProjectsWithResponse request
-	GetProjectsWithResponse(ctx context.Context, organization string, params *GetProjectsParams, reqEditors ...RequestEditorFn) (*GetProjectsResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteProjectWithResponse request
-	DeleteProjectWithResponse(ctx context.Context, organization string, project string, params *DeleteProjectParams, reqEditors ...RequestEditorFn) (*DeleteProjectResponse, error)
+	operationPath := fmt.Sprintf("/cluster/databasequotas/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetProjectWithResponse request
-	GetProjectWithResponse(ctx context.Context, organization string, project string, reqEditors ...RequestEditorFn) (*GetProjectResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PatchProjectWithBodyWithResponse request with any body
-	PatchProjectWithBodyWithResponse(ctx context.Context, organization string, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchProjectResponse, error)
-
-	PatchProjectWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, organization string, project string, body PatchProjectApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchProjectResponse, error)
-
-	// CreateProjectWithBodyWithResponse request with any body
-	CreateProjectWithBodyWithResponse(ctx context.Context, organization string, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error)
-
-	CreateProjectWithResponse(ctx context.Context, organization string, project string, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error)
-}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-type GetAllBackupPoliciesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ItemList
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON500      *ErrorContent
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAllBackupPoliciesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPatchDatabaseQuotaRequestWithApplicationJSONPatchPlusJSONBody calls the generic PatchDatabaseQuota builder with application/json-patch+json body
+func NewPatchDatabaseQuotaRequestWithApplicationJSONPatchPlusJSONBody(server string, name string, params *PatchDatabaseQuotaParams, body PatchDatabaseQuotaApplicationJSONPatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchDatabaseQuotaRequestWithBody(server, name, params, "application/json-patch+json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAllBackupPoliciesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPatchDatabaseQuotaRequestWithBody generates requests for PatchDatabaseQuota with any type of body
+func NewPatchDatabaseQuotaRequestWithBody(server string, name string, params *PatchDatabaseQuotaParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetBackupPoliciesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ItemList
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON500      *ErrorContent
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetBackupPoliciesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/cluster/databasequotas/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetBackupPoliciesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteBackupPolicyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON404      *ErrorContent
-	JSON408      *ErrorContent
-	JSON500      *ErrorContent
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r DeleteBackupPolicyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		if params.UpdateStatus != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "updateStatus", runtime.ParamLocationQuery, *params.UpdateStatus); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteBackupPolicyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetBackupPolicyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BackupPolicyModel
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON404      *ErrorContent
-	JSON500      *ErrorContent
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetBackupPolicyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewCreateDatabaseQuotaRequest calls the generic CreateDatabaseQuota builder with application/json body
+func NewCreateDatabaseQuotaRequest(server string, name string, params *CreateDatabaseQuotaParams, body CreateDatabaseQuotaJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDatabaseQuotaRequestWithBody(server, name, params, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetBackupPolicyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewCreateDatabaseQuotaRequestWithBody generates requests for CreateDatabaseQuota with any type of body
+func NewCreateDatabaseQuotaRequestWithBody(server string, name string, params *CreateDatabaseQuotaParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PatchBackupPolicyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BackupPolicyModel
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON404      *ErrorContent
-	JSON409      *ErrorContent
-	JSON415      *ErrorContent
-	JSON500      *ErrorContent
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r PatchBackupPolicyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/cluster/databasequotas/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchBackupPolicyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateBackupPolicyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BackupPolicyModel
-	JSON201      *BackupPolicyModel
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON404      *ErrorContent
-	JSON409      *ErrorContent
-	JSON415      *ErrorContent
-	JSON500      *ErrorContent
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r CreateBackupPolicyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		if params.UpdateStatus != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "updateStatus", runtime.ParamLocationQuery, *params.UpdateStatus); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateBackupPolicyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetBackupsFromPolicyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ItemList
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON404      *ErrorContent
-	JSON500      *ErrorContent
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r GetBackupsFromPolicyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetBackupsFromPolicyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetHelmFeaturesRequest generates requests for GetHelmFeatures
+func NewGetHelmFeaturesRequest(server string, params *GetHelmFeaturesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetMatchingDatabasesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ItemList
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON404      *ErrorContent
-	JSON500      *ErrorContent
-}
-
-// Status returns HTTPResponse.Status
-func (r GetMatchingDatabasesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/cluster/helmfeatures")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetMatchingDatabasesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetAllBackupsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ItemList
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON500      *ErrorContent
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r GetAllBackupsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Offset != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAllBackupsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type GetOrganizationBackupsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ItemList
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON500      *ErrorContent
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r GetOrganizationBackupsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Cursor != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOrganizationBackupsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type GetProjectBackupsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ItemList
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON500      *ErrorContent
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r GetProjectBackupsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Limit != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetProjectBackupsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type GetBackupsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ItemList
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON500      *ErrorContent
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r GetBackupsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Expand != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetBackupsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type CreateBackupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *BackupModel
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON404      *ErrorContent
-	JSON409      *ErrorContent
-	JSON415      *ErrorContent
-	JSON500      *ErrorContent
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r CreateBackupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateBackupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteBackupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON404      *ErrorContent
-	JSON408      *ErrorContent
-	JSON500      *ErrorContent
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteBackupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewDeleteHelmFeatureRequest generates requests for DeleteHelmFeature
+func NewDeleteHelmFeatureRequest(server string, name string, params *DeleteHelmFeatureParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteBackupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetBackupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BackupModel
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON404      *ErrorContent
-	JSON500      *ErrorContent
-}
+	operationPath := fmt.Sprintf("/cluster/helmfeatures/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetBackupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetBackupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-type PatchBackupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BackupModel
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON404      *ErrorContent
-	JSON409      *ErrorContent
-	JSON415      *ErrorContent
-	JSON500      *ErrorContent
-}
+		if params.TimeoutSeconds != nil {
 
-// Status returns HTTPResponse.Status
-func (r PatchBackupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeoutSeconds", runtime.ParamLocationQuery, *params.TimeoutSeconds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchBackupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateOrUpdateBackupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BackupModel
-	JSON201      *BackupModel
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON404      *ErrorContent
-	JSON409      *ErrorContent
-	JSON415      *ErrorContent
-	JSON500      *ErrorContent
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateOrUpdateBackupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetHelmFeatureRequest generates requests for GetHelmFeature
+func NewGetHelmFeatureRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateOrUpdateBackupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetAllDatabasesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ItemList
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON500      *ErrorContent
-}
+	operationPath := fmt.Sprintf("/cluster/helmfeatures/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetAllDatabasesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAllDatabasesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetOrganizationDatabasesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ItemList
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON500      *ErrorContent
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetOrganizationDatabasesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPatchHelmFeatureRequestWithApplicationJSONPatchPlusJSONBody calls the generic PatchHelmFeature builder with application/json-patch+json body
+func NewPatchHelmFeatureRequestWithApplicationJSONPatchPlusJSONBody(server string, name string, params *PatchHelmFeatureParams, body PatchHelmFeatureApplicationJSONPatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchHelmFeatureRequestWithBody(server, name, params, "application/json-patch+json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOrganizationDatabasesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPatchHelmFeatureRequestWithBody generates requests for PatchHelmFeature with any type of body
+func NewPatchHelmFeatureRequestWithBody(server string, name string, params *PatchHelmFeatureParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetDatabasesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ItemList
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON404      *ErrorContent
-	JSON500      *ErrorContent
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetDatabasesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/cluster/helmfeatures/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabasesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteDatabaseResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON404      *ErrorContent
-	JSON408      *ErrorContent
-	JSON500      *ErrorContent
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r DeleteDatabaseResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		if params.UpdateStatus != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "updateStatus", runtime.ParamLocationQuery, *params.UpdateStatus); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDatabaseResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetDatabaseResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseModel
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON404      *ErrorContent
-	JSON500      *ErrorContent
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetDatabaseResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewCreateHelmFeatureRequest calls the generic CreateHelmFeature builder with application/json body
+func NewCreateHelmFeatureRequest(server string, name string, params *CreateHelmFeatureParams, body CreateHelmFeatureJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateHelmFeatureRequestWithBody(server, name, params, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewCreateHelmFeatureRequestWithBody generates requests for CreateHelmFeature with any type of body
+func NewCreateHelmFeatureRequestWithBody(server string, name string, params *CreateHelmFeatureParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PatchDatabaseResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseModel
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON404      *ErrorContent
-	JSON409      *ErrorContent
-	JSON415      *ErrorContent
-	JSON422      *ErrorContent
-	JSON500      *ErrorContent
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r PatchDatabaseResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/cluster/helmfeatures/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchDatabaseResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateDatabaseResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseModel
-	JSON201      *DatabaseModel
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON404      *ErrorContent
-	JSON409      *ErrorContent
-	JSON415      *ErrorContent
-	JSON422      *ErrorContent
-	JSON500      *ErrorContent
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r CreateDatabaseResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.UpdateStatus != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateDatabaseResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "updateStatus", runtime.ParamLocationQuery, *params.UpdateStatus); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type UpdateDbaPasswordResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON404      *ErrorContent
-	JSON408      *ErrorContent
-	JSON409      *ErrorContent
-	JSON415      *ErrorContent
-	JSON500      *ErrorContent
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r UpdateDbaPasswordResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateDbaPasswordResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetAllProjectsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ItemList
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON500      *ErrorContent
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r GetAllProjectsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAllProjectsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewGetRoleTemplatesRequest generates requests for GetRoleTemplates
+func NewGetRoleTemplatesRequest(server string, params *GetRoleTemplatesParams) (*http.Request, error) {
+	var err error
 
-type GetProjectsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ItemList
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON500      *ErrorContent
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetProjectsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/cluster/roletemplates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetProjectsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteProjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON404      *ErrorContent
-	JSON408      *ErrorContent
-	JSON409      *ErrorContent
-	JSON500      *ErrorContent
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r DeleteProjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Offset != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteProjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type GetProjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ProjectModel
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON404      *ErrorContent
-	JSON500      *ErrorContent
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r GetProjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Cursor != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetProjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type PatchProjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ProjectModel
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON404      *ErrorContent
-	JSON409      *ErrorContent
-	JSON415      *ErrorContent
-	JSON500      *ErrorContent
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r PatchProjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Limit != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchProjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Expand != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return 0
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-type CreateProjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ProjectModel
-	JSON201      *ProjectModel
-	JSON400      *ErrorContent
-	JSON401      *ErrorContent
-	JSON403      *ErrorContent
-	JSON404      *ErrorContent
-	JSON409      *ErrorContent
-	JSON415      *ErrorContent
-	JSON500      *ErrorContent
+// NewDeleteRoleTemplateRequest generates requests for DeleteRoleTemplate
+func NewDeleteRoleTemplateRequest(server string, name string, params *DeleteRoleTemplateParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cluster/roletemplates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.TimeoutSeconds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeoutSeconds", runtime.ParamLocationQuery, *params.TimeoutSeconds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateProjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetRoleTemplateRequest generates requests for GetRoleTemplate
+func NewGetRoleTemplateRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cluster/roletemplates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateProjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPatchRoleTemplateRequestWithApplicationJSONPatchPlusJSONBody calls the generic PatchRoleTemplate builder with application/json-patch+json body
+func NewPatchRoleTemplateRequestWithApplicationJSONPatchPlusJSONBody(server string, name string, params *PatchRoleTemplateParams, body PatchRoleTemplateApplicationJSONPatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchRoleTemplateRequestWithBody(server, name, params, "application/json-patch+json", bodyReader)
 }
 
-// GetAllBackupPoliciesWithResponse request returning *GetAllBackupPoliciesResponse
-func (c *ClientWithResponses) GetAllBackupPoliciesWithResponse(ctx context.Context, params *GetAllBackupPoliciesParams, reqEditors ...RequestEditorFn) (*GetAllBackupPoliciesResponse, error) {
-	rsp, err := c.GetAllBackupPolicies(ctx, params, reqEditors...)
+// NewPatchRoleTemplateRequestWithBody generates requests for PatchRoleTemplate with any type of body
+func NewPatchRoleTemplateRequestWithBody(server string, name string, params *PatchRoleTemplateParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAllBackupPoliciesResponse(rsp)
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cluster/roletemplates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UpdateStatus != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "updateStatus", runtime.ParamLocationQuery, *params.UpdateStatus); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// GetBackupPoliciesWithResponse request returning *GetBackupPoliciesResponse
-func (c *ClientWithResponses) GetBackupPoliciesWithResponse(ctx context.Context, organization string, params *GetBackupPoliciesParams, reqEditors ...RequestEditorFn) (*GetBackupPoliciesResponse, error) {
-	rsp, err := c.GetBackupPolicies(ctx, organization, params, reqEditors...)
+// NewCreateRoleTemplateRequest calls the generic CreateRoleTemplate builder with application/json body
+func NewCreateRoleTemplateRequest(server string, name string, params *CreateRoleTemplateParams, body CreateRoleTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetBackupPoliciesResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRoleTemplateRequestWithBody(server, name, params, "application/json", bodyReader)
+}
+
+// NewCreateRoleTemplateRequestWithBody generates requests for CreateRoleTemplate with any type of body
+func NewCreateRoleTemplateRequestWithBody(server string, name string, params *CreateRoleTemplateParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cluster/roletemplates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UpdateStatus != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "updateStatus", runtime.ParamLocationQuery, *params.UpdateStatus); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// DeleteBackupPolicyWithResponse request returning *DeleteBackupPolicyResponse
-func (c *ClientWithResponses) DeleteBackupPolicyWithResponse(ctx context.Context, organization string, policy string, params *DeleteBackupPolicyParams, reqEditors ...RequestEditorFn) (*DeleteBackupPolicyResponse, error) {
-	rsp, err := c.DeleteBackupPolicy(ctx, organization, policy, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteBackupPolicyResponse(rsp)
-}
+// NewGetServiceTiersRequest generates requests for GetServiceTiers
+func NewGetServiceTiersRequest(server string, params *GetServiceTiersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cluster/servicetiers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Expand != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteServiceTierRequest generates requests for DeleteServiceTier
+func NewDeleteServiceTierRequest(server string, name string, params *DeleteServiceTierParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cluster/servicetiers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.TimeoutSeconds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeoutSeconds", runtime.ParamLocationQuery, *params.TimeoutSeconds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetServiceTierRequest generates requests for GetServiceTier
+func NewGetServiceTierRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cluster/servicetiers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchServiceTierRequestWithApplicationJSONPatchPlusJSONBody calls the generic PatchServiceTier builder with application/json-patch+json body
+func NewPatchServiceTierRequestWithApplicationJSONPatchPlusJSONBody(server string, name string, params *PatchServiceTierParams, body PatchServiceTierApplicationJSONPatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchServiceTierRequestWithBody(server, name, params, "application/json-patch+json", bodyReader)
+}
+
+// NewPatchServiceTierRequestWithBody generates requests for PatchServiceTier with any type of body
+func NewPatchServiceTierRequestWithBody(server string, name string, params *PatchServiceTierParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cluster/servicetiers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UpdateStatus != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "updateStatus", runtime.ParamLocationQuery, *params.UpdateStatus); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateServiceTierRequest calls the generic CreateServiceTier builder with application/json body
+func NewCreateServiceTierRequest(server string, name string, params *CreateServiceTierParams, body CreateServiceTierJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateServiceTierRequestWithBody(server, name, params, "application/json", bodyReader)
+}
+
+// NewCreateServiceTierRequestWithBody generates requests for CreateServiceTier with any type of body
+func NewCreateServiceTierRequestWithBody(server string, name string, params *CreateServiceTierParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cluster/servicetiers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UpdateStatus != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "updateStatus", runtime.ParamLocationQuery, *params.UpdateStatus); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAllDatabasesRequest generates requests for GetAllDatabases
+func NewGetAllDatabasesRequest(server string, params *GetAllDatabasesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/databases")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Expand != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.LabelFilter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelFilter", runtime.ParamLocationQuery, *params.LabelFilter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FieldFilter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fieldFilter", runtime.ParamLocationQuery, *params.FieldFilter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ListAccessible != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "listAccessible", runtime.ParamLocationQuery, *params.ListAccessible); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOrganizationDatabasesRequest generates requests for GetOrganizationDatabases
+func NewGetOrganizationDatabasesRequest(server string, organization string, params *GetOrganizationDatabasesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/databases/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Expand != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.LabelFilter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelFilter", runtime.ParamLocationQuery, *params.LabelFilter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FieldFilter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fieldFilter", runtime.ParamLocationQuery, *params.FieldFilter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ListAccessible != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "listAccessible", runtime.ParamLocationQuery, *params.ListAccessible); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDatabasesRequest generates requests for GetDatabases
+func NewGetDatabasesRequest(server string, organization string, project string, params *GetDatabasesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/databases/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Expand != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.LabelFilter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelFilter", runtime.ParamLocationQuery, *params.LabelFilter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FieldFilter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fieldFilter", runtime.ParamLocationQuery, *params.FieldFilter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ListAccessible != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "listAccessible", runtime.ParamLocationQuery, *params.ListAccessible); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteDatabaseRequest generates requests for DeleteDatabase
+func NewDeleteDatabaseRequest(server string, organization string, project string, database string, params *DeleteDatabaseParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "database", runtime.ParamLocationPath, database)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/databases/%s/%s/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.TimeoutSeconds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeoutSeconds", runtime.ParamLocationQuery, *params.TimeoutSeconds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDatabaseRequest generates requests for GetDatabase
+func NewGetDatabaseRequest(server string, organization string, project string, database string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "database", runtime.ParamLocationPath, database)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/databases/%s/%s/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchDatabaseRequestWithApplicationJSONPatchPlusJSONBody calls the generic PatchDatabase builder with application/json-patch+json body
+func NewPatchDatabaseRequestWithApplicationJSONPatchPlusJSONBody(server string, organization string, project string, database string, body PatchDatabaseApplicationJSONPatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchDatabaseRequestWithBody(server, organization, project, database, "application/json-patch+json", bodyReader)
+}
+
+// NewPatchDatabaseRequestWithBody generates requests for PatchDatabase with any type of body
+func NewPatchDatabaseRequestWithBody(server string, organization string, project string, database string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "database", runtime.ParamLocationPath, database)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/databases/%s/%s/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateDatabaseRequest calls the generic CreateDatabase builder with application/json body
+func NewCreateDatabaseRequest(server string, organization string, project string, database string, body CreateDatabaseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDatabaseRequestWithBody(server, organization, project, database, "application/json", bodyReader)
+}
+
+// NewCreateDatabaseRequestWithBody generates requests for CreateDatabase with any type of body
+func NewCreateDatabaseRequestWithBody(server string, organization string, project string, database string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "database", runtime.ParamLocationPath, database)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/databases/%s/%s/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateDbaPasswordRequest calls the generic UpdateDbaPassword builder with application/json body
+func NewUpdateDbaPasswordRequest(server string, organization string, project string, database string, params *UpdateDbaPasswordParams, body UpdateDbaPasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDbaPasswordRequestWithBody(server, organization, project, database, params, "application/json", bodyReader)
+}
+
+// NewUpdateDbaPasswordRequestWithBody generates requests for UpdateDbaPassword with any type of body
+func NewUpdateDbaPasswordRequestWithBody(server string, organization string, project string, database string, params *UpdateDbaPasswordParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "database", runtime.ParamLocationPath, database)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/databases/%s/%s/%s/dbaPassword", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.TimeoutSeconds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeoutSeconds", runtime.ParamLocationQuery, *params.TimeoutSeconds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLoginRequest calls the generic Login builder with application/json body
+func NewLoginRequest(server string, body LoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLoginRequestWithBody generates requests for Login with any type of body
+func NewLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAllProjectsRequest generates requests for GetAllProjects
+func NewGetAllProjectsRequest(server string, params *GetAllProjectsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Expand != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.LabelFilter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelFilter", runtime.ParamLocationQuery, *params.LabelFilter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FieldFilter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fieldFilter", runtime.ParamLocationQuery, *params.FieldFilter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ListAccessible != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "listAccessible", runtime.ParamLocationQuery, *params.ListAccessible); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProjectsRequest generates requests for GetProjects
+func NewGetProjectsRequest(server string, organization string, params *GetProjectsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Expand != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.LabelFilter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelFilter", runtime.ParamLocationQuery, *params.LabelFilter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FieldFilter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fieldFilter", runtime.ParamLocationQuery, *params.FieldFilter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ListAccessible != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "listAccessible", runtime.ParamLocationQuery, *params.ListAccessible); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteProjectRequest generates requests for DeleteProject
+func NewDeleteProjectRequest(server string, organization string, project string, params *DeleteProjectParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.TimeoutSeconds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeoutSeconds", runtime.ParamLocationQuery, *params.TimeoutSeconds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProjectRequest generates requests for GetProject
+func NewGetProjectRequest(server string, organization string, project string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchProjectRequestWithApplicationJSONPatchPlusJSONBody calls the generic PatchProject builder with application/json-patch+json body
+func NewPatchProjectRequestWithApplicationJSONPatchPlusJSONBody(server string, organization string, project string, body PatchProjectApplicationJSONPatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchProjectRequestWithBody(server, organization, project, "application/json-patch+json", bodyReader)
+}
+
+// NewPatchProjectRequestWithBody generates requests for PatchProject with any type of body
+func NewPatchProjectRequestWithBody(server string, organization string, project string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateProjectRequest calls the generic CreateProject builder with application/json body
+func NewCreateProjectRequest(server string, organization string, project string, body CreateProjectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateProjectRequestWithBody(server, organization, project, "application/json", bodyReader)
+}
+
+// NewCreateProjectRequestWithBody generates requests for CreateProject with any type of body
+func NewCreateProjectRequestWithBody(server string, organization string, project string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAllUsersRequest generates requests for GetAllUsers
+func NewGetAllUsersRequest(server string, params *GetAllUsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Expand != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.LabelFilter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelFilter", runtime.ParamLocationQuery, *params.LabelFilter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FieldFilter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fieldFilter", runtime.ParamLocationQuery, *params.FieldFilter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ListAccessible != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "listAccessible", runtime.ParamLocationQuery, *params.ListAccessible); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUsersRequest generates requests for GetUsers
+func NewGetUsersRequest(server string, organization string, params *GetUsersParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Expand != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.LabelFilter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelFilter", runtime.ParamLocationQuery, *params.LabelFilter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FieldFilter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fieldFilter", runtime.ParamLocationQuery, *params.FieldFilter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ListAccessible != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "listAccessible", runtime.ParamLocationQuery, *params.ListAccessible); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteUserRequest generates requests for DeleteUser
+func NewDeleteUserRequest(server string, organization string, user string, params *DeleteUserParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "user", runtime.ParamLocationPath, user)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.TimeoutSeconds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeoutSeconds", runtime.ParamLocationQuery, *params.TimeoutSeconds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUserRequest generates requests for GetUser
+func NewGetUserRequest(server string, organization string, user string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "user", runtime.ParamLocationPath, user)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchUserRequestWithApplicationJSONPatchPlusJSONBody calls the generic PatchUser builder with application/json-patch+json body
+func NewPatchUserRequestWithApplicationJSONPatchPlusJSONBody(server string, organization string, user string, params *PatchUserParams, body PatchUserApplicationJSONPatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchUserRequestWithBody(server, organization, user, params, "application/json-patch+json", bodyReader)
+}
+
+// NewPatchUserRequestWithBody generates requests for PatchUser with any type of body
+func NewPatchUserRequestWithBody(server string, organization string, user string, params *PatchUserParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "user", runtime.ParamLocationPath, user)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.AllowCrossOrganizationAccess != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "allowCrossOrganizationAccess", runtime.ParamLocationQuery, *params.AllowCrossOrganizationAccess); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateUserRequest calls the generic CreateUser builder with application/json body
+func NewCreateUserRequest(server string, organization string, user string, params *CreateUserParams, body CreateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateUserRequestWithBody(server, organization, user, params, "application/json", bodyReader)
+}
+
+// NewCreateUserRequestWithBody generates requests for CreateUser with any type of body
+func NewCreateUserRequestWithBody(server string, organization string, user string, params *CreateUserParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "user", runtime.ParamLocationPath, user)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.AllowCrossOrganizationAccess != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "allowCrossOrganizationAccess", runtime.ParamLocationQuery, *params.AllowCrossOrganizationAccess); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAllBackupPoliciesWithResponse request
+	GetAllBackupPoliciesWithResponse(ctx context.Context, params *GetAllBackupPoliciesParams, reqEditors ...RequestEditorFn) (*GetAllBackupPoliciesResponse, error)
+
+	// GetBackupPoliciesWithResponse request
+	GetBackupPoliciesWithResponse(ctx context.Context, organization string, params *GetBackupPoliciesParams, reqEditors ...RequestEditorFn) (*GetBackupPoliciesResponse, error)
+
+	// DeleteBackupPolicyWithResponse request
+	DeleteBackupPolicyWithResponse(ctx context.Context, organization string, policy string, params *DeleteBackupPolicyParams, reqEditors ...RequestEditorFn) (*DeleteBackupPolicyResponse, error)
+
+	// GetBackupPolicyWithResponse request
+	GetBackupPolicyWithResponse(ctx context.Context, organization string, policy string, reqEditors ...RequestEditorFn) (*GetBackupPolicyResponse, error)
+
+	// PatchBackupPolicyWithBodyWithResponse request with any body
+	PatchBackupPolicyWithBodyWithResponse(ctx context.Context, organization string, policy string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchBackupPolicyResponse, error)
+
+	PatchBackupPolicyWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, organization string, policy string, body PatchBackupPolicyApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchBackupPolicyResponse, error)
+
+	// CreateBackupPolicyWithBodyWithResponse request with any body
+	CreateBackupPolicyWithBodyWithResponse(ctx context.Context, organization string, policy string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBackupPolicyResponse, error)
+
+	CreateBackupPolicyWithResponse(ctx context.Context, organization string, policy string, body CreateBackupPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBackupPolicyResponse, error)
+
+	// GetBackupsFromPolicyWithResponse request
+	GetBackupsFromPolicyWithResponse(ctx context.Context, organization string, policy string, params *GetBackupsFromPolicyParams, reqEditors ...RequestEditorFn) (*GetBackupsFromPolicyResponse, error)
+
+	// GetMatchingDatabasesWithResponse request
+	GetMatchingDatabasesWithResponse(ctx context.Context, organization string, policy string, params *GetMatchingDatabasesParams, reqEditors ...RequestEditorFn) (*GetMatchingDatabasesResponse, error)
+
+	// GetAllBackupsWithResponse request
+	GetAllBackupsWithResponse(ctx context.Context, params *GetAllBackupsParams, reqEditors ...RequestEditorFn) (*GetAllBackupsResponse, error)
+
+	// GetOrganizationBackupsWithResponse request
+	GetOrganizationBackupsWithResponse(ctx context.Context, organization string, params *GetOrganizationBackupsParams, reqEditors ...RequestEditorFn) (*GetOrganizationBackupsResponse, error)
+
+	// GetProjectBackupsWithResponse request
+	GetProjectBackupsWithResponse(ctx context.Context, organization string, project string, params *GetProjectBackupsParams, reqEditors ...RequestEditorFn) (*GetProjectBackupsResponse, error)
+
+	// GetBackupsWithResponse request
+	GetBackupsWithResponse(ctx context.Context, organization string, project string, database string, params *GetBackupsParams, reqEditors ...RequestEditorFn) (*GetBackupsResponse, error)
+
+	// CreateBackupWithBodyWithResponse request with any body
+	CreateBackupWithBodyWithResponse(ctx context.Context, organization string, project string, database string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBackupResponse, error)
+
+	CreateBackupWithResponse(ctx context.Context, organization string, project string, database string, body CreateBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBackupResponse, error)
+
+	// DeleteBackupWithResponse request
+	DeleteBackupWithResponse(ctx context.Context, organization string, project string, database string, backup string, params *DeleteBackupParams, reqEditors ...RequestEditorFn) (*DeleteBackupResponse, error)
+
+	// GetBackupWithResponse request
+	GetBackupWithResponse(ctx context.Context, organization string, project string, database string, backup string, reqEditors ...RequestEditorFn) (*GetBackupResponse, error)
+
+	// PatchBackupWithBodyWithResponse request with any body
+	PatchBackupWithBodyWithResponse(ctx context.Context, organization string, project string, database string, backup string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchBackupResponse, error)
+
+	PatchBackupWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, organization string, project string, database string, backup string, body PatchBackupApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchBackupResponse, error)
+
+	// CreateOrUpdateBackupWithBodyWithResponse request with any body
+	CreateOrUpdateBackupWithBodyWithResponse(ctx context.Context, organization string, project string, database string, backup string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrUpdateBackupResponse, error)
+
+	CreateOrUpdateBackupWithResponse(ctx context.Context, organization string, project string, database string, backup string, body CreateOrUpdateBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrUpdateBackupResponse, error)
+
+	// GetCanaryRolloutsWithResponse request
+	GetCanaryRolloutsWithResponse(ctx context.Context, params *GetCanaryRolloutsParams, reqEditors ...RequestEditorFn) (*GetCanaryRolloutsResponse, error)
+
+	// DeleteCanaryRolloutWithResponse request
+	DeleteCanaryRolloutWithResponse(ctx context.Context, name string, params *DeleteCanaryRolloutParams, reqEditors ...RequestEditorFn) (*DeleteCanaryRolloutResponse, error)
+
+	// GetCanaryRolloutWithResponse request
+	GetCanaryRolloutWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetCanaryRolloutResponse, error)
+
+	// PatchCanaryRolloutWithBodyWithResponse request with any body
+	PatchCanaryRolloutWithBodyWithResponse(ctx context.Context, name string, params *PatchCanaryRolloutParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchCanaryRolloutResponse, error)
+
+	PatchCanaryRolloutWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, name string, params *PatchCanaryRolloutParams, body PatchCanaryRolloutApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchCanaryRolloutResponse, error)
+
+	// CreateCanaryRolloutWithBodyWithResponse request with any body
+	CreateCanaryRolloutWithBodyWithResponse(ctx context.Context, name string, params *CreateCanaryRolloutParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCanaryRolloutResponse, error)
+
+	CreateCanaryRolloutWithResponse(ctx context.Context, name string, params *CreateCanaryRolloutParams, body CreateCanaryRolloutJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCanaryRolloutResponse, error)
+
+	// GetCanaryRolloutTemplatesWithResponse request
+	GetCanaryRolloutTemplatesWithResponse(ctx context.Context, params *GetCanaryRolloutTemplatesParams, reqEditors ...RequestEditorFn) (*GetCanaryRolloutTemplatesResponse, error)
+
+	// DeleteCanaryRolloutTemplateWithResponse request
+	DeleteCanaryRolloutTemplateWithResponse(ctx context.Context, name string, params *DeleteCanaryRolloutTemplateParams, reqEditors ...RequestEditorFn) (*DeleteCanaryRolloutTemplateResponse, error)
+
+	// GetCanaryRolloutTemplateWithResponse request
+	GetCanaryRolloutTemplateWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetCanaryRolloutTemplateResponse, error)
+
+	// PatchCanaryRolloutTemplateWithBodyWithResponse request with any body
+	PatchCanaryRolloutTemplateWithBodyWithResponse(ctx context.Context, name string, params *PatchCanaryRolloutTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchCanaryRolloutTemplateResponse, error)
+
+	PatchCanaryRolloutTemplateWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, name string, params *PatchCanaryRolloutTemplateParams, body PatchCanaryRolloutTemplateApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchCanaryRolloutTemplateResponse, error)
+
+	// CreateCanaryRolloutTemplateWithBodyWithResponse request with any body
+	CreateCanaryRolloutTemplateWithBodyWithResponse(ctx context.Context, name string, params *CreateCanaryRolloutTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCanaryRolloutTemplateResponse, error)
+
+	CreateCanaryRolloutTemplateWithResponse(ctx context.Context, name string, params *CreateCanaryRolloutTemplateParams, body CreateCanaryRolloutTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCanaryRolloutTemplateResponse, error)
+
+	// GetDatabaseQuotasWithResponse request
+	GetDatabaseQuotasWithResponse(ctx context.Context, params *GetDatabaseQuotasParams, reqEditors ...RequestEditorFn) (*GetDatabaseQuotasResponse, error)
+
+	// DeleteDatabaseQuotaWithResponse request
+	DeleteDatabaseQuotaWithResponse(ctx context.Context, name string, params *DeleteDatabaseQuotaParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseQuotaResponse, error)
+
+	// GetDatabaseQuotaWithResponse request
+	GetDatabaseQuotaWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetDatabaseQuotaResponse, error)
+
+	// PatchDatabaseQuotaWithBodyWithResponse request with any body
+	PatchDatabaseQuotaWithBodyWithResponse(ctx context.Context, name string, params *PatchDatabaseQuotaParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchDatabaseQuotaResponse, error)
+
+	PatchDatabaseQuotaWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, name string, params *PatchDatabaseQuotaParams, body PatchDatabaseQuotaApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchDatabaseQuotaResponse, error)
+
+	// CreateDatabaseQuotaWithBodyWithResponse request with any body
+	CreateDatabaseQuotaWithBodyWithResponse(ctx context.Context, name string, params *CreateDatabaseQuotaParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseQuotaResponse, error)
+
+	CreateDatabaseQuotaWithResponse(ctx context.Context, name string, params *CreateDatabaseQuotaParams, body CreateDatabaseQuotaJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseQuotaResponse, error)
+
+	// GetHelmFeaturesWithResponse request
+	GetHelmFeaturesWithResponse(ctx context.Context, params *GetHelmFeaturesParams, reqEditors ...RequestEditorFn) (*GetHelmFeaturesResponse, error)
+
+	// DeleteHelmFeatureWithResponse request
+	DeleteHelmFeatureWithResponse(ctx context.Context, name string, params *DeleteHelmFeatureParams, reqEditors ...RequestEditorFn) (*DeleteHelmFeatureResponse, error)
+
+	// GetHelmFeatureWithResponse request
+	GetHelmFeatureWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetHelmFeatureResponse, error)
+
+	// PatchHelmFeatureWithBodyWithResponse request with any body
+	PatchHelmFeatureWithBodyWithResponse(ctx context.Context, name string, params *PatchHelmFeatureParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchHelmFeatureResponse, error)
+
+	PatchHelmFeatureWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, name string, params *PatchHelmFeatureParams, body PatchHelmFeatureApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchHelmFeatureResponse, error)
+
+	// CreateHelmFeatureWithBodyWithResponse request with any body
+	CreateHelmFeatureWithBodyWithResponse(ctx context.Context, name string, params *CreateHelmFeatureParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateHelmFeatureResponse, error)
+
+	CreateHelmFeatureWithResponse(ctx context.Context, name string, params *CreateHelmFeatureParams, body CreateHelmFeatureJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateHelmFeatureResponse, error)
+
+	// GetRoleTemplatesWithResponse request
+	GetRoleTemplatesWithResponse(ctx context.Context, params *GetRoleTemplatesParams, reqEditors ...RequestEditorFn) (*GetRoleTemplatesResponse, error)
+
+	// DeleteRoleTemplateWithResponse request
+	DeleteRoleTemplateWithResponse(ctx context.Context, name string, params *DeleteRoleTemplateParams, reqEditors ...RequestEditorFn) (*DeleteRoleTemplateResponse, error)
+
+	// GetRoleTemplateWithResponse request
+	GetRoleTemplateWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetRoleTemplateResponse, error)
+
+	// PatchRoleTemplateWithBodyWithResponse request with any body
+	PatchRoleTemplateWithBodyWithResponse(ctx context.Context, name string, params *PatchRoleTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchRoleTemplateResponse, error)
+
+	PatchRoleTemplateWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, name string, params *PatchRoleTemplateParams, body PatchRoleTemplateApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchRoleTemplateResponse, error)
+
+	// CreateRoleTemplateWithBodyWithResponse request with any body
+	CreateRoleTemplateWithBodyWithResponse(ctx context.Context, name string, params *CreateRoleTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRoleTemplateResponse, error)
+
+	CreateRoleTemplateWithResponse(ctx context.Context, name string, params *CreateRoleTemplateParams, body CreateRoleTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRoleTemplateResponse, error)
+
+	// GetServiceTiersWithResponse request
+	GetServiceTiersWithResponse(ctx context.Context, params *GetServiceTiersParams, reqEditors ...RequestEditorFn) (*GetServiceTiersResponse, error)
+
+	// DeleteServiceTierWithResponse request
+	DeleteServiceTierWithResponse(ctx context.Context, name string, params *DeleteServiceTierParams, reqEditors ...RequestEditorFn) (*DeleteServiceTierResponse, error)
+
+	// GetServiceTierWithResponse request
+	GetServiceTierWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetServiceTierResponse, error)
+
+	// PatchServiceTierWithBodyWithResponse request with any body
+	PatchServiceTierWithBodyWithResponse(ctx context.Context, name string, params *PatchServiceTierParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchServiceTierResponse, error)
+
+	PatchServiceTierWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, name string, params *PatchServiceTierParams, body PatchServiceTierApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchServiceTierResponse, error)
+
+	// CreateServiceTierWithBodyWithResponse request with any body
+	CreateServiceTierWithBodyWithResponse(ctx context.Context, name string, params *CreateServiceTierParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateServiceTierResponse, error)
+
+	CreateServiceTierWithResponse(ctx context.Context, name string, params *CreateServiceTierParams, body CreateServiceTierJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateServiceTierResponse, error)
+
+	// GetAllDatabasesWithResponse request
+	GetAllDatabasesWithResponse(ctx context.Context, params *GetAllDatabasesParams, reqEditors ...RequestEditorFn) (*GetAllDatabasesResponse, error)
+
+	// GetOrganizationDatabasesWithResponse request
+	GetOrganizationDatabasesWithResponse(ctx context.Context, organization string, params *GetOrganizationDatabasesParams, reqEditors ...RequestEditorFn) (*GetOrganizationDatabasesResponse, error)
+
+	// GetDatabasesWithResponse request
+	GetDatabasesWithResponse(ctx context.Context, organization string, project string, params *GetDatabasesParams, reqEditors ...RequestEditorFn) (*GetDatabasesResponse, error)
+
+	// DeleteDatabaseWithResponse request
+	DeleteDatabaseWithResponse(ctx context.Context, organization string, project string, database string, params *DeleteDatabaseParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseResponse, error)
+
+	// GetDatabaseWithResponse request
+	GetDatabaseWithResponse(ctx context.Context, organization string, project string, database string, reqEditors ...RequestEditorFn) (*GetDatabaseResponse, error)
+
+	// PatchDatabaseWithBodyWithResponse request with any body
+	PatchDatabaseWithBodyWithResponse(ctx context.Context, organization string, project string, database string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchDatabaseResponse, error)
+
+	PatchDatabaseWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, organization string, project string, database string, body PatchDatabaseApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchDatabaseResponse, error)
+
+	// CreateDatabaseWithBodyWithResponse request with any body
+	CreateDatabaseWithBodyWithResponse(ctx context.Context, organization string, project string, database string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error)
+
+	CreateDatabaseWithResponse(ctx context.Context, organization string, project string, database string, body CreateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error)
+
+	// UpdateDbaPasswordWithBodyWithResponse request with any body
+	UpdateDbaPasswordWithBodyWithResponse(ctx context.Context, organization string, project string, database string, params *UpdateDbaPasswordParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDbaPasswordResponse, error)
+
+	UpdateDbaPasswordWithResponse(ctx context.Context, organization string, project string, database string, params *UpdateDbaPasswordParams, body UpdateDbaPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDbaPasswordResponse, error)
+
+	// LoginWithBodyWithResponse request with any body
+	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+
+	LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+
+	// GetAllProjectsWithResponse request
+	GetAllProjectsWithResponse(ctx context.Context, params *GetAllProjectsParams, reqEditors ...RequestEditorFn) (*GetAllProjectsResponse, error)
+
+	// GetProjectsWithResponse request
+	GetProjectsWithResponse(ctx context.Context, organization string, params *GetProjectsParams, reqEditors ...RequestEditorFn) (*GetProjectsResponse, error)
+
+	// DeleteProjectWithResponse request
+	DeleteProjectWithResponse(ctx context.Context, organization string, project string, params *DeleteProjectParams, reqEditors ...RequestEditorFn) (*DeleteProjectResponse, error)
+
+	// GetProjectWithResponse request
+	GetProjectWithResponse(ctx context.Context, organization string, project string, reqEditors ...RequestEditorFn) (*GetProjectResponse, error)
+
+	// PatchProjectWithBodyWithResponse request with any body
+	PatchProjectWithBodyWithResponse(ctx context.Context, organization string, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchProjectResponse, error)
+
+	PatchProjectWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, organization string, project string, body PatchProjectApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchProjectResponse, error)
+
+	// CreateProjectWithBodyWithResponse request with any body
+	CreateProjectWithBodyWithResponse(ctx context.Context, organization string, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error)
+
+	CreateProjectWithResponse(ctx context.Context, organization string, project string, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error)
+
+	// GetAllUsersWithResponse request
+	GetAllUsersWithResponse(ctx context.Context, params *GetAllUsersParams, reqEditors ...RequestEditorFn) (*GetAllUsersResponse, error)
+
+	// GetUsersWithResponse request
+	GetUsersWithResponse(ctx context.Context, organization string, params *GetUsersParams, reqEditors ...RequestEditorFn) (*GetUsersResponse, error)
+
+	// DeleteUserWithResponse request
+	DeleteUserWithResponse(ctx context.Context, organization string, user string, params *DeleteUserParams, reqEditors ...RequestEditorFn) (*DeleteUserResponse, error)
+
+	// GetUserWithResponse request
+	GetUserWithResponse(ctx context.Context, organization string, user string, reqEditors ...RequestEditorFn) (*GetUserResponse, error)
+
+	// PatchUserWithBodyWithResponse request with any body
+	PatchUserWithBodyWithResponse(ctx context.Context, organization string, user string, params *PatchUserParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchUserResponse, error)
+
+	PatchUserWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, organization string, user string, params *PatchUserParams, body PatchUserApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchUserResponse, error)
+
+	// CreateUserWithBodyWithResponse request with any body
+	CreateUserWithBodyWithResponse(ctx context.Context, organization string, user string, params *CreateUserParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+
+	CreateUserWithResponse(ctx context.Context, organization string, user string, params *CreateUserParams, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+}
+
+type GetAllBackupPoliciesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemList
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAllBackupPoliciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllBackupPoliciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBackupPoliciesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemList
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBackupPoliciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBackupPoliciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBackupPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON408      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBackupPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBackupPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBackupPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupPolicyModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBackupPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBackupPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchBackupPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupPolicyModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchBackupPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchBackupPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateBackupPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupPolicyModel
+	JSON201      *BackupPolicyModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateBackupPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBackupPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBackupsFromPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemList
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBackupsFromPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBackupsFromPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMatchingDatabasesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemList
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMatchingDatabasesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMatchingDatabasesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAllBackupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemList
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAllBackupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllBackupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrganizationBackupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemList
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizationBackupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizationBackupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectBackupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemList
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectBackupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectBackupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBackupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemList
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBackupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBackupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateBackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *BackupModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateBackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON408      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchBackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchBackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchBackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateOrUpdateBackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupModel
+	JSON201      *BackupModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOrUpdateBackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrUpdateBackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCanaryRolloutsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemList
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCanaryRolloutsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCanaryRolloutsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCanaryRolloutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON408      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCanaryRolloutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCanaryRolloutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCanaryRolloutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CanaryRolloutModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCanaryRolloutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCanaryRolloutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchCanaryRolloutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CanaryRolloutModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchCanaryRolloutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchCanaryRolloutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCanaryRolloutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CanaryRolloutModel
+	JSON201      *CanaryRolloutModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCanaryRolloutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCanaryRolloutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCanaryRolloutTemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemList
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCanaryRolloutTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCanaryRolloutTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCanaryRolloutTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON408      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCanaryRolloutTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCanaryRolloutTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCanaryRolloutTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CanaryRolloutTemplateModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCanaryRolloutTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCanaryRolloutTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchCanaryRolloutTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CanaryRolloutTemplateModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchCanaryRolloutTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchCanaryRolloutTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCanaryRolloutTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CanaryRolloutTemplateModel
+	JSON201      *CanaryRolloutTemplateModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCanaryRolloutTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCanaryRolloutTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseQuotasResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemList
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseQuotasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseQuotasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDatabaseQuotaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON408      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDatabaseQuotaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDatabaseQuotaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseQuotaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseQuotaModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseQuotaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseQuotaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchDatabaseQuotaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseQuotaModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchDatabaseQuotaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchDatabaseQuotaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDatabaseQuotaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseQuotaModel
+	JSON201      *DatabaseQuotaModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDatabaseQuotaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDatabaseQuotaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHelmFeaturesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemList
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHelmFeaturesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHelmFeaturesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteHelmFeatureResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON408      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteHelmFeatureResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteHelmFeatureResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHelmFeatureResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HelmFeatureModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHelmFeatureResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHelmFeatureResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchHelmFeatureResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HelmFeatureModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchHelmFeatureResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchHelmFeatureResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateHelmFeatureResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HelmFeatureModel
+	JSON201      *HelmFeatureModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateHelmFeatureResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateHelmFeatureResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRoleTemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemList
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRoleTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRoleTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteRoleTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON408      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteRoleTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteRoleTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRoleTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RoleTemplateModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRoleTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRoleTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchRoleTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RoleTemplateModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchRoleTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchRoleTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateRoleTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RoleTemplateModel
+	JSON201      *RoleTemplateModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateRoleTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateRoleTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetServiceTiersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemList
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetServiceTiersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetServiceTiersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteServiceTierResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON408      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteServiceTierResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteServiceTierResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetServiceTierResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ServiceTierModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetServiceTierResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetServiceTierResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchServiceTierResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ServiceTierModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchServiceTierResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchServiceTierResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateServiceTierResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ServiceTierModel
+	JSON201      *ServiceTierModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateServiceTierResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateServiceTierResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAllDatabasesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemList
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAllDatabasesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllDatabasesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrganizationDatabasesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemList
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizationDatabasesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizationDatabasesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabasesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemList
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabasesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabasesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON408      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON422      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseModel
+	JSON201      *DatabaseModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON422      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDbaPasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON408      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDbaPasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDbaPasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginResponseModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAllProjectsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemList
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAllProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemList
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON408      *ErrorContent
+	JSON409      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectModel
+	JSON201      *ProjectModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAllUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemList
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAllUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemList
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON408      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DbaasUserModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DbaasUserModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DbaasUserModel
+	JSON201      *DbaasUserModel
+	JSON400      *ErrorContent
+	JSON401      *ErrorContent
+	JSON403      *ErrorContent
+	JSON404      *ErrorContent
+	JSON409      *ErrorContent
+	JSON415      *ErrorContent
+	JSON500      *ErrorContent
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetAllBackupPoliciesWithResponse request returning *GetAllBackupPoliciesResponse
+func (c *ClientWithResponses) GetAllBackupPoliciesWithResponse(ctx context.Context, params *GetAllBackupPoliciesParams, reqEditors ...RequestEditorFn) (*GetAllBackupPoliciesResponse, error) {
+	rsp, err := c.GetAllBackupPolicies(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAllBackupPoliciesResponse(rsp)
+}
+
+// GetBackupPoliciesWithResponse request returning *GetBackupPoliciesResponse
+func (c *ClientWithResponses) GetBackupPoliciesWithResponse(ctx context.Context, organization string, params *GetBackupPoliciesParams, reqEditors ...RequestEditorFn) (*GetBackupPoliciesResponse, error) {
+	rsp, err := c.GetBackupPolicies(ctx, organization, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBackupPoliciesResponse(rsp)
+}
+
+// DeleteBackupPolicyWithResponse request returning *DeleteBackupPolicyResponse
+func (c *ClientWithResponses) DeleteBackupPolicyWithResponse(ctx context.Context, organization string, policy string, params *DeleteBackupPolicyParams, reqEditors ...RequestEditorFn) (*DeleteBackupPolicyResponse, error) {
+	rsp, err := c.DeleteBackupPolicy(ctx, organization, policy, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBackupPolicyResponse(rsp)
+}
+
+// GetBackupPolicyWithResponse request returning *GetBackupPolicyResponse
+func (c *ClientWithResponses) GetBackupPolicyWithResponse(ctx context.Context, organization string, policy string, reqEditors ...RequestEditorFn) (*GetBackupPolicyResponse, error) {
+	rsp, err := c.GetBackupPolicy(ctx, organization, policy, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBackupPolicyResponse(rsp)
+}
+
+// PatchBackupPolicyWithBodyWithResponse request with arbitrary body returning *PatchBackupPolicyResponse
+func (c *ClientWithResponses) PatchBackupPolicyWithBodyWithResponse(ctx context.Context, organization string, policy string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchBackupPolicyResponse, error) {
+	rsp, err := c.PatchBackupPolicyWithBody(ctx, organization, policy, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchBackupPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchBackupPolicyWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, organization string, policy string, body PatchBackupPolicyApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchBackupPolicyResponse, error) {
+	rsp, err := c.PatchBackupPolicyWithApplicationJSONPatchPlusJSONBody(ctx, organization, policy, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchBackupPolicyResponse(rsp)
+}
+
+// CreateBackupPolicyWithBodyWithResponse request with arbitrary body returning *CreateBackupPolicyResponse
+func (c *ClientWithResponses) CreateBackupPolicyWithBodyWithResponse(ctx context.Context, organization string, policy string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBackupPolicyResponse, error) {
+	rsp, err := c.CreateBackupPolicyWithBody(ctx, organization, policy, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBackupPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateBackupPolicyWithResponse(ctx context.Context, organization string, policy string, body CreateBackupPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBackupPolicyResponse, error) {
+	rsp, err := c.CreateBackupPolicy(ctx, organization, policy, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBackupPolicyResponse(rsp)
+}
+
+// GetBackupsFromPolicyWithResponse request returning *GetBackupsFromPolicyResponse
+func (c *ClientWithResponses) GetBackupsFromPolicyWithResponse(ctx context.Context, organization string, policy string, params *GetBackupsFromPolicyParams, reqEditors ...RequestEditorFn) (*GetBackupsFromPolicyResponse, error) {
+	rsp, err := c.GetBackupsFromPolicy(ctx, organization, policy, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBackupsFromPolicyResponse(rsp)
+}
+
+// GetMatchingDatabasesWithResponse request returning *GetMatchingDatabasesResponse
+func (c *ClientWithResponses) GetMatchingDatabasesWithResponse(ctx context.Context, organization string, policy string, params *GetMatchingDatabasesParams, reqEditors ...RequestEditorFn) (*GetMatchingDatabasesResponse, error) {
+	rsp, err := c.GetMatchingDatabases(ctx, organization, policy, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMatchingDatabasesResponse(rsp)
+}
+
+// GetAllBackupsWithResponse request returning *GetAllBackupsResponse
+func (c *ClientWithResponses) GetAllBackupsWithResponse(ctx context.Context, params *GetAllBackupsParams, reqEditors ...RequestEditorFn) (*GetAllBackupsResponse, error) {
+	rsp, err := c.GetAllBackups(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAllBackupsResponse(rsp)
+}
+
+// GetOrganizationBackupsWithResponse request returning *GetOrganizationBackupsResponse
+func (c *ClientWithResponses) GetOrganizationBackupsWithResponse(ctx context.Context, organization string, params *GetOrganizationBackupsParams, reqEditors ...RequestEditorFn) (*GetOrganizationBackupsResponse, error) {
+	rsp, err := c.GetOrganizationBackups(ctx, organization, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrganizationBackupsResponse(rsp)
+}
+
+// GetProjectBackupsWithResponse request returning *GetProjectBackupsResponse
+func (c *ClientWithResponses) GetProjectBackupsWithResponse(ctx context.Context, organization string, project string, params *GetProjectBackupsParams, reqEditors ...RequestEditorFn) (*GetProjectBackupsResponse, error) {
+	rsp, err := c.GetProjectBackups(ctx, organization, project, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectBackupsResponse(rsp)
+}
+
+// GetBackupsWithResponse request returning *GetBackupsResponse
+func (c *ClientWithResponses) GetBackupsWithResponse(ctx context.Context, organization string, project string, database string, params *GetBackupsParams, reqEditors ...RequestEditorFn) (*GetBackupsResponse, error) {
+	rsp, err := c.GetBackups(ctx, organization, project, database, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBackupsResponse(rsp)
+}
+
+// CreateBackupWithBodyWithResponse request with arbitrary body returning *CreateBackupResponse
+func (c *ClientWithResponses) CreateBackupWithBodyWithResponse(ctx context.Context, organization string, project string, database string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBackupResponse, error) {
+	rsp, err := c.CreateBackupWithBody(ctx, organization, project, database, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBackupResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateBackupWithResponse(ctx context.Context, organization string, project string, database string, body CreateBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBackupResponse, error) {
+	rsp, err := c.CreateBackup(ctx, organization, project, database, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBackupResponse(rsp)
+}
+
+// DeleteBackupWithResponse request returning *DeleteBackupResponse
+func (c *ClientWithResponses) DeleteBackupWithResponse(ctx context.Context, organization string, project string, database string, backup string, params *DeleteBackupParams, reqEditors ...RequestEditorFn) (*DeleteBackupResponse, error) {
+	rsp, err := c.DeleteBackup(ctx, organization, project, database, backup, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBackupResponse(rsp)
+}
+
+// GetBackupWithResponse request returning *GetBackupResponse
+func (c *ClientWithResponses) GetBackupWithResponse(ctx context.Context, organization string, project string, database string, backup string, reqEditors ...RequestEditorFn) (*GetBackupResponse, error) {
+	rsp, err := c.GetBackup(ctx, organization, project, database, backup, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBackupResponse(rsp)
+}
+
+// PatchBackupWithBodyWithResponse request with arbitrary body returning *PatchBackupResponse
+func (c *ClientWithResponses) PatchBackupWithBodyWithResponse(ctx context.Context, organization string, project string, database string, backup string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchBackupResponse, error) {
+	rsp, err := c.PatchBackupWithBody(ctx, organization, project, database, backup, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchBackupResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchBackupWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, organization string, project string, database string, backup string, body PatchBackupApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchBackupResponse, error) {
+	rsp, err := c.PatchBackupWithApplicationJSONPatchPlusJSONBody(ctx, organization, project, database, backup, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchBackupResponse(rsp)
+}
+
+// CreateOrUpdateBackupWithBodyWithResponse request with arbitrary body returning *CreateOrUpdateBackupResponse
+func (c *ClientWithResponses) CreateOrUpdateBackupWithBodyWithResponse(ctx context.Context, organization string, project string, database string, backup string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrUpdateBackupResponse, error) {
+	rsp, err := c.CreateOrUpdateBackupWithBody(ctx, organization, project, database, backup, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrUpdateBackupResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateOrUpdateBackupWithResponse(ctx context.Context, organization string, project string, database string, backup string, body CreateOrUpdateBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrUpdateBackupResponse, error) {
+	rsp, err := c.CreateOrUpdateBackup(ctx, organization, project, database, backup, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrUpdateBackupResponse(rsp)
+}
+
+// GetCanaryRolloutsWithResponse request returning *GetCanaryRolloutsResponse
+func (c *ClientWithResponses) GetCanaryRolloutsWithResponse(ctx context.Context, params *GetCanaryRolloutsParams, reqEditors ...RequestEditorFn) (*GetCanaryRolloutsResponse, error) {
+	rsp, err := c.GetCanaryRollouts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCanaryRolloutsResponse(rsp)
+}
+
+// DeleteCanaryRolloutWithResponse request returning *DeleteCanaryRolloutResponse
+func (c *ClientWithResponses) DeleteCanaryRolloutWithResponse(ctx context.Context, name string, params *DeleteCanaryRolloutParams, reqEditors ...RequestEditorFn) (*DeleteCanaryRolloutResponse, error) {
+	rsp, err := c.DeleteCanaryRollout(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCanaryRolloutResponse(rsp)
+}
+
+// GetCanaryRolloutWithResponse request returning *GetCanaryRolloutResponse
+func (c *ClientWithResponses) GetCanaryRolloutWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetCanaryRolloutResponse, error) {
+	rsp, err := c.GetCanaryRollout(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCanaryRolloutResponse(rsp)
+}
+
+// PatchCanaryRolloutWithBodyWithResponse request with arbitrary body returning *PatchCanaryRolloutResponse
+func (c *ClientWithResponses) PatchCanaryRolloutWithBodyWithResponse(ctx context.Context, name string, params *PatchCanaryRolloutParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchCanaryRolloutResponse, error) {
+	rsp, err := c.PatchCanaryRolloutWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchCanaryRolloutResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchCanaryRolloutWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, name string, params *PatchCanaryRolloutParams, body PatchCanaryRolloutApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchCanaryRolloutResponse, error) {
+	rsp, err := c.PatchCanaryRolloutWithApplicationJSONPatchPlusJSONBody(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchCanaryRolloutResponse(rsp)
+}
+
+// CreateCanaryRolloutWithBodyWithResponse request with arbitrary body returning *CreateCanaryRolloutResponse
+func (c *ClientWithResponses) CreateCanaryRolloutWithBodyWithResponse(ctx context.Context, name string, params *CreateCanaryRolloutParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCanaryRolloutResponse, error) {
+	rsp, err := c.CreateCanaryRolloutWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCanaryRolloutResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateCanaryRolloutWithResponse(ctx context.Context, name string, params *CreateCanaryRolloutParams, body CreateCanaryRolloutJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCanaryRolloutResponse, error) {
+	rsp, err := c.CreateCanaryRollout(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCanaryRolloutResponse(rsp)
+}
+
+// GetCanaryRolloutTemplatesWithResponse request returning *GetCanaryRolloutTemplatesResponse
+func (c *ClientWithResponses) GetCanaryRolloutTemplatesWithResponse(ctx context.Context, params *GetCanaryRolloutTemplatesParams, reqEditors ...RequestEditorFn) (*GetCanaryRolloutTemplatesResponse, error) {
+	rsp, err := c.GetCanaryRolloutTemplates(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCanaryRolloutTemplatesResponse(rsp)
+}
+
+// DeleteCanaryRolloutTemplateWithResponse request returning *DeleteCanaryRolloutTemplateResponse
+func (c *ClientWithResponses) DeleteCanaryRolloutTemplateWithResponse(ctx context.Context, name string, params *DeleteCanaryRolloutTemplateParams, reqEditors ...RequestEditorFn) (*DeleteCanaryRolloutTemplateResponse, error) {
+	rsp, err := c.DeleteCanaryRolloutTemplate(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCanaryRolloutTemplateResponse(rsp)
+}
+
+// GetCanaryRolloutTemplateWithResponse request returning *GetCanaryRolloutTemplateResponse
+func (c *ClientWithResponses) GetCanaryRolloutTemplateWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetCanaryRolloutTemplateResponse, error) {
+	rsp, err := c.GetCanaryRolloutTemplate(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCanaryRolloutTemplateResponse(rsp)
+}
+
+// PatchCanaryRolloutTemplateWithBodyWithResponse request with arbitrary body returning *PatchCanaryRolloutTemplateResponse
+func (c *ClientWithResponses) PatchCanaryRolloutTemplateWithBodyWithResponse(ctx context.Context, name string, params *PatchCanaryRolloutTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchCanaryRolloutTemplateResponse, error) {
+	rsp, err := c.PatchCanaryRolloutTemplateWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchCanaryRolloutTemplateResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchCanaryRolloutTemplateWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, name string, params *PatchCanaryRolloutTemplateParams, body PatchCanaryRolloutTemplateApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchCanaryRolloutTemplateResponse, error) {
+	rsp, err := c.PatchCanaryRolloutTemplateWithApplicationJSONPatchPlusJSONBody(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchCanaryRolloutTemplateResponse(rsp)
+}
+
+// CreateCanaryRolloutTemplateWithBodyWithResponse request with arbitrary body returning *CreateCanaryRolloutTemplateResponse
+func (c *ClientWithResponses) CreateCanaryRolloutTemplateWithBodyWithResponse(ctx context.Context, name string, params *CreateCanaryRolloutTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCanaryRolloutTemplateResponse, error) {
+	rsp, err := c.CreateCanaryRolloutTemplateWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCanaryRolloutTemplateResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateCanaryRolloutTemplateWithResponse(ctx context.Context, name string, params *CreateCanaryRolloutTemplateParams, body CreateCanaryRolloutTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCanaryRolloutTemplateResponse, error) {
+	rsp, err := c.CreateCanaryRolloutTemplate(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCanaryRolloutTemplateResponse(rsp)
+}
+
+// GetDatabaseQuotasWithResponse request returning *GetDatabaseQuotasResponse
+func (c *ClientWithResponses) GetDatabaseQuotasWithResponse(ctx context.Context, params *GetDatabaseQuotasParams, reqEditors ...RequestEditorFn) (*GetDatabaseQuotasResponse, error) {
+	rsp, err := c.GetDatabaseQuotas(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseQuotasResponse(rsp)
+}
+
+// DeleteDatabaseQuotaWithResponse request returning *DeleteDatabaseQuotaResponse
+func (c *ClientWithResponses) DeleteDatabaseQuotaWithResponse(ctx context.Context, name string, params *DeleteDatabaseQuotaParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseQuotaResponse, error) {
+	rsp, err := c.DeleteDatabaseQuota(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDatabaseQuotaResponse(rsp)
+}
+
+// GetDatabaseQuotaWithResponse request returning *GetDatabaseQuotaResponse
+func (c *ClientWithResponses) GetDatabaseQuotaWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetDatabaseQuotaResponse, error) {
+	rsp, err := c.GetDatabaseQuota(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseQuotaResponse(rsp)
+}
+
+// PatchDatabaseQuotaWithBodyWithResponse request with arbitrary body returning *PatchDatabaseQuotaResponse
+func (c *ClientWithResponses) PatchDatabaseQuotaWithBodyWithResponse(ctx context.Context, name string, params *PatchDatabaseQuotaParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchDatabaseQuotaResponse, error) {
+	rsp, err := c.PatchDatabaseQuotaWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchDatabaseQuotaResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchDatabaseQuotaWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, name string, params *PatchDatabaseQuotaParams, body PatchDatabaseQuotaApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchDatabaseQuotaResponse, error) {
+	rsp, err := c.PatchDatabaseQuotaWithApplicationJSONPatchPlusJSONBody(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchDatabaseQuotaResponse(rsp)
+}
+
+// CreateDatabaseQuotaWithBodyWithResponse request with arbitrary body returning *CreateDatabaseQuotaResponse
+func (c *ClientWithResponses) CreateDatabaseQuotaWithBodyWithResponse(ctx context.Context, name string, params *CreateDatabaseQuotaParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseQuotaResponse, error) {
+	rsp, err := c.CreateDatabaseQuotaWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseQuotaResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDatabaseQuotaWithResponse(ctx context.Context, name string, params *CreateDatabaseQuotaParams, body CreateDatabaseQuotaJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseQuotaResponse, error) {
+	rsp, err := c.CreateDatabaseQuota(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseQuotaResponse(rsp)
+}
+
+// GetHelmFeaturesWithResponse request returning *GetHelmFeaturesResponse
+func (c *ClientWithResponses) GetHelmFeaturesWithResponse(ctx context.Context, params *GetHelmFeaturesParams, reqEditors ...RequestEditorFn) (*GetHelmFeaturesResponse, error) {
+	rsp, err := c.GetHelmFeatures(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHelmFeaturesResponse(rsp)
+}
+
+// DeleteHelmFeatureWithResponse request returning *DeleteHelmFeatureResponse
+func (c *ClientWithResponses) DeleteHelmFeatureWithResponse(ctx context.Context, name string, params *DeleteHelmFeatureParams, reqEditors ...RequestEditorFn) (*DeleteHelmFeatureResponse, error) {
+	rsp, err := c.DeleteHelmFeature(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteHelmFeatureResponse(rsp)
+}
+
+// GetHelmFeatureWithResponse request returning *GetHelmFeatureResponse
+func (c *ClientWithResponses) GetHelmFeatureWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetHelmFeatureResponse, error) {
+	rsp, err := c.GetHelmFeature(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHelmFeatureResponse(rsp)
+}
+
+// PatchHelmFeatureWithBodyWithResponse request with arbitrary body returning *PatchHelmFeatureResponse
+func (c *ClientWithResponses) PatchHelmFeatureWithBodyWithResponse(ctx context.Context, name string, params *PatchHelmFeatureParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchHelmFeatureResponse, error) {
+	rsp, err := c.PatchHelmFeatureWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchHelmFeatureResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchHelmFeatureWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, name string, params *PatchHelmFeatureParams, body PatchHelmFeatureApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchHelmFeatureResponse, error) {
+	rsp, err := c.PatchHelmFeatureWithApplicationJSONPatchPlusJSONBody(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchHelmFeatureResponse(rsp)
+}
+
+// CreateHelmFeatureWithBodyWithResponse request with arbitrary body returning *CreateHelmFeatureResponse
+func (c *ClientWithResponses) CreateHelmFeatureWithBodyWithResponse(ctx context.Context, name string, params *CreateHelmFeatureParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateHelmFeatureResponse, error) {
+	rsp, err := c.CreateHelmFeatureWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateHelmFeatureResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateHelmFeatureWithResponse(ctx context.Context, name string, params *CreateHelmFeatureParams, body CreateHelmFeatureJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateHelmFeatureResponse, error) {
+	rsp, err := c.CreateHelmFeature(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateHelmFeatureResponse(rsp)
+}
+
+// GetRoleTemplatesWithResponse request returning *GetRoleTemplatesResponse
+func (c *ClientWithResponses) GetRoleTemplatesWithResponse(ctx context.Context, params *GetRoleTemplatesParams, reqEditors ...RequestEditorFn) (*GetRoleTemplatesResponse, error) {
+	rsp, err := c.GetRoleTemplates(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRoleTemplatesResponse(rsp)
+}
+
+// DeleteRoleTemplateWithResponse request returning *DeleteRoleTemplateResponse
+func (c *ClientWithResponses) DeleteRoleTemplateWithResponse(ctx context.Context, name string, params *DeleteRoleTemplateParams, reqEditors ...RequestEditorFn) (*DeleteRoleTemplateResponse, error) {
+	rsp, err := c.DeleteRoleTemplate(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteRoleTemplateResponse(rsp)
+}
+
+// GetRoleTemplateWithResponse request returning *GetRoleTemplateResponse
+func (c *ClientWithResponses) GetRoleTemplateWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetRoleTemplateResponse, error) {
+	rsp, err := c.GetRoleTemplate(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRoleTemplateResponse(rsp)
+}
+
+// PatchRoleTemplateWithBodyWithResponse request with arbitrary body returning *PatchRoleTemplateResponse
+func (c *ClientWithResponses) PatchRoleTemplateWithBodyWithResponse(ctx context.Context, name string, params *PatchRoleTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchRoleTemplateResponse, error) {
+	rsp, err := c.PatchRoleTemplateWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchRoleTemplateResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchRoleTemplateWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, name string, params *PatchRoleTemplateParams, body PatchRoleTemplateApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchRoleTemplateResponse, error) {
+	rsp, err := c.PatchRoleTemplateWithApplicationJSONPatchPlusJSONBody(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchRoleTemplateResponse(rsp)
+}
+
+// CreateRoleTemplateWithBodyWithResponse request with arbitrary body returning *CreateRoleTemplateResponse
+func (c *ClientWithResponses) CreateRoleTemplateWithBodyWithResponse(ctx context.Context, name string, params *CreateRoleTemplateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRoleTemplateResponse, error) {
+	rsp, err := c.CreateRoleTemplateWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRoleTemplateResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateRoleTemplateWithResponse(ctx context.Context, name string, params *CreateRoleTemplateParams, body CreateRoleTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRoleTemplateResponse, error) {
+	rsp, err := c.CreateRoleTemplate(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRoleTemplateResponse(rsp)
+}
+
+// GetServiceTiersWithResponse request returning *GetServiceTiersResponse
+func (c *ClientWithResponses) GetServiceTiersWithResponse(ctx context.Context, params *GetServiceTiersParams, reqEditors ...RequestEditorFn) (*GetServiceTiersResponse, error) {
+	rsp, err := c.GetServiceTiers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetServiceTiersResponse(rsp)
+}
+
+// DeleteServiceTierWithResponse request returning *DeleteServiceTierResponse
+func (c *ClientWithResponses) DeleteServiceTierWithResponse(ctx context.Context, name string, params *DeleteServiceTierParams, reqEditors ...RequestEditorFn) (*DeleteServiceTierResponse, error) {
+	rsp, err := c.DeleteServiceTier(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteServiceTierResponse(rsp)
+}
+
+// GetServiceTierWithResponse request returning *GetServiceTierResponse
+func (c *ClientWithResponses) GetServiceTierWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetServiceTierResponse, error) {
+	rsp, err := c.GetServiceTier(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetServiceTierResponse(rsp)
+}
+
+// PatchServiceTierWithBodyWithResponse request with arbitrary body returning *PatchServiceTierResponse
+func (c *ClientWithResponses) PatchServiceTierWithBodyWithResponse(ctx context.Context, name string, params *PatchServiceTierParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchServiceTierResponse, error) {
+	rsp, err := c.PatchServiceTierWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchServiceTierResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchServiceTierWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, name string, params *PatchServiceTierParams, body PatchServiceTierApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchServiceTierResponse, error) {
+	rsp, err := c.PatchServiceTierWithApplicationJSONPatchPlusJSONBody(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchServiceTierResponse(rsp)
+}
+
+// CreateServiceTierWithBodyWithResponse request with arbitrary body returning *CreateServiceTierResponse
+func (c *ClientWithResponses) CreateServiceTierWithBodyWithResponse(ctx context.Context, name string, params *CreateServiceTierParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateServiceTierResponse, error) {
+	rsp, err := c.CreateServiceTierWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateServiceTierResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateServiceTierWithResponse(ctx context.Context, name string, params *CreateServiceTierParams, body CreateServiceTierJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateServiceTierResponse, error) {
+	rsp, err := c.CreateServiceTier(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateServiceTierResponse(rsp)
+}
+
+// GetAllDatabasesWithResponse request returning *GetAllDatabasesResponse
+func (c *ClientWithResponses) GetAllDatabasesWithResponse(ctx context.Context, params *GetAllDatabasesParams, reqEditors ...RequestEditorFn) (*GetAllDatabasesResponse, error) {
+	rsp, err := c.GetAllDatabases(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAllDatabasesResponse(rsp)
+}
+
+// GetOrganizationDatabasesWithResponse request returning *GetOrganizationDatabasesResponse
+func (c *ClientWithResponses) GetOrganizationDatabasesWithResponse(ctx context.Context, organization string, params *GetOrganizationDatabasesParams, reqEditors ...RequestEditorFn) (*GetOrganizationDatabasesResponse, error) {
+	rsp, err := c.GetOrganizationDatabases(ctx, organization, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrganizationDatabasesResponse(rsp)
+}
+
+// GetDatabasesWithResponse request returning *GetDatabasesResponse
+func (c *ClientWithResponses) GetDatabasesWithResponse(ctx context.Context, organization string, project string, params *GetDatabasesParams, reqEditors ...RequestEditorFn) (*GetDatabasesResponse, error) {
+	rsp, err := c.GetDatabases(ctx, organization, project, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabasesResponse(rsp)
+}
+
+// DeleteDatabaseWithResponse request returning *DeleteDatabaseResponse
+func (c *ClientWithResponses) DeleteDatabaseWithResponse(ctx context.Context, organization string, project string, database string, params *DeleteDatabaseParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseResponse, error) {
+	rsp, err := c.DeleteDatabase(ctx, organization, project, database, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDatabaseResponse(rsp)
+}
+
+// GetDatabaseWithResponse request returning *GetDatabaseResponse
+func (c *ClientWithResponses) GetDatabaseWithResponse(ctx context.Context, organization string, project string, database string, reqEditors ...RequestEditorFn) (*GetDatabaseResponse, error) {
+	rsp, err := c.GetDatabase(ctx, organization, project, database, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseResponse(rsp)
+}
+
+// PatchDatabaseWithBodyWithResponse request with arbitrary body returning *PatchDatabaseResponse
+func (c *ClientWithResponses) PatchDatabaseWithBodyWithResponse(ctx context.Context, organization string, project string, database string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchDatabaseResponse, error) {
+	rsp, err := c.PatchDatabaseWithBody(ctx, organization, project, database, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchDatabaseResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchDatabaseWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, organization string, project string, database string, body PatchDatabaseApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchDatabaseResponse, error) {
+	rsp, err := c.PatchDatabaseWithApplicationJSONPatchPlusJSONBody(ctx, organization, project, database, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchDatabaseResponse(rsp)
+}
+
+// CreateDatabaseWithBodyWithResponse request with arbitrary body returning *CreateDatabaseResponse
+func (c *ClientWithResponses) CreateDatabaseWithBodyWithResponse(ctx context.Context, organization string, project string, database string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error) {
+	rsp, err := c.CreateDatabaseWithBody(ctx, organization, project, database, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDatabaseWithResponse(ctx context.Context, organization string, project string, database string, body CreateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error) {
+	rsp, err := c.CreateDatabase(ctx, organization, project, database, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseResponse(rsp)
+}
+
+// UpdateDbaPasswordWithBodyWithResponse request with arbitrary body returning *UpdateDbaPasswordResponse
+func (c *ClientWithResponses) UpdateDbaPasswordWithBodyWithResponse(ctx context.Context, organization string, project string, database string, params *UpdateDbaPasswordParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDbaPasswordResponse, error) {
+	rsp, err := c.UpdateDbaPasswordWithBody(ctx, organization, project, database, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDbaPasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDbaPasswordWithResponse(ctx context.Context, organization string, project string, database string, params *UpdateDbaPasswordParams, body UpdateDbaPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDbaPasswordResponse, error) {
+	rsp, err := c.UpdateDbaPassword(ctx, organization, project, database, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDbaPasswordResponse(rsp)
+}
+
+// LoginWithBodyWithResponse request with arbitrary body returning *LoginResponse
+func (c *ClientWithResponses) LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
+	rsp, err := c.LoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
+	rsp, err := c.Login(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResponse(rsp)
+}
+
+// GetAllProjectsWithResponse request returning *GetAllProjectsResponse
+func (c *ClientWithResponses) GetAllProjectsWithResponse(ctx context.Context, params *GetAllProjectsParams, reqEditors ...RequestEditorFn) (*GetAllProjectsResponse, error) {
+	rsp, err := c.GetAllProjects(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAllProjectsResponse(rsp)
+}
+
+// GetProjectsWithResponse request returning *GetProjectsResponse
+func (c *ClientWithResponses) GetProjectsWithResponse(ctx context.Context, organization string, params *GetProjectsParams, reqEditors ...RequestEditorFn) (*GetProjectsResponse, error) {
+	rsp, err := c.GetProjects(ctx, organization, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectsResponse(rsp)
+}
+
+// DeleteProjectWithResponse request returning *DeleteProjectResponse
+func (c *ClientWithResponses) DeleteProjectWithResponse(ctx context.Context, organization string, project string, params *DeleteProjectParams, reqEditors ...RequestEditorFn) (*DeleteProjectResponse, error) {
+	rsp, err := c.DeleteProject(ctx, organization, project, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectResponse(rsp)
+}
+
+// GetProjectWithResponse request returning *GetProjectResponse
+func (c *ClientWithResponses) GetProjectWithResponse(ctx context.Context, organization string, project string, reqEditors ...RequestEditorFn) (*GetProjectResponse, error) {
+	rsp, err := c.GetProject(ctx, organization, project, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectResponse(rsp)
+}
+
+// PatchProjectWithBodyWithResponse request with arbitrary body returning *PatchProjectResponse
+func (c *ClientWithResponses) PatchProjectWithBodyWithResponse(ctx context.Context, organization string, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchProjectResponse, error) {
+	rsp, err := c.PatchProjectWithBody(ctx, organization, project, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchProjectResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchProjectWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, organization string, project string, body PatchProjectApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchProjectResponse, error) {
+	rsp, err := c.PatchProjectWithApplicationJSONPatchPlusJSONBody(ctx, organization, project, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchProjectResponse(rsp)
+}
+
+// CreateProjectWithBodyWithResponse request with arbitrary body returning *CreateProjectResponse
+func (c *ClientWithResponses) CreateProjectWithBodyWithResponse(ctx context.Context, organization string, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error) {
+	rsp, err := c.CreateProjectWithBody(ctx, organization, project, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateProjectWithResponse(ctx context.Context, organization string, project string, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error) {
+	rsp, err := c.CreateProject(ctx, organization, project, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectResponse(rsp)
+}
+
+// GetAllUsersWithResponse request returning *GetAllUsersResponse
+func (c *ClientWithResponses) GetAllUsersWithResponse(ctx context.Context, params *GetAllUsersParams, reqEditors ...RequestEditorFn) (*GetAllUsersResponse, error) {
+	rsp, err := c.GetAllUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAllUsersResponse(rsp)
+}
+
+// GetUsersWithResponse request returning *GetUsersResponse
+func (c *ClientWithResponses) GetUsersWithResponse(ctx context.Context, organization string, params *GetUsersParams, reqEditors ...RequestEditorFn) (*GetUsersResponse, error) {
+	rsp, err := c.GetUsers(ctx, organization, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersResponse(rsp)
+}
+
+// DeleteUserWithResponse request returning *DeleteUserResponse
+func (c *ClientWithResponses) DeleteUserWithResponse(ctx context.Context, organization string, user string, params *DeleteUserParams, reqEditors ...RequestEditorFn) (*DeleteUserResponse, error) {
+	rsp, err := c.DeleteUser(ctx, organization, user, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUserResponse(rsp)
+}
+
+// GetUserWithResponse request returning *GetUserResponse
+func (c *ClientWithResponses) GetUserWithResponse(ctx context.Context, organization string, user string, reqEditors ...RequestEditorFn) (*GetUserResponse, error) {
+	rsp, err := c.GetUser(ctx, organization, user, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserResponse(rsp)
+}
+
+// PatchUserWithBodyWithResponse request with arbitrary body returning *PatchUserResponse
+func (c *ClientWithResponses) PatchUserWithBodyWithResponse(ctx context.Context, organization string, user string, params *PatchUserParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchUserResponse, error) {
+	rsp, err := c.PatchUserWithBody(ctx, organization, user, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchUserWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, organization string, user string, params *PatchUserParams, body PatchUserApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchUserResponse, error) {
+	rsp, err := c.PatchUserWithApplicationJSONPatchPlusJSONBody(ctx, organization, user, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchUserResponse(rsp)
+}
+
+// CreateUserWithBodyWithResponse request with arbitrary body returning *CreateUserResponse
+func (c *ClientWithResponses) CreateUserWithBodyWithResponse(ctx context.Context, organization string, user string, params *CreateUserParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
+	rsp, err := c.CreateUserWithBody(ctx, organization, user, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateUserWithResponse(ctx context.Context, organization string, user string, params *CreateUserParams, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
+	rsp, err := c.CreateUser(ctx, organization, user, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserResponse(rsp)
+}
+
+// ParseGetAllBackupPoliciesResponse parses an HTTP response from a GetAllBackupPoliciesWithResponse call
+func ParseGetAllBackupPoliciesResponse(rsp *http.Response) (*GetAllBackupPoliciesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAllBackupPoliciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBackupPoliciesResponse parses an HTTP response from a GetBackupPoliciesWithResponse call
+func ParseGetBackupPoliciesResponse(rsp *http.Response) (*GetBackupPoliciesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBackupPoliciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteBackupPolicyResponse parses an HTTP response from a DeleteBackupPolicyWithResponse call
+func ParseDeleteBackupPolicyResponse(rsp *http.Response) (*DeleteBackupPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteBackupPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON408 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBackupPolicyResponse parses an HTTP response from a GetBackupPolicyWithResponse call
+func ParseGetBackupPolicyResponse(rsp *http.Response) (*GetBackupPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBackupPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupPolicyModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchBackupPolicyResponse parses an HTTP response from a PatchBackupPolicyWithResponse call
+func ParsePatchBackupPolicyResponse(rsp *http.Response) (*PatchBackupPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchBackupPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupPolicyModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateBackupPolicyResponse parses an HTTP response from a CreateBackupPolicyWithResponse call
+func ParseCreateBackupPolicyResponse(rsp *http.Response) (*CreateBackupPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateBackupPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupPolicyModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BackupPolicyModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBackupsFromPolicyResponse parses an HTTP response from a GetBackupsFromPolicyWithResponse call
+func ParseGetBackupsFromPolicyResponse(rsp *http.Response) (*GetBackupsFromPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBackupsFromPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMatchingDatabasesResponse parses an HTTP response from a GetMatchingDatabasesWithResponse call
+func ParseGetMatchingDatabasesResponse(rsp *http.Response) (*GetMatchingDatabasesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMatchingDatabasesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAllBackupsResponse parses an HTTP response from a GetAllBackupsWithResponse call
+func ParseGetAllBackupsResponse(rsp *http.Response) (*GetAllBackupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAllBackupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOrganizationBackupsResponse parses an HTTP response from a GetOrganizationBackupsWithResponse call
+func ParseGetOrganizationBackupsResponse(rsp *http.Response) (*GetOrganizationBackupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrganizationBackupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectBackupsResponse parses an HTTP response from a GetProjectBackupsWithResponse call
+func ParseGetProjectBackupsResponse(rsp *http.Response) (*GetProjectBackupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectBackupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBackupsResponse parses an HTTP response from a GetBackupsWithResponse call
+func ParseGetBackupsResponse(rsp *http.Response) (*GetBackupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBackupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateBackupResponse parses an HTTP response from a CreateBackupWithResponse call
+func ParseCreateBackupResponse(rsp *http.Response) (*CreateBackupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateBackupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BackupModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteBackupResponse parses an HTTP response from a DeleteBackupWithResponse call
+func ParseDeleteBackupResponse(rsp *http.Response) (*DeleteBackupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteBackupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON408 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBackupResponse parses an HTTP response from a GetBackupWithResponse call
+func ParseGetBackupResponse(rsp *http.Response) (*GetBackupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBackupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchBackupResponse parses an HTTP response from a PatchBackupWithResponse call
+func ParsePatchBackupResponse(rsp *http.Response) (*PatchBackupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchBackupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateOrUpdateBackupResponse parses an HTTP response from a CreateOrUpdateBackupWithResponse call
+func ParseCreateOrUpdateBackupResponse(rsp *http.Response) (*CreateOrUpdateBackupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateOrUpdateBackupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BackupModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCanaryRolloutsResponse parses an HTTP response from a GetCanaryRolloutsWithResponse call
+func ParseGetCanaryRolloutsResponse(rsp *http.Response) (*GetCanaryRolloutsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCanaryRolloutsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCanaryRolloutResponse parses an HTTP response from a DeleteCanaryRolloutWithResponse call
+func ParseDeleteCanaryRolloutResponse(rsp *http.Response) (*DeleteCanaryRolloutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCanaryRolloutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON408 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCanaryRolloutResponse parses an HTTP response from a GetCanaryRolloutWithResponse call
+func ParseGetCanaryRolloutResponse(rsp *http.Response) (*GetCanaryRolloutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCanaryRolloutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CanaryRolloutModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchCanaryRolloutResponse parses an HTTP response from a PatchCanaryRolloutWithResponse call
+func ParsePatchCanaryRolloutResponse(rsp *http.Response) (*PatchCanaryRolloutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchCanaryRolloutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CanaryRolloutModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateCanaryRolloutResponse parses an HTTP response from a CreateCanaryRolloutWithResponse call
+func ParseCreateCanaryRolloutResponse(rsp *http.Response) (*CreateCanaryRolloutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateCanaryRolloutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CanaryRolloutModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CanaryRolloutModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCanaryRolloutTemplatesResponse parses an HTTP response from a GetCanaryRolloutTemplatesWithResponse call
+func ParseGetCanaryRolloutTemplatesResponse(rsp *http.Response) (*GetCanaryRolloutTemplatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCanaryRolloutTemplatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCanaryRolloutTemplateResponse parses an HTTP response from a DeleteCanaryRolloutTemplateWithResponse call
+func ParseDeleteCanaryRolloutTemplateResponse(rsp *http.Response) (*DeleteCanaryRolloutTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCanaryRolloutTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON408 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCanaryRolloutTemplateResponse parses an HTTP response from a GetCanaryRolloutTemplateWithResponse call
+func ParseGetCanaryRolloutTemplateResponse(rsp *http.Response) (*GetCanaryRolloutTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCanaryRolloutTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CanaryRolloutTemplateModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchCanaryRolloutTemplateResponse parses an HTTP response from a PatchCanaryRolloutTemplateWithResponse call
+func ParsePatchCanaryRolloutTemplateResponse(rsp *http.Response) (*PatchCanaryRolloutTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchCanaryRolloutTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CanaryRolloutTemplateModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateCanaryRolloutTemplateResponse parses an HTTP response from a CreateCanaryRolloutTemplateWithResponse call
+func ParseCreateCanaryRolloutTemplateResponse(rsp *http.Response) (*CreateCanaryRolloutTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateCanaryRolloutTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CanaryRolloutTemplateModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CanaryRolloutTemplateModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDatabaseQuotasResponse parses an HTTP response from a GetDatabaseQuotasWithResponse call
+func ParseGetDatabaseQuotasResponse(rsp *http.Response) (*GetDatabaseQuotasResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDatabaseQuotasResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteDatabaseQuotaResponse parses an HTTP response from a DeleteDatabaseQuotaWithResponse call
+func ParseDeleteDatabaseQuotaResponse(rsp *http.Response) (*DeleteDatabaseQuotaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteDatabaseQuotaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON408 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDatabaseQuotaResponse parses an HTTP response from a GetDatabaseQuotaWithResponse call
+func ParseGetDatabaseQuotaResponse(rsp *http.Response) (*GetDatabaseQuotaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDatabaseQuotaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseQuotaModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchDatabaseQuotaResponse parses an HTTP response from a PatchDatabaseQuotaWithResponse call
+func ParsePatchDatabaseQuotaResponse(rsp *http.Response) (*PatchDatabaseQuotaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchDatabaseQuotaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseQuotaModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateDatabaseQuotaResponse parses an HTTP response from a CreateDatabaseQuotaWithResponse call
+func ParseCreateDatabaseQuotaResponse(rsp *http.Response) (*CreateDatabaseQuotaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateDatabaseQuotaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseQuotaModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DatabaseQuotaModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
 
-// GetBackupPolicyWithResponse request returning *GetBackupPolicyResponse
-func (c *ClientWithResponses) GetBackupPolicyWithResponse(ctx context.Context, organization string, policy string, reqEditors ...RequestEditorFn) (*GetBackupPolicyResponse, error) {
-	rsp, err := c.GetBackupPolicy(ctx, organization, policy, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetBackupPolicyResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
 
-// PatchBackupPolicyWithBodyWithResponse request with arbitrary body returning *PatchBackupPolicyResponse
-func (c *ClientWithResponses) PatchBackupPolicyWithBodyWithResponse(ctx context.Context, organization string, policy string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchBackupPolicyResponse, error) {
-	rsp, err := c.PatchBackupPolicyWithBody(ctx, organization, policy, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePatchBackupPolicyResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-func (c *ClientWithResponses) PatchBackupPolicyWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, organization string, policy string, body PatchBackupPolicyApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchBackupPolicyResponse, error) {
-	rsp, err := c.PatchBackupPolicyWithApplicationJSONPatchPlusJSONBody(ctx, organization, policy, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParsePatchBackupPolicyResponse(rsp)
-}
 
-// CreateBackupPolicyWithBodyWithResponse request with arbitrary body returning *CreateBackupPolicyResponse
-func (c *ClientWithResponses) CreateBackupPolicyWithBodyWithResponse(ctx context.Context, organization string, policy string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBackupPolicyResponse, error) {
-	rsp, err := c.CreateBackupPolicyWithBody(ctx, organization, policy, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateBackupPolicyResponse(rsp)
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateBackupPolicyWithResponse(ctx context.Context, organization string, policy string, body CreateBackupPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBackupPolicyResponse, error) {
-	rsp, err := c.CreateBackupPolicy(ctx, organization, policy, body, reqEditors...)
+// ParseGetHelmFeaturesResponse parses an HTTP response from a GetHelmFeaturesWithResponse call
+func ParseGetHelmFeaturesResponse(rsp *http.Response) (*GetHelmFeaturesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateBackupPolicyResponse(rsp)
-}
 
-// GetBackupsFromPolicyWithResponse request returning *GetBackupsFromPolicyResponse
-func (c *ClientWithResponses) GetBackupsFromPolicyWithResponse(ctx context.Context, organization string, policy string, params *GetBackupsFromPolicyParams, reqEditors ...RequestEditorFn) (*GetBackupsFromPolicyResponse, error) {
-	rsp, err := c.GetBackupsFromPolicy(ctx, organization, policy, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetHelmFeaturesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetBackupsFromPolicyResponse(rsp)
-}
 
-// GetMatchingDatabasesWithResponse request returning *GetMatchingDatabasesResponse
-func (c *ClientWithResponses) GetMatchingDatabasesWithResponse(ctx context.Context, organization string, policy string, params *GetMatchingDatabasesParams, reqEditors ...RequestEditorFn) (*GetMatchingDatabasesResponse, error) {
-	rsp, err := c.GetMatchingDatabases(ctx, organization, policy, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetMatchingDatabasesResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// GetAllBackupsWithResponse request returning *GetAllBackupsResponse
-func (c *ClientWithResponses) GetAllBackupsWithResponse(ctx context.Context, params *GetAllBackupsParams, reqEditors ...RequestEditorFn) (*GetAllBackupsResponse, error) {
-	rsp, err := c.GetAllBackups(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetAllBackupsResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// GetOrganizationBackupsWithResponse request returning *GetOrganizationBackupsResponse
-func (c *ClientWithResponses) GetOrganizationBackupsWithResponse(ctx context.Context, organization string, params *GetOrganizationBackupsParams, reqEditors ...RequestEditorFn) (*GetOrganizationBackupsResponse, error) {
-	rsp, err := c.GetOrganizationBackups(ctx, organization, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetOrganizationBackupsResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// GetProjectBackupsWithResponse request returning *GetProjectBackupsResponse
-func (c *ClientWithResponses) GetProjectBackupsWithResponse(ctx context.Context, organization string, project string, params *GetProjectBackupsParams, reqEditors ...RequestEditorFn) (*GetProjectBackupsResponse, error) {
-	rsp, err := c.GetProjectBackups(ctx, organization, project, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetProjectBackupsResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-// GetBackupsWithResponse request returning *GetBackupsResponse
-func (c *ClientWithResponses) GetBackupsWithResponse(ctx context.Context, organization string, project string, database string, params *GetBackupsParams, reqEditors ...RequestEditorFn) (*GetBackupsResponse, error) {
-	rsp, err := c.GetBackups(ctx, organization, project, database, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetBackupsResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// CreateBackupWithBodyWithResponse request with arbitrary body returning *CreateBackupResponse
-func (c *ClientWithResponses) CreateBackupWithBodyWithResponse(ctx context.Context, organization string, project string, database string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBackupResponse, error) {
-	rsp, err := c.CreateBackupWithBody(ctx, organization, project, database, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseCreateBackupResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateBackupWithResponse(ctx context.Context, organization string, project string, database string, body CreateBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBackupResponse, error) {
-	rsp, err := c.CreateBackup(ctx, organization, project, database, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateBackupResponse(rsp)
+	return response, nil
 }
 
-// DeleteBackupWithResponse request returning *DeleteBackupResponse
-func (c *ClientWithResponses) DeleteBackupWithResponse(ctx context.Context, organization string, project string, database string, backup string, params *DeleteBackupParams, reqEditors ...RequestEditorFn) (*DeleteBackupResponse, error) {
-	rsp, err := c.DeleteBackup(ctx, organization, project, database, backup, params, reqEditors...)
+// ParseDeleteHelmFeatureResponse parses an HTTP response from a DeleteHelmFeatureWithResponse call
+func ParseDeleteHelmFeatureResponse(rsp *http.Response) (*DeleteHelmFeatureResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteBackupResponse(rsp)
-}
 
-// GetBackupWithResponse request returning *GetBackupResponse
-func (c *ClientWithResponses) GetBackupWithResponse(ctx context.Context, organization string, project string, database string, backup string, reqEditors ...RequestEditorFn) (*GetBackupResponse, error) {
-	rsp, err := c.GetBackup(ctx, organization, project, database, backup, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteHelmFeatureResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetBackupResponse(rsp)
-}
 
-// PatchBackupWithBodyWithResponse request with arbitrary body returning *PatchBackupResponse
-func (c *ClientWithResponses) PatchBackupWithBodyWithResponse(ctx context.Context, organization string, project string, database string, backup string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchBackupResponse, error) {
-	rsp, err := c.PatchBackupWithBody(ctx, organization, project, database, backup, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePatchBackupResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-func (c *ClientWithResponses) PatchBackupWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, organization string, project string, database string, backup string, body PatchBackupApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchBackupResponse, error) {
-	rsp, err := c.PatchBackupWithApplicationJSONPatchPlusJSONBody(ctx, organization, project, database, backup, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePatchBackupResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// CreateOrUpdateBackupWithBodyWithResponse request with arbitrary body returning *CreateOrUpdateBackupResponse
-func (c *ClientWithResponses) CreateOrUpdateBackupWithBodyWithResponse(ctx context.Context, organization string, project string, database string, backup string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrUpdateBackupResponse, error) {
-	rsp, err := c.CreateOrUpdateBackupWithBody(ctx, organization, project, database, backup, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateOrUpdateBackupResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-func (c *ClientWithResponses) CreateOrUpdateBackupWithResponse(ctx context.Context, organization string, project string, database string, backup string, body CreateOrUpdateBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrUpdateBackupResponse, error) {
-	rsp, err := c.CreateOrUpdateBackup(ctx, organization, project, database, backup, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateOrUpdateBackupResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// GetAllDatabasesWithResponse request returning *GetAllDatabasesResponse
-func (c *ClientWithResponses) GetAllDatabasesWithResponse(ctx context.Context, params *GetAllDatabasesParams, reqEditors ...RequestEditorFn) (*GetAllDatabasesResponse, error) {
-	rsp, err := c.GetAllDatabases(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetAllDatabasesResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON408 = &dest
 
-// GetOrganizationDatabasesWithResponse request returning *GetOrganizationDatabasesResponse
-func (c *ClientWithResponses) GetOrganizationDatabasesWithResponse(ctx context.Context, organization string, params *GetOrganizationDatabasesParams, reqEditors ...RequestEditorFn) (*GetOrganizationDatabasesResponse, error) {
-	rsp, err := c.GetOrganizationDatabases(ctx, organization, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetOrganizationDatabasesResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// GetDatabasesWithResponse request returning *GetDatabasesResponse
-func (c *ClientWithResponses) GetDatabasesWithResponse(ctx context.Context, organization string, project string, params *GetDatabasesParams, reqEditors ...RequestEditorFn) (*GetDatabasesResponse, error) {
-	rsp, err := c.GetDatabases(ctx, organization, project, params, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseGetDatabasesResponse(rsp)
-}
 
-// DeleteDatabaseWithResponse request returning *DeleteDatabaseResponse
-func (c *ClientWithResponses) DeleteDatabaseWithResponse(ctx context.Context, organization string, project string, database string, params *DeleteDatabaseParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseResponse, error) {
-	rsp, err := c.DeleteDatabase(ctx, organization, project, database, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteDatabaseResponse(rsp)
+	return response, nil
 }
 
-// GetDatabaseWithResponse request returning *GetDatabaseResponse
-func (c *ClientWithResponses) GetDatabaseWithResponse(ctx context.Context, organization string, project string, database string, reqEditors ...RequestEditorFn) (*GetDatabaseResponse, error) {
-	rsp, err := c.GetDatabase(ctx, organization, project, database, reqEditors...)
+// ParseGetHelmFeatureResponse parses an HTTP response from a GetHelmFeatureWithResponse call
+func ParseGetHelmFeatureResponse(rsp *http.Response) (*GetHelmFeatureResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDatabaseResponse(rsp)
-}
 
-// PatchDatabaseWithBodyWithResponse request with arbitrary body returning *PatchDatabaseResponse
-func (c *ClientWithResponses) PatchDatabaseWithBodyWithResponse(ctx context.Context, organization string, project string, database string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchDatabaseResponse, error) {
-	rsp, err := c.PatchDatabaseWithBody(ctx, organization, project, database, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetHelmFeatureResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePatchDatabaseResponse(rsp)
-}
 
-func (c *ClientWithResponses) PatchDatabaseWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, organization string, project string, database string, body PatchDatabaseApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchDatabaseResponse, error) {
-	rsp, err := c.PatchDatabaseWithApplicationJSONPatchPlusJSONBody(ctx, organization, project, database, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePatchDatabaseResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HelmFeatureModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// CreateDatabaseWithBodyWithResponse request with arbitrary body returning *CreateDatabaseResponse
-func (c *ClientWithResponses) CreateDatabaseWithBodyWithResponse(ctx context.Context, organization string, project string, database string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error) {
-	rsp, err := c.CreateDatabaseWithBody(ctx, organization, project, database, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateDatabaseResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-func (c *ClientWithResponses) CreateDatabaseWithResponse(ctx context.Context, organization string, project string, database string, body CreateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error) {
-	rsp, err := c.CreateDatabase(ctx, organization, project, database, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateDatabaseResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// UpdateDbaPasswordWithBodyWithResponse request with arbitrary body returning *UpdateDbaPasswordResponse
-func (c *ClientWithResponses) UpdateDbaPasswordWithBodyWithResponse(ctx context.Context, organization string, project string, database string, params *UpdateDbaPasswordParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDbaPasswordResponse, error) {
-	rsp, err := c.UpdateDbaPasswordWithBody(ctx, organization, project, database, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateDbaPasswordResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-func (c *ClientWithResponses) UpdateDbaPasswordWithResponse(ctx context.Context, organization string, project string, database string, params *UpdateDbaPasswordParams, body UpdateDbaPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDbaPasswordResponse, error) {
-	rsp, err := c.UpdateDbaPassword(ctx, organization, project, database, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateDbaPasswordResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// GetAllProjectsWithResponse request returning *GetAllProjectsResponse
-func (c *ClientWithResponses) GetAllProjectsWithResponse(ctx context.Context, params *GetAllProjectsParams, reqEditors ...RequestEditorFn) (*GetAllProjectsResponse, error) {
-	rsp, err := c.GetAllProjects(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetAllProjectsResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// GetProjectsWithResponse request returning *GetProjectsResponse
-func (c *ClientWithResponses) GetProjectsWithResponse(ctx context.Context, organization string, params *GetProjectsParams, reqEditors ...RequestEditorFn) (*GetProjectsResponse, error) {
-	rsp, err := c.GetProjects(ctx, organization, params, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseGetProjectsResponse(rsp)
-}
 
-// DeleteProjectWithResponse request returning *DeleteProjectResponse
-func (c *ClientWithResponses) DeleteProjectWithResponse(ctx context.Context, organization string, project string, params *DeleteProjectParams, reqEditors ...RequestEditorFn) (*DeleteProjectResponse, error) {
-	rsp, err := c.DeleteProject(ctx, organization, project, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteProjectResponse(rsp)
+	return response, nil
 }
 
-// GetProjectWithResponse request returning *GetProjectResponse
-func (c *ClientWithResponses) GetProjectWithResponse(ctx context.Context, organization string, project string, reqEditors ...RequestEditorFn) (*GetProjectResponse, error) {
-	rsp, err := c.GetProject(ctx, organization, project, reqEditors...)
+// ParsePatchHelmFeatureResponse parses an HTTP response from a PatchHelmFeatureWithResponse call
+func ParsePatchHelmFeatureResponse(rsp *http.Response) (*PatchHelmFeatureResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetProjectResponse(rsp)
-}
 
-// PatchProjectWithBodyWithResponse request with arbitrary body returning *PatchProjectResponse
-func (c *ClientWithResponses) PatchProjectWithBodyWithResponse(ctx context.Context, organization string, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchProjectResponse, error) {
-	rsp, err := c.PatchProjectWithBody(ctx, organization, project, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PatchHelmFeatureResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePatchProjectResponse(rsp)
-}
 
-func (c *ClientWithResponses) PatchProjectWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, organization string, project string, body PatchProjectApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchProjectResponse, error) {
-	rsp, err := c.PatchProjectWithApplicationJSONPatchPlusJSONBody(ctx, organization, project, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePatchProjectResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HelmFeatureModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// CreateProjectWithBodyWithResponse request with arbitrary body returning *CreateProjectResponse
-func (c *ClientWithResponses) CreateProjectWithBodyWithResponse(ctx context.Context, organization string, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error) {
-	rsp, err := c.CreateProjectWithBody(ctx, organization, project, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateProjectResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-func (c *ClientWithResponses) CreateProjectWithResponse(ctx context.Context, organization string, project string, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error) {
-	rsp, err := c.CreateProject(ctx, organization, project, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseCreateProjectResponse(rsp)
+
+	return response, nil
 }
 
-// ParseGetAllBackupPoliciesResponse parses an HTTP response from a GetAllBackupPoliciesWithResponse call
-func ParseGetAllBackupPoliciesResponse(rsp *http.Response) (*GetAllBackupPoliciesResponse, error) {
+// ParseCreateHelmFeatureResponse parses an HTTP response from a CreateHelmFeatureWithResponse call
+func ParseCreateHelmFeatureResponse(rsp *http.Response) (*CreateHelmFeatureResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllBackupPoliciesResponse{
+	response := &CreateHelmFeatureResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ItemList
+		var dest HelmFeatureModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest HelmFeatureModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5173,6 +12439,27 @@ func ParseGetAllBackupPoliciesResponse(rsp *http.Response) (*GetAllBackupPolicie
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5185,15 +12472,15 @@ func ParseGetAllBackupPoliciesResponse(rsp *http.Response) (*GetAllBackupPolicie
 	return response, nil
 }
 
-// ParseGetBackupPoliciesResponse parses an HTTP response from a GetBackupPoliciesWithResponse call
-func ParseGetBackupPoliciesResponse(rsp *http.Response) (*GetBackupPoliciesResponse, error) {
+// ParseGetRoleTemplatesResponse parses an HTTP response from a GetRoleTemplatesWithResponse call
+func ParseGetRoleTemplatesResponse(rsp *http.Response) (*GetRoleTemplatesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetBackupPoliciesResponse{
+	response := &GetRoleTemplatesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5239,15 +12526,15 @@ func ParseGetBackupPoliciesResponse(rsp *http.Response) (*GetBackupPoliciesRespo
 	return response, nil
 }
 
-// ParseDeleteBackupPolicyResponse parses an HTTP response from a DeleteBackupPolicyWithResponse call
-func ParseDeleteBackupPolicyResponse(rsp *http.Response) (*DeleteBackupPolicyResponse, error) {
+// ParseDeleteRoleTemplateResponse parses an HTTP response from a DeleteRoleTemplateWithResponse call
+func ParseDeleteRoleTemplateResponse(rsp *http.Response) (*DeleteRoleTemplateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteBackupPolicyResponse{
+	response := &DeleteRoleTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5300,22 +12587,22 @@ func ParseDeleteBackupPolicyResponse(rsp *http.Response) (*DeleteBackupPolicyRes
 	return response, nil
 }
 
-// ParseGetBackupPolicyResponse parses an HTTP response from a GetBackupPolicyWithResponse call
-func ParseGetBackupPolicyResponse(rsp *http.Response) (*GetBackupPolicyResponse, error) {
+// ParseGetRoleTemplateResponse parses an HTTP response from a GetRoleTemplateWithResponse call
+func ParseGetRoleTemplateResponse(rsp *http.Response) (*GetRoleTemplateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetBackupPolicyResponse{
+	response := &GetRoleTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BackupPolicyModel
+		var dest RoleTemplateModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5361,22 +12648,22 @@ func ParseGetBackupPolicyResponse(rsp *http.Response) (*GetBackupPolicyResponse,
 	return response, nil
 }
 
-// ParsePatchBackupPolicyResponse parses an HTTP response from a PatchBackupPolicyWithResponse call
-func ParsePatchBackupPolicyResponse(rsp *http.Response) (*PatchBackupPolicyResponse, error) {
+// ParsePatchRoleTemplateResponse parses an HTTP response from a PatchRoleTemplateWithResponse call
+func ParsePatchRoleTemplateResponse(rsp *http.Response) (*PatchRoleTemplateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchBackupPolicyResponse{
+	response := &PatchRoleTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BackupPolicyModel
+		var dest RoleTemplateModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5436,29 +12723,29 @@ func ParsePatchBackupPolicyResponse(rsp *http.Response) (*PatchBackupPolicyRespo
 	return response, nil
 }
 
-// ParseCreateBackupPolicyResponse parses an HTTP response from a CreateBackupPolicyWithResponse call
-func ParseCreateBackupPolicyResponse(rsp *http.Response) (*CreateBackupPolicyResponse, error) {
+// ParseCreateRoleTemplateResponse parses an HTTP response from a CreateRoleTemplateWithResponse call
+func ParseCreateRoleTemplateResponse(rsp *http.Response) (*CreateRoleTemplateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateBackupPolicyResponse{
+	response := &CreateRoleTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BackupPolicyModel
+		var dest RoleTemplateModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest BackupPolicyModel
+		var dest RoleTemplateModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5518,15 +12805,15 @@ func ParseCreateBackupPolicyResponse(rsp *http.Response) (*CreateBackupPolicyRes
 	return response, nil
 }
 
-// ParseGetBackupsFromPolicyResponse parses an HTTP response from a GetBackupsFromPolicyWithResponse call
-func ParseGetBackupsFromPolicyResponse(rsp *http.Response) (*GetBackupsFromPolicyResponse, error) {
+// ParseGetServiceTiersResponse parses an HTTP response from a GetServiceTiersWithResponse call
+func ParseGetServiceTiersResponse(rsp *http.Response) (*GetServiceTiersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetBackupsFromPolicyResponse{
+	response := &GetServiceTiersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5560,6 +12847,53 @@ func ParseGetBackupsFromPolicyResponse(rsp *http.Response) (*GetBackupsFromPolic
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteServiceTierResponse parses an HTTP response from a DeleteServiceTierWithResponse call
+func ParseDeleteServiceTierResponse(rsp *http.Response) (*DeleteServiceTierResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteServiceTierResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5567,6 +12901,13 @@ func ParseGetBackupsFromPolicyResponse(rsp *http.Response) (*GetBackupsFromPolic
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON408 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5579,22 +12920,22 @@ func ParseGetBackupsFromPolicyResponse(rsp *http.Response) (*GetBackupsFromPolic
 	return response, nil
 }
 
-// ParseGetMatchingDatabasesResponse parses an HTTP response from a GetMatchingDatabasesWithResponse call
-func ParseGetMatchingDatabasesResponse(rsp *http.Response) (*GetMatchingDatabasesResponse, error) {
+// ParseGetServiceTierResponse parses an HTTP response from a GetServiceTierWithResponse call
+func ParseGetServiceTierResponse(rsp *http.Response) (*GetServiceTierResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetMatchingDatabasesResponse{
+	response := &GetServiceTierResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ItemList
+		var dest ServiceTierModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5640,22 +12981,22 @@ func ParseGetMatchingDatabasesResponse(rsp *http.Response) (*GetMatchingDatabase
 	return response, nil
 }
 
-// ParseGetAllBackupsResponse parses an HTTP response from a GetAllBackupsWithResponse call
-func ParseGetAllBackupsResponse(rsp *http.Response) (*GetAllBackupsResponse, error) {
+// ParsePatchServiceTierResponse parses an HTTP response from a PatchServiceTierWithResponse call
+func ParsePatchServiceTierResponse(rsp *http.Response) (*PatchServiceTierResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllBackupsResponse{
+	response := &PatchServiceTierResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ItemList
+		var dest ServiceTierModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5682,6 +13023,27 @@ func ParseGetAllBackupsResponse(rsp *http.Response) (*GetAllBackupsResponse, err
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5694,27 +13056,34 @@ func ParseGetAllBackupsResponse(rsp *http.Response) (*GetAllBackupsResponse, err
 	return response, nil
 }
 
-// ParseGetOrganizationBackupsResponse parses an HTTP response from a GetOrganizationBackupsWithResponse call
-func ParseGetOrganizationBackupsResponse(rsp *http.Response) (*GetOrganizationBackupsResponse, error) {
+// ParseCreateServiceTierResponse parses an HTTP response from a CreateServiceTierWithResponse call
+func ParseCreateServiceTierResponse(rsp *http.Response) (*CreateServiceTierResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetOrganizationBackupsResponse{
+	response := &CreateServiceTierResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ItemList
+		var dest ServiceTierModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ServiceTierModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5736,6 +13105,27 @@ func ParseGetOrganizationBackupsResponse(rsp *http.Response) (*GetOrganizationBa
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5748,15 +13138,15 @@ func ParseGetOrganizationBackupsResponse(rsp *http.Response) (*GetOrganizationBa
 	return response, nil
 }
 
-// ParseGetProjectBackupsResponse parses an HTTP response from a GetProjectBackupsWithResponse call
-func ParseGetProjectBackupsResponse(rsp *http.Response) (*GetProjectBackupsResponse, error) {
+// ParseGetAllDatabasesResponse parses an HTTP response from a GetAllDatabasesWithResponse call
+func ParseGetAllDatabasesResponse(rsp *http.Response) (*GetAllDatabasesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectBackupsResponse{
+	response := &GetAllDatabasesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5802,15 +13192,15 @@ func ParseGetProjectBackupsResponse(rsp *http.Response) (*GetProjectBackupsRespo
 	return response, nil
 }
 
-// ParseGetBackupsResponse parses an HTTP response from a GetBackupsWithResponse call
-func ParseGetBackupsResponse(rsp *http.Response) (*GetBackupsResponse, error) {
+// ParseGetOrganizationDatabasesResponse parses an HTTP response from a GetOrganizationDatabasesWithResponse call
+func ParseGetOrganizationDatabasesResponse(rsp *http.Response) (*GetOrganizationDatabasesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetBackupsResponse{
+	response := &GetOrganizationDatabasesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5856,26 +13246,26 @@ func ParseGetBackupsResponse(rsp *http.Response) (*GetBackupsResponse, error) {
 	return response, nil
 }
 
-// ParseCreateBackupResponse parses an HTTP response from a CreateBackupWithResponse call
-func ParseCreateBackupResponse(rsp *http.Response) (*CreateBackupResponse, error) {
+// ParseGetDatabasesResponse parses an HTTP response from a GetDatabasesWithResponse call
+func ParseGetDatabasesResponse(rsp *http.Response) (*GetDatabasesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateBackupResponse{
+	response := &GetDatabasesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest BackupModel
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorContent
@@ -5905,19 +13295,66 @@ func ParseCreateBackupResponse(rsp *http.Response) (*CreateBackupResponse, error
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON500 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+	}
+
+	return response, nil
+}
+
+// ParseDeleteDatabaseResponse parses an HTTP response from a DeleteDatabaseWithResponse call
+func ParseDeleteDatabaseResponse(rsp *http.Response) (*DeleteDatabaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteDatabaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON415 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON408 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorContent
@@ -5931,20 +13368,27 @@ func ParseCreateBackupResponse(rsp *http.Response) (*CreateBackupResponse, error
 	return response, nil
 }
 
-// ParseDeleteBackupResponse parses an HTTP response from a DeleteBackupWithResponse call
-func ParseDeleteBackupResponse(rsp *http.Response) (*DeleteBackupResponse, error) {
+// ParseGetDatabaseResponse parses an HTTP response from a GetDatabaseWithResponse call
+func ParseGetDatabaseResponse(rsp *http.Response) (*GetDatabaseResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteBackupResponse{
+	response := &GetDatabaseResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5973,13 +13417,6 @@ func ParseDeleteBackupResponse(rsp *http.Response) (*DeleteBackupResponse, error
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
-		var dest ErrorContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON408 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5992,22 +13429,22 @@ func ParseDeleteBackupResponse(rsp *http.Response) (*DeleteBackupResponse, error
 	return response, nil
 }
 
-// ParseGetBackupResponse parses an HTTP response from a GetBackupWithResponse call
-func ParseGetBackupResponse(rsp *http.Response) (*GetBackupResponse, error) {
+// ParsePatchDatabaseResponse parses an HTTP response from a PatchDatabaseWithResponse call
+func ParsePatchDatabaseResponse(rsp *http.Response) (*PatchDatabaseResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetBackupResponse{
+	response := &PatchDatabaseResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BackupModel
+		var dest DatabaseModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6032,14 +13469,35 @@ func ParseGetBackupResponse(rsp *http.Response) (*GetBackupResponse, error) {
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ErrorContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON422 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorContent
@@ -6053,27 +13511,34 @@ func ParseGetBackupResponse(rsp *http.Response) (*GetBackupResponse, error) {
 	return response, nil
 }
 
-// ParsePatchBackupResponse parses an HTTP response from a PatchBackupWithResponse call
-func ParsePatchBackupResponse(rsp *http.Response) (*PatchBackupResponse, error) {
+// ParseCreateDatabaseResponse parses an HTTP response from a CreateDatabaseWithResponse call
+func ParseCreateDatabaseResponse(rsp *http.Response) (*CreateDatabaseResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchBackupResponse{
+	response := &CreateDatabaseResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BackupModel
+		var dest DatabaseModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DatabaseModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6116,6 +13581,13 @@ func ParsePatchBackupResponse(rsp *http.Response) (*PatchBackupResponse, error)
 		}
 		response.JSON415 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6128,34 +13600,20 @@ func ParsePatchBackupResponse(rsp *http.Response) (*PatchBackupResponse, error)
 	return response, nil
 }
 
-// ParseCreateOrUpdateBackupResponse parses an HTTP response from a CreateOrUpdateBackupWithResponse call
-func ParseCreateOrUpdateBackupResponse(rsp *http.Response) (*CreateOrUpdateBackupResponse, error) {
+// ParseUpdateDbaPasswordResponse parses an HTTP response from a UpdateDbaPasswordWithResponse call
+func ParseUpdateDbaPasswordResponse(rsp *http.Response) (*UpdateDbaPasswordResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateOrUpdateBackupResponse{
+	response := &UpdateDbaPasswordResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BackupModel
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest BackupModel
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6184,6 +13642,13 @@ func ParseCreateOrUpdateBackupResponse(rsp *http.Response) (*CreateOrUpdateBacku
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON408 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest ErrorContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6210,22 +13675,22 @@ func ParseCreateOrUpdateBackupResponse(rsp *http.Response) (*CreateOrUpdateBacku
 	return response, nil
 }
 
-// ParseGetAllDatabasesResponse parses an HTTP response from a GetAllDatabasesWithResponse call
-func ParseGetAllDatabasesResponse(rsp *http.Response) (*GetAllDatabasesResponse, error) {
+// ParseLoginResponse parses an HTTP response from a LoginWithResponse call
+func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllDatabasesResponse{
+	response := &LoginResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ItemList
+		var dest LoginResponseModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6252,6 +13717,27 @@ func ParseGetAllDatabasesResponse(rsp *http.Response) (*GetAllDatabasesResponse,
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6264,15 +13750,15 @@ func ParseGetAllDatabasesResponse(rsp *http.Response) (*GetAllDatabasesResponse,
 	return response, nil
 }
 
-// ParseGetOrganizationDatabasesResponse parses an HTTP response from a GetOrganizationDatabasesWithResponse call
-func ParseGetOrganizationDatabasesResponse(rsp *http.Response) (*GetOrganizationDatabasesResponse, error) {
+// ParseGetAllProjectsResponse parses an HTTP response from a GetAllProjectsWithResponse call
+func ParseGetAllProjectsResponse(rsp *http.Response) (*GetAllProjectsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetOrganizationDatabasesResponse{
+	response := &GetAllProjectsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -6318,15 +13804,15 @@ func ParseGetOrganizationDatabasesResponse(rsp *http.Response) (*GetOrganization
 	return response, nil
 }
 
-// ParseGetDatabasesResponse parses an HTTP response from a GetDatabasesWithResponse call
-func ParseGetDatabasesResponse(rsp *http.Response) (*GetDatabasesResponse, error) {
+// ParseGetProjectsResponse parses an HTTP response from a GetProjectsWithResponse call
+func ParseGetProjectsResponse(rsp *http.Response) (*GetProjectsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabasesResponse{
+	response := &GetProjectsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -6360,13 +13846,6 @@ func ParseGetDatabasesResponse(rsp *http.Response) (*GetDatabasesResponse, error
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6379,15 +13858,15 @@ func ParseGetDatabasesResponse(rsp *http.Response) (*GetDatabasesResponse, error
 	return response, nil
 }
 
-// ParseDeleteDatabaseResponse parses an HTTP response from a DeleteDatabaseWithResponse call
-func ParseDeleteDatabaseResponse(rsp *http.Response) (*DeleteDatabaseResponse, error) {
+// ParseDeleteProjectResponse parses an HTTP response from a DeleteProjectWithResponse call
+func ParseDeleteProjectResponse(rsp *http.Response) (*DeleteProjectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteDatabaseResponse{
+	response := &DeleteProjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -6428,6 +13907,13 @@ func ParseDeleteDatabaseResponse(rsp *http.Response) (*DeleteDatabaseResponse, e
 		}
 		response.JSON408 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6440,22 +13926,22 @@ func ParseDeleteDatabaseResponse(rsp *http.Response) (*DeleteDatabaseResponse, e
 	return response, nil
 }
 
-// ParseGetDatabaseResponse parses an HTTP response from a GetDatabaseWithResponse call
-func ParseGetDatabaseResponse(rsp *http.Response) (*GetDatabaseResponse, error) {
+// ParseGetProjectResponse parses an HTTP response from a GetProjectWithResponse call
+func ParseGetProjectResponse(rsp *http.Response) (*GetProjectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabaseResponse{
+	response := &GetProjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseModel
+		var dest ProjectModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6501,22 +13987,22 @@ func ParseGetDatabaseResponse(rsp *http.Response) (*GetDatabaseResponse, error)
 	return response, nil
 }
 
-// ParsePatchDatabaseResponse parses an HTTP response from a PatchDatabaseWithResponse call
-func ParsePatchDatabaseResponse(rsp *http.Response) (*PatchDatabaseResponse, error) {
+// ParsePatchProjectResponse parses an HTTP response from a PatchProjectWithResponse call
+func ParsePatchProjectResponse(rsp *http.Response) (*PatchProjectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchDatabaseResponse{
+	response := &PatchProjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseModel
+		var dest ProjectModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6564,13 +14050,6 @@ func ParsePatchDatabaseResponse(rsp *http.Response) (*PatchDatabaseResponse, err
 		}
 		response.JSON415 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ErrorContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6583,29 +14062,29 @@ func ParsePatchDatabaseResponse(rsp *http.Response) (*PatchDatabaseResponse, err
 	return response, nil
 }
 
-// ParseCreateDatabaseResponse parses an HTTP response from a CreateDatabaseWithResponse call
-func ParseCreateDatabaseResponse(rsp *http.Response) (*CreateDatabaseResponse, error) {
+// ParseCreateProjectResponse parses an HTTP response from a CreateProjectWithResponse call
+func ParseCreateProjectResponse(rsp *http.Response) (*CreateProjectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateDatabaseResponse{
+	response := &CreateProjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseModel
+		var dest ProjectModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest DatabaseModel
+		var dest ProjectModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6653,88 +14132,6 @@ func ParseCreateDatabaseResponse(rsp *http.Response) (*CreateDatabaseResponse, e
 		}
 		response.JSON415 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ErrorContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseUpdateDbaPasswordResponse parses an HTTP response from a UpdateDbaPasswordWithResponse call
-func ParseUpdateDbaPasswordResponse(rsp *http.Response) (*UpdateDbaPasswordResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &UpdateDbaPasswordResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest ErrorContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 408:
-		var dest ErrorContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON408 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ErrorContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
-		var dest ErrorContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON415 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6747,15 +14144,15 @@ func ParseUpdateDbaPasswordResponse(rsp *http.Response) (*UpdateDbaPasswordRespo
 	return response, nil
 }
 
-// ParseGetAllProjectsResponse parses an HTTP response from a GetAllProjectsWithResponse call
-func ParseGetAllProjectsResponse(rsp *http.Response) (*GetAllProjectsResponse, error) {
+// ParseGetAllUsersResponse parses an HTTP response from a GetAllUsersWithResponse call
+func ParseGetAllUsersResponse(rsp *http.Response) (*GetAllUsersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllProjectsResponse{
+	response := &GetAllUsersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -6801,15 +14198,15 @@ func ParseGetAllProjectsResponse(rsp *http.Response) (*GetAllProjectsResponse, e
 	return response, nil
 }
 
-// ParseGetProjectsResponse parses an HTTP response from a GetProjectsWithResponse call
-func ParseGetProjectsResponse(rsp *http.Response) (*GetProjectsResponse, error) {
+// ParseGetUsersResponse parses an HTTP response from a GetUsersWithResponse call
+func ParseGetUsersResponse(rsp *http.Response) (*GetUsersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectsResponse{
+	response := &GetUsersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -6855,15 +14252,15 @@ func ParseGetProjectsResponse(rsp *http.Response) (*GetProjectsResponse, error)
 	return response, nil
 }
 
-// ParseDeleteProjectResponse parses an HTTP response from a DeleteProjectWithResponse call
-func ParseDeleteProjectResponse(rsp *http.Response) (*DeleteProjectResponse, error) {
+// ParseDeleteUserResponse parses an HTTP response from a DeleteUserWithResponse call
+func ParseDeleteUserResponse(rsp *http.Response) (*DeleteUserResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectResponse{
+	response := &DeleteUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -6904,13 +14301,6 @@ func ParseDeleteProjectResponse(rsp *http.Response) (*DeleteProjectResponse, err
 		}
 		response.JSON408 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ErrorContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorContent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6923,22 +14313,22 @@ func ParseDeleteProjectResponse(rsp *http.Response) (*DeleteProjectResponse, err
 	return response, nil
 }
 
-// ParseGetProjectResponse parses an HTTP response from a GetProjectWithResponse call
-func ParseGetProjectResponse(rsp *http.Response) (*GetProjectResponse, error) {
+// ParseGetUserResponse parses an HTTP response from a GetUserWithResponse call
+func ParseGetUserResponse(rsp *http.Response) (*GetUserResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectResponse{
+	response := &GetUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectModel
+		var dest DbaasUserModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6984,22 +14374,22 @@ func ParseGetProjectResponse(rsp *http.Response) (*GetProjectResponse, error) {
 	return response, nil
 }
 
-// ParsePatchProjectResponse parses an HTTP response from a PatchProjectWithResponse call
-func ParsePatchProjectResponse(rsp *http.Response) (*PatchProjectResponse, error) {
+// ParsePatchUserResponse parses an HTTP response from a PatchUserWithResponse call
+func ParsePatchUserResponse(rsp *http.Response) (*PatchUserResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchProjectResponse{
+	response := &PatchUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectModel
+		var dest DbaasUserModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -7059,29 +14449,29 @@ func ParsePatchProjectResponse(rsp *http.Response) (*PatchProjectResponse, error
 	return response, nil
 }
 
-// ParseCreateProjectResponse parses an HTTP response from a CreateProjectWithResponse call
-func ParseCreateProjectResponse(rsp *http.Response) (*CreateProjectResponse, error) {
+// ParseCreateUserResponse parses an HTTP response from a CreateUserWithResponse call
+func ParseCreateUserResponse(rsp *http.Response) (*CreateUserResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectResponse{
+	response := &CreateUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectModel
+		var dest DbaasUserModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ProjectModel
+		var dest DbaasUserModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
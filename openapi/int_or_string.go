@@ -0,0 +1,38 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package openapi
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// IntOrStringValue is the Go type for the IntOrString schema, which may be
+// serialized as either an integer or a string. The value is stored as a string
+// so that it can be exposed as a Terraform string attribute. Values that are
+// valid integers are serialized as JSON numbers.
+type IntOrStringValue string
+
+func (v IntOrStringValue) MarshalJSON() ([]byte, error) {
+	if n, err := strconv.ParseInt(string(v), 10, 32); err == nil && strconv.FormatInt(n, 10) == string(v) {
+		return []byte(v), nil
+	}
+	return json.Marshal(string(v))
+}
+
+func (v *IntOrStringValue) UnmarshalJSON(data []byte) error {
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err == nil {
+		*v = IntOrStringValue(number)
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	*v = IntOrStringValue(str)
+	return nil
+}
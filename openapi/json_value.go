@@ -0,0 +1,37 @@
+// (C) Copyright 2013-2024 Dassault Systemes SE.  All Rights Reserved.
+//
+// This software is licensed under a BSD 3-Clause License.
+// See the LICENSE file provided with this software.
+
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// JsonValue is the Go type for free-form JSON values, such as those described
+// by the AnyType schema. The value is stored as a JSON-encoded string so that
+// it can be exposed as a Terraform string attribute, and is serialized as the
+// JSON value that it encodes.
+type JsonValue string
+
+func (v JsonValue) MarshalJSON() ([]byte, error) {
+	if v == "" {
+		return []byte("null"), nil
+	}
+	if !json.Valid([]byte(v)) {
+		return nil, fmt.Errorf("Invalid JSON value: %s", v)
+	}
+	return []byte(v), nil
+}
+
+func (v *JsonValue) UnmarshalJSON(data []byte) error {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		return err
+	}
+	*v = JsonValue(buf.String())
+	return nil
+}
@@ -18,173 +18,333 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9+XLcOJIw/ioY/n5/2N11SbZ3pxWxMStb6m7P+tBK8nbEWgoJIlEqjEmCTYCSy249",
-	"1vcC35N9gZMgCbJYpVLpMCYm3CpcTCQSeQCZie9BSJKMpChlNNj5HtBwhhIo/nwNwy9F9iZHkKH3JEIx",
-	"L8xykqGcYSSaRJDBC0iR+BvRMMcZwyQNdoLjGQK6FrAZZIDNELgQQ4ILFJP0kgJGgkGQwK/vUHrJZsHO",
-	"v70YBAlO9c+tQZBBxlDOB/wMh99O+T+T4S+nPwWDgM0zFOwElOU4vQwGwdchgRkehiRClygdoq8sh0MG",
-	"LwWgIZsHOyW4g2AWxtUCNqXRF7voRgyZRygPdrb532w6xBFKGZ5iXsbyAqniFCao1jWGFygWn4ZRhDlS",
-	"YHxQwV05tWfPPu8O/1dN7fPQ/H02Ov3p+T+suueNad8Manj/RFE+jNAUpygCEggAGYPhDEWAEbEKOaKk",
-	"yEO1LiFMwQUCBUURmJIcTHHMkMLp7WAkF/9CIeu3NApfemHMT70sqsBelBdV7JctSH4JU/wNSpS4KNNu",
-	"8WCoswK2RkStUKOjUmwjZdKLUuvds5yIpXIiS1U+GDxpYDWKyt8aO7rERsxWL8SYnjeDIEd/FjhHUbDz",
-	"2WYUtRXRPU7rZH8zUBzU884leSdOMpKzI8GjOAD/f46mwU7w/41LUTVWcmr81morEe2Z7yaY78s25isL",
-	"XCTNawCZWsRcneRnPoOf/6oS6/PVqFVAoeejfujZiJ8uQdJNobqbly5eutxWunDhItnA/6CcthLSlazU",
-	"m0b3GYE/ZigFNEMh/1QEcAogOD/4dHwOuNBClIEMzmMCowHAaYRDyBAtMSzHQYDOSBFHgv9kEWQoGgCY",
-	"RgBTyY8u5qI1nVOGEs6+LguYRwBeQpxSBkKShkWeo5Sp7nS0LMY1RocVXP7bzSCgDLKCLmL+Ur4eibaK",
-	"+bfLbcUFlhbfByTG4fw9phRFsmRZWT4t4ng+/LOAsVwuI9sFR+Rs/3qGwxmAmvavIQWJ+KBcBExBJqDY",
-	"sOjeapfRCaIUXjomvAtmRQJTkCMYwYsYAdVSEyJOL0GEGMRcNl6QQpKknq3a+2uYtAZQz7n8raesSzps",
-	"CquJhPAYt0k3hhMl03stI/oKkyzm39iebL8cTraGk63jyWRH/P9/g0EwJXkCmcQ6GvLRnXi4JMNVkCOg",
-	"OlOjKgRVygySrFKXJNCIqjbLEaQuprbL2f1lDpMEMhyCklva1CFZFB9A7A0+NC+G66QOBaCeu/mpp60K",
-	"XHqsmrFucbOIcbi1/6lg1Gk4b2EZuprzXc7uoiLWopHrlJyvgzCXKOJ0shIWSiA0IuwSjYuyrGOnVBp5",
-	"/fvO9e/tdejf5RbaoIK1lGq+tUnVXGLjB9XQrc25WOWSrK3c0sby9mptq1r7d4EexpdFIqYLzYe6ocEs",
-	"RTEKGckX9TxS7cqOS6jScl0rCvUgoAXNUBpxhbq+mn/MEJuh3AimaU4SgV21lSBfDtPdoPGCkBjBtN+2",
-	"sPvLPVEZUeG6LKuYElWytxrV7ARb7rkNBYP/RZZCfVc0RD//BS8hQ3tKn35nxGWEprCImd6ybmQzAswQ",
-	"AtmFQ+yZlUggC2dC6TYnikQzPL3zKAjFLU+7ubHUihnozvQ3z2oSrquFZWa3tOkwUTo7mUq5UHeK9qpI",
-	"2SDK5TDtCK/XN9FdbdGh9Ld2uVlJC7J2iQ22KbIhVYU3TYDKmjaW1tiQMaTMtu+pW2zxZuUu0ixPiJ9r",
-	"lCOQEmZUdceyYoaSpdhw5cihRCnMczjvq1dSdqZMMwWvpWS66kqNs1nbeffmbF4i9kjhpY/9rDErkCqQ",
-	"/jCNaHvSet2rJnVXCxemq2062FxnJ155O3wz+AWlDxLdHXjuRHA7ZicOzDZap+jrSijl+g/v28UX7her",
-	"HLoWrDqrjKXWrOwwT12t5TFR9DGN51rwriA0lHZrVEP90+iFsqAmKMrS5hFyQzzIxfwdplHcsvAzUScO",
-	"ECqXWyush+x7Jkc006qX6tlVyzsYRqOhLDiIi0vcYqVlok7aTIyABKbwEq1rfnLw+vxMaW1+qryDwBoN",
-	"lXb1ei5lab+D+dYTksZRwTW0FbjVUKH6n13MzwwzkOhw1WiUNOs6DB5nY1GISdqHkdWmLATDvfMsPYEq",
-	"u6qXVhBmyjtYf6Oh1vUOchIVIes808hkm/rZRj83jlvc6ZypD5+pDzfueBwN6nc+jSaVYwv3HZCrT+ud",
-	"0Ht1BUSLJIE5/qZP+TkbbroEbOyy52XrZQ8XTPNj8sl1o2fswXIxMRXXXdLOQ4Zl5ogykiMAgX3htoJ1",
-	"JwY/Y+SssC7xaoXWzUVZ3KGy19vliEFuye62WD/mHMGcYYFwHsaIGo3GLKCxdFBaJMHO52BGijzmTCyC",
-	"WPz3GqEv4o+EpGwm/pojyNucOg75lzd89GTOILXwZZeV6CpLbWz9ex1blWaCcN14ctD0zkkKwE/g/ACl",
-	"EU4vz8EQHFeIJ5MVgBuEMeKDqR5HRRgiFKGo1kc1RBGgvAWlQpLpY054BXEs7l65RlJQpEb7FeK4MdRU",
-	"FJoT0rSgvKfqsYc4OA2QZ5CCC4RSkMD8i7o5iZCEfKAuszEFOJXXfYhyjGtiUFgIBoGZXTAIJGzBINCf",
-	"bFICXxE+yNBCulhz00WuwG2AVGBUBnJjKBiYmVRaO5ezMtlK8yVXUtFoH60YVZRiVNOJq7zhVVM9fjAa",
-	"uj4llY7on8SZfJtL5QU8gJRekzxqkdKq1qjqe693xTneCLyBKSBpPOcMvLyCuJ6hVCp68gpaM3LXncB1",
-	"jhmykdVHjF/AMw1UKbqrhUZc28UdcqzWXVRRlFLM8BWSsPk72nt1UE8gThlKYbrYv/V92dRcyPS74rV0",
-	"jvVdYuIkKZhgf/1pfKkL3+2NXPiWp7h3c9e7CpoejYPmQ0Tehrw2+9+T75W2o78j731H/otED7eafs1J",
-	"sviW3DRd8rpbL0/tqpsJEnCq9Si/wlwiYZQb7cFoA+DtFJAEM4FIZm2aSjehbc4QVxOiUeUUJZ2MaALj",
-	"OGjVeacCHcE4jAvKUD5WA/NxaT8BJuam94f6oVdB/Ow4R5L1tTvz5R1qNdZb7+G8SuJVEq+SeJXEqyRe",
-	"JXlQbnteJbl3leTVnaokC732YB7O8BXaw/TLEf7WdviLvxlhpzqAKxIXCaKOFXojtQhx6qRonhM2TsMc",
-	"yTsj3VsMXF2f7WNcVzZE/OSzk5PRZxk6+Y/nf5lfPz9//uzZ5/96/9vxwf4pfv7X57RIvshfz/+x2qWL",
-	"muBZhOmXMw6gWUpXjV7XZl3HlZyzsSLYY4zyA5jDBDFOcO33NESTuCT5zPSx3FXV3sC1W7vKXhE3IIJz",
-	"mQ4j14XOcueAbq7z4mYQ/IsUeQrj/hSnOtwVxb2a/HbfJKdm6CA5V41GbbOuw1PC2TjrcQOsxOOYwUu9",
-	"IB8Ksvca4EQ4ThAux0bgV5Jbx/p8egNAEQIzxjK6Mx7PiotRRMIvKB+FJBnn47Qg0YX6lzdvcnZDrlpC",
-	"X+M45utsWHuD0ke10OstbqGIJfzL+tuspLOwXOdnn0dnQ61C8T9/XnWB226z2y+xu+6uXza0pnpT1mAi",
-	"babnAhvyYwb/LJDNXGiRZTGWG8zJUEarWX2861n5nYr8rJbbotSu6fCjaTR9GK68Lt2oIaFDeIAS9948",
-	"2H8/RCmHOAIh7zEVWqjgkEf//Q6EMea6GF+qK5Tj6by6YgqdK3iqwLMMJaWLiv5pfFNkQQc7Klvczrui",
-	"6YFw7/4V3a4VZgG0c8VtfCeqThN1b4kFYZ6yAZ0VLCLXaU+oZ5AC02XFwJeyu7o6tcbTl6e6qOOQx25D",
-	"/4z30ygjOG0xrZGqdW2NkKSpMLtX9Fmif8ZnevxyUtVCMzG7uENDrLfr65mhF0r7ZuxqkaxdHRr0xzEA",
-	"wxBlTOJFIgOTlKoh3qhrYtcIF0g4BCiPQW7XpoSBOWKlLqBGeU8iPJ13DpPwJhhF2kmEkSxTHY7UWtc8",
-	"G5QqiKkZze5b+oRUyFc4T1DZQDXf/5pxU8vZHMk6p69JpaHypxDonGF0hQAE0qdCLlGL70kTtt6OHWpE",
-	"aZurIZWhLjWj0nejD9IOCRML/aaUJFRA+e7Ili4U5KIhWbwalnuMIcRgEGiKCgaBIYtgEOgF138Kvxm1",
-	"NE5XmkFgps7/doC/hLdNCWAtU9XijWJPqdm5xx6xJ1UbYCV3H400x1iojk9Hm0WUXFm3tgnr3VxZpZ1g",
-	"FfpsW9yd4FakqYmsDePUEKGhzJ2gNyf6AV2a9vOc5G9IypAUwzU9lkSuvCrcmgjFmg1jdIVigPgogLeW",
-	"h6my/QWiYEauhZCTLcqDVen+L/eWbnMuk7GcgylGcWQ1xilDeZYjhiItJH8/Pj442z88/HiomXLbF/jC",
-	"RkDcbyDAuwE5ewnus3P56/y5dZickZQizjhILrznGAGHv74Z/vLL1kSeBBtInTACSAGUuWeGJveM5GUj",
-	"LaA/fnjz6fBw/8Px2aeDvd3jfT6L3ebRMQihdOHl6BHn2CQH5we7x29+L4+0GRHbfwR2a2fd6m4wRyzn",
-	"1h+cMpSDQqQwOf9t//ic9yQXDGKJnJhvR1beL2obns8YZlk81+p8hChnSCCcwVSeJ2Amv14FrPb9a8xm",
-	"pGAApnPVlZZ3mqqHpESNpk8f/uvDxz8+nB3u//en/aNjvdRSETSdhCtkTuS1CIgKARFMQZF+Sfm2V4MK",
-	"//4BSBCbkWjAEWlmmkE2G4FjzgsUzPp2AECNBj55TGmBwAVi15zZsBIUjiJpno0sAVoSKRc39SUPBkFt",
-	"fkuIvuZoO4GnH0M/wcBGvrInHgWDcFCFBP9hE33FLhJHUnz+TdmxJ8qtdFtiVdxGnLKCK9dW1dEaq8VB",
-	"KjMotQ255UyVtP81g2mEoneYsv2U5fOmPJSXZk6LrrgYcoSCHMWQ4StUp8xPh++aDhvV/aNXpgv0iRP0",
-	"ZurRFUIH0VdMhW+x8VoXoYR8d4rhg/q99Xoup9cVZjhZd5ihVP/t4JoNoeLWEYlbnRGJtYvKCmHU0HS6",
-	"kk4qcXRmiFlOo16qp1Etrymqjcq3DCV8gzp01SKnpOV+WtYByLT1xVAiQ6Kl4RNjKq4wKqKgoCjX27eb",
-	"m1hxRo5cDZgyLjrkN3PEijxFkbg6QZzjaEcG5ZgglV8qYhzVx1GkhC6H6s8C5fPycB+ci0EiKdjl36OT",
-	"YjJ5EYqBxJ/oXNzOyGlJOPS9jFxXOgCEzVB+jSkSTeemgZwvhyXLEUWpyYunOR4t0VrxTlN8bkqKVNzn",
-	"GxSRFH2cBjufmxcZ3V4JTf5847pGb4ZolfeYMU4wawsq+4qTIgFpkVygvFwwybOFEJ/BKyTNTL2KHRQj",
-	"FvhcfPCcC2v0ZwFjLRTqHynHE8uEhXjPCKVY3MxxEBJugZd0W97bPaMIgfMUfWXnz/XpgsK9lC3SYwVG",
-	"VzAN9eKdk+mUInbOazSQXDkqbDVNJGC4gnHBNQNZwmn5GckBZhSc88U6f86VmXO5wc5HdrwtTtmL7XLj",
-	"cP3nUvhVVEQ7B9y9IkJiVgSkyF9Q2U8DgEss8y1T+v5wVaANaSNhRsALitLQnMNKHCrjs+YdBONYjdMk",
-	"glEXc3h5Mwgkrls88ETd7TjTEhjnqhkjDMYt0da8qkGdJdqW+9grp67yT0rSD+pcocEKTDMXc1AlEjy7",
-	"RN9lOPkB/94BZOHsY4Zy4wtZT4Ep3anKe+jx6KdFPJ9kdmApjKKAC9aEXCHxRxZDIedUQUgycdvDl+y0",
-	"U8ET1+EzPnabm+AC4Pi2Ett2kZ+XWYq6TkBUPvSZ01Gp4XXbwKc8saS7bEFgf0VyaM8XkpcHe1oKRVic",
-	"ZUZrDPhf2hGGr4ya2Nu0Y1JFynDcOiNMzWQGAI0uR+B8KzqvzGsrqrpEnJxEPz8/OaE/aSfu//t/Tn9+",
-	"vp5JCeWF7mn0dt4ouqZT2re3vGLE9MxaZKUyVsqMwmiVdlyXVpqt5jtge6Kb++ZKmblztkprKmyt6kAi",
-	"0ccdPLh0tRuJOyhdrx9R2MFmUt9q9uKjDpZzplccxfvSLyP3/v1mENAYulFy9G7XeKxqL0XwBqYpYdZE",
-	"AElDVPXZNZmYqu6qEbq6oxMjPgVz9yf+Nhd/Mez0xJHV/eIBFIXdLhyg5HxOz/5F2LkPP/+XK/v5q7Xg",
-	"HVwadMuWdeXnfcDOvprs676+fOvHV/WbFb6p+M5Xa8e39hRfFtIk+zGcgLceihOwKwDnQfgATx6FD7CD",
-	"H27WBVhchapDMSuYbyNewZO78Qq+1TTW/cJOD6dgS+Zv0id461Y+wRpocSMfx/KIr/R/Uqeb9+UsvN3h",
-	"LNzTl1ZNsNWV1lq1urdr3WfWanoLl9nGKLfxmFWDuR1m7bV1LOpiF9oFA3Q51equt/CptYdYzaV2fa6w",
-	"JIHipvWOHWHX6/zqIu2ml+tyVN10cl19mRo+rv3ore71ugypOZxeW/fjWh1WNQFV3VWX2KFLOrBWw/fX",
-	"7b/68gH7rzaC3FscX3q/Q2UlS61E0XDTU5hA0J2rGVP56oLSsq0Ld9s0HOilEq5YroQktW3JdzOlRVI/",
-	"UoBhgsZSY8Hp5ThCCRlvT7ZfTrYmW1vbk8lkUrOoKgdk4+ffJ4MXN8u8c7u6P0vNkaXhwbLYi2dVvV8t",
-	"5Jk4Nih1rEphqWpZxTd1LataV3sSyfEGJ45b8nyXd6uiUfl2Byc7JrlGryvWxVmZZX5bnYJZ/irzLfPf",
-	"nQ9sqgYqYe6CychWdzcbk7ZXTsf81PNRBR2UVLbQiX4XTEk1u7s5lQmHldFifhujRZV0GS1lE4oYl14L",
-	"T/MOlfg6Uu3NgZ5KhbwAL7LV3aHFJGSWWDE/NVJUQYfhULZQmZwXzEi2ursZKSj0jMxPPSNV0CFydYtV",
-	"2aB+6s1KQG1KrPTTqqzB/awKN/E4OOD84/QPhL5U3rQKjoo0El5ZzeWI4FyLQr5+xgszy0lCmC2eDUVo",
-	"9fw9UaMeF4jKv/5AUar/Pp4Vufrz1xzLP44gK3L1p4TpdMXc9/MzMj3jIFm81i4rOW5Z2sGmas3E9q7i",
-	"8J8wLWDuRqJortHIiaYDjYYMNRrLgX9FF7n68z3Mw1kwCHazHMfiNy/9Z5Ei8R8xwG5xWQhHpCOUMSSc",
-	"cwbBx5AR+dcHcqUL91Ao/1wN2xIbFX5Z55ZdMs00UMh4J+IVjsmeW1xXX1sT2LOiHPRTFOVBHKdgPAVJ",
-	"ETOcWU/kCr9qpatDBiTVrfiyGofiTEJwxshZVci311sHnM4W3Zkq2rrU0Ph7i6awLB65pO6DSN5ubZis",
-	"KRgdDdpx6VBCXixCZtmnhs33bVrKsuiUPKEHPs1+WgtC69pNV4t2lLo0oFeLcGp10tEYx+QdpGyZBxZF",
-	"wJJtGBqrX+hRqBHoIbxly8z5JlA2pQxB40Sjb7OrbmlLHhnLD/Op8o9aUr1RUQr3WlVnnol629XUDqOP",
-	"GuO+LDDmvS6qG/hWefUZ2QfjMnXc8u4mI8oXyjrfkbnfHmU2VhqSrO3knVe1PV0NxTmI8uKxDyJ+Ov3L",
-	"dRqxfdPnFGIx0QlwDcWpX4bcxO+uHB66AY0hbXUO6VhkE+WwhqdjBAyWdwetund0vj6r66VrRIuzKsqX",
-	"n0k/X4yV5qvdOMoL5Pq18cKEUbThnyFX9HRFDqYeWi45mCkoOZgqanCwsly+FLJXPgnSdmcshUNrDJOQ",
-	"HHuvd83jIfqkPSmo8Ewyd/xcqIu7YxWnD7IizwgVt92LPG0sd+cc0XkaduogOs5YeXOgqAIgoEQyCMxM",
-	"0kIYsgLGlWYyWIZL5POBCCbhMxVhM3IS0lFMp++QAbeVQ9MI5yhk4smUKcmVi5Z8+MYJ16hF9pYO9Azm",
-	"l21BG7KuMuDCcFObJvU6N12DxDFOWOSYzY+EmqGOsCkOdwtpCUr1I9gJXu8evX1TfnfGmDhKvUAwR3mz",
-	"9f7u4f5hvfmNSGI5JTLZQ8qgzHOMEhG8GwhHotFRkWUkZ//5IqKjUByaFnmshqA7Y+kjJGoaQlJ6Ir0h",
-	"KctJDA5imCLw7M3BcwDjmFwLH0DJg1SwpQjakc87yq4lU8oRV/PiuYqFg0BnYpPR1EfKleXZ3msIj56D",
-	"hG8xscwoT+jHqaq3wI5IODKgK0cnqUSOcxQjSNEwJQxRWTWMcYhSioZivLHw7mLiKN41x8P9o2Owe/A2",
-	"GATau2cn2B79fTQRHrIZSmGGg53gxWgy2lKxFmKpx1KKCiGquIIiQ6LDV95GwU7wG2K7cWy90Sw9WSxf",
-	"mZ3PfeKNGJEBVZLbc2IIdgIRY6ifn9/R8UsDSUzCZ3LRudjNzaBPHGbl65qZmUhDWurVvEE1dtI4pg6s",
-	"4CzdwjDEGH3FIbnMYTbDIYzjObgUhCZMnlTFMSqeKUJmpKMcUqGG2sOVSoc3GfGmQ+KoeDEL5shW7ikR",
-	"jlQuNKoAVRuNNSXBjbRGRCJR8xR40GGjZSzpNaQApSLwQeYisMLjxBVViTmZjEEYRHz87Yn2yx2Bj5V4",
-	"UNMKUzCReVpVqoBaeFzp7muHx7nQIWMxb0lUliCSa1YLo5WCRVb9h5Iv4mKOQ1yLuIU5Mgtf6dcIpx2M",
-	"RiMhnRy3fYY4GvkeQpIkcEgR36O8UAcyMpKpNDoKEDMZG4pmUK+C659HHz8cQDbjfXJEOQmooN8WCB0d",
-	"jDu2vP0k8RUygYbDUqng325bTgnlctT9pgUjUiGlpRluHEmlXaI5BV8xccFBTYj0+e6HvfMR2BVJv+Rz",
-	"dVK7LScrVnrnJP0JnH9B83MwBB/TeK7o1fi/K1d2cTMvvirOVCx/+C9orsf4D7HotxkJcKbMiKQeMezf",
-	"FsMWEXCWEnbWD8i/9YKy35gVcNv2N+/7q0D+XVOF3DdrowoxHN8hfVYUgpSkw/O0iGMtGZQ9XGJMhhRz",
-	"GV8dvz/dwPLR037f+FvfSUREf2G1OaxCVsvMpoW6RINVqMuSGApUmM5FkkAVbU+Li2EJPbpCqc7FbiJR",
-	"KLeWCZLiVM5I9AdZjq9wjFRiIkG4XNCUw4mJYgoEv28VjJTtGnhcszPxzjenwkYT6YiErrg9mWhVXhmS",
-	"sEzWNv4XlUEE5YBd98Qm1YawEtoTW9jnPhjpzBVS+HNt9+UaYaqkq2uBy46OF8Dg9ArGWMGytXlYIixd",
-	"67KcXOGIM6c8F35gBZfJTJvoYY5EhBiUB28vJy82D6rWETloJMfftHedoG4rnRGKymxFN4Pg1QaXeFc5",
-	"wat8XiQU2zKq2M7C+LGs5s+nVbv48ynfOdIffR7sBJzMG2eYnJaxTnglzri4SioOiHTWHGOmnXJzv8Ay",
-	"IYAKpRavm3Koajbd+LvtEXbTZeItsu8E+1Bc0hVHaB87yPuOJW0Pby56c9Gbi95c9OaiNxe9uejNRW8u",
-	"enPRm4veXPTmYpu5CFNQTxCzdpNx/F062dzI6+kYydjYqvkoAveQZUHO79x+dAyX6S/f0hAtrRqKQpJK",
-	"tfcaYmbyi5jZ85oLLrJTGHOSHIAijTlBCnOlFBoqSNGkruGErAZtYbUMJ4gU7EhCUGG1xq1w4ggaSHCK",
-	"kyIRlQ0jpcmTX7q9Dqr+VXzryaX3XPPH4JovJWFsDNYqvRkFRngNS3j+fo/waCSqPWB7PJdbXG3YpyBz",
-	"JDvn4qWep92w2KUFzaDPweNjERt3qdva6FDhcn1pVOS/9hzac+h74NCPnef9hti6GV4GWThrsjyRIPqx",
-	"Mj1Biq+JzFjUsdhDMfmf9bqrZAKCWQo6KRH/Rj7Mo/A9FZ9IBWgqr3Tw+SQF4Dv/B4CTgGQnwQ44CWAU",
-	"nQQDXSpOf0T52AxhVcvDS1H/nyKm7CTgVTcn6Skn3q0qSEeItQchy3hdkTZjRQBNkOtYDu2C86UN3nYN",
-	"vIJmKI00RKsDQuVAKHJBwMnBBuJFFYhDRIsE9YZBJitfCEb5wRubFE18wKIM47Xk6/XQgJuqd7TYqA9B",
-	"lIvNIiQBLBPnNeJcvGT3kv1ebK9f7hEe+RxKLRuueoKsSNHXDIW8xGQSern1aoN6SIIiDAHnMfJRG3l3",
-	"f94hDdXTIeri8iloTjLiaO3KU+GwFkWCMfTUdadFCpMMX6lukymxg+rUAUXzfFgLaSGfTwJLVRKqUUUf",
-	"OTFxZbzWSHQR5KYUizBBRmbfOBSpJUC1knKuH8gye1UV3O2VwOV4PXq3uxhSpWl2AhpDygs+n4gUvSfB",
-	"qQ1fTedSO00F/3LAYGOr1UCy199eNAUTp2hZrA6VTY38SAXWPCOy7ZbW1Aarz9tJRapHLZe8+uj2i+0t",
-	"3u6mphyuoIE9QBWweZqjhJ2g0zVqWreASCWK83qo10PvR++7QOKNYYDZg9NTFaQ6lyLJH62y+vQ0VCXX",
-	"zZo4xOYdXpyP9RnJQudr+mtOksd/f+4dub0jt3fk9o7c3pHbO3J7R27vyO0dub0j91ocub0Dtz+G8I4u",
-	"63Uop4DBLyjVj2Nu0i42p+pdlvF7xcX3TGNvGXvL2FvG3jL2lrG3jL1l7C1jbxl7y/jHtYwt1xRvG3vb",
-	"2NvGa7GNy13V58mBWxjJ/bIu+3TL3rj0xqU3Lr1x6Y1Lb1x649Ibl9649Neu3rT0ebP6m3KGmHUSp7ZE",
-	"yz0NtyVSK3+0GrYadD6/srcPvX3o7UNvH3r70NuH3j709qG3D7196O1Dbx/eg324KLPyajbi+LtKctFp",
-	"LR7INpsyFF3uriYVhzc5vcnpTU5vcnqT05uc3uT0Jqc3Ob3J6U1Ob3J6k3P9JqeVAXG9xub4u/5gjwdg",
-	"H5PB6RhJz9Tbrt529bart1297eptV2+7etvV267edvW2q7ddve26pqxB0mS1zK3+NusgyAhd8LrDD2iF",
-	"3t0bEUItkQph84UAR6J/wQy4RiEz74uXoKx3E5bMvi9hWSL7/rpz3ffJcu/T2/vY+Y3Ezlfy2uts8Tqv",
-	"feVw8MFkuHeC7LPaP5Cs9jAFJB1GKIHmScC7PEEef5eNe78H/2OeJzuGMmvjn6df0/P0/l16L7Q3IgEf",
-	"zIP0/iX6VUTcorfnvYhaXUTd/VP4fc03//q9lwMblwNP89n7ZY/zFj507znsLTns+t/d340idWvJjQQN",
-	"1oovyctDxLF1cOh6er9ykOh6eP9QvBKvwJrmJOkLWNfz8k3YnuIr87d5X94LTS80N2s83f85pn9K/jE9",
-	"Jb+sQtL+ePzHXH7E6yUb1UsWKCPNl80bgr/fg+YKubLGevVdVWuM6TfPE+J4Cn17sv1ysjXZmoj/db2I",
-	"3n5R2vcl84YK9DbJSO7SycEMppHwcqlhBIseR+I7Fehkv99FtxJVQ4OVIZ//0DnbsvtBXFxiBThKLlAU",
-	"oWgUZqO0INHFKCTJLW6G7/FF9r6HCnf6CLu/mPZq2qO/mB4Akj+e19qds/SPtT+Gx9qXvtVWCR7HIUxh",
-	"Ps9JHJOCcXnSXjn+ztWAm642DCVZDBmivRq5B9Rb6c+CMEg7K90DzFCcTBFkRd6Aw65yd85JjNpmUalz",
-	"d+dLjkPEsFCZW6uqnXu99bcbxx3P/PkQLR+i5UO0fIiWD9HyIVo+RMuHaPkQLR+i5Z/T8ycvPkir4/k6",
-	"5RzYfOugNMi6jWjTbsX3Djb3bru3D7196O1Dbx96+9Dbh94+9Pahtw+9fejtQ28fevuw2z5sf+vgtjZi",
-	"v/cONmch+qcOvK3pbU1va3pb09ua3tb0tqa3Nb2t6W1Nb2t6L3CkHx94giHuLVav47mF9dm7tScXuvNk",
-	"7ZVhXP7lBZ/g6tiOtfAprjzjv1tYO/NN/v1+QPFprlyZlfuIp8HCY9YfNbPyHSnzGq2dsZwVwvZ5qjwz",
-	"vwdm/tQyVa3MILuyVf3oTHL9aabezGB6aREmw+JgacU8U7y3O7dUujWiCYzjzvxSb9MwR2J7YPoFUPwN",
-	"CZPCAAfzcIavEF0dwCznVMUwomM12B6mX47wN+QGe2sy+Q1XYd6uwnyEmIU9cYOBc7Q6hAnk9kgK09AJ",
-	"khqIl8kv0bc6bYXKnCUgLcF9UQV3D1NxDmzR4J0Diqn6qsjDIbJwNOF8WYVzP10OzK4MYxVIn1ZysV7q",
-	"TWt6MYNdr+14bWfDpusv9wPK008y9nJ7+wGh1koyci3q0dcQoQhAQ5lAJFJ4ounRVteC21OkeSV4nW8w",
-	"OTQMlbhLKrNCeZ1o5VXnKbuAB5DSa5Kr1GKhUKPfMjvRmVI0Kzm/Gkon7/xK6JhGc/kXKfIUxrU2lhrq",
-	"yktWm430XdDOSyUwzVl2TEV9aduZC64db7dLAVfmeaukf7MWY6u2GMugWunz3bjeKnX+rjxxLyavJi8U",
-	"lnpnVtObV66XxOW95Flb/mDsjnKtLQ+Iz7fmtdZN6zOujGvuO/lHknCte5pPKuXaw9CIHaj9kZRjR964",
-	"FZXjpV08xpaOw8dzP28qZfGe1dT7e/wI/h5rMifepphhTtd7r3dBpkhI0XpTR1YulUrjlj/2bE1cq70w",
-	"v0Sqmfy70sptCxwiOk9DEJJ0ii+LHEUVmBYAA0NWwNgFSy6GLY9wXcbB/6AcT+fGY3SJ77qQsJxq3djA",
-	"y+rVTdKvrKWlAwNaCLVlWsTxXCrE2z1GwCbhR5YTofaQKbhAOL20lWuv03qd9uk6ETW2FIdGb6tFjkSb",
-	"VJJ304bOq0LMQlKkDOVqt/q0wxs8W2V1Cmp9576vJomuxNxkyuKMxDjEJuuus66ZaKlv0/F3UTF39nF+",
-	"s8/HOqN6l2hd9YleraP9+rA1gp1d2FXcOcte0ctLtW+dqWpA3aWdULY0agOSCw/qKOr8hqvF+Dsv1Q1n",
-	"CMZs9k39isklV6XF3yRDKcyw+mVNtCvb84Fu5pM9+wBrH2DtA6x9gLUPsPYB1j7A2gdY+wDruw+w1lqq",
-	"j6/2ubwebVSzIeLWVM/GGOs+qGg3Q1tNuHb7zed29uagNwe9OejNQW8OenPQm4PeHPTmoDcHvTnozcEN",
-	"moPtmZ1vZxJW8zp3J7c6MA6Hjze3s09IZVzRfT4q70p2X4kIN+1JZtP8MtmoNh1poeGsRANoYK0QgBnf",
-	"u8ZT5mmmzWomdewh6gaLDjgfi/S6Sy1aoaI7EYS1Y3yaKy8jfLLa22a5WpGfdeW4eow87c7yUmlKurO0",
-	"VJMeaamOECsBeXgZnrbdGZ7KxXwYCZ5eOBM89YXyB83v1Euqt6V30rj1Qt4L+c0agg/AwHqSuZ2eYIak",
-	"VTWo9vxIT1mB6pfTqClUVcQtjaHOrXPlSq0zGaUwJW1xxWr1WkdfkPinzOzTzPqzGLBqzp/1JeRpStgH",
-	"Jt0tm/2OMvAsC4bPv+P1inuS5g8qhY4T0ieZQecJJp9ZSe+4GQSV0M2OmM0FwZpiMnyaUjUp8jjYCWaM",
-	"ZXRnPFYSfpQWJLoYhSQJbk5v/l8AAAD//8DbqyoU5QEA",
+	"H4sIAAAAAAAC/+y9i3LcOJIo+isY3hsxdk+p9LB7zrYiOnbVlt3tWT+0ktwTsS5HFUSiVByRAJsAJVd7",
+	"9Fn3B+6XncCTIAmyWFTpaexOtFV4MZFIJDITicxvQUjSjGCEGQ32vwU0XKAUij8PkoRc8T+ynGQoZzES",
+	"xTmipMhDxP+OEA3zOGMxwcF+cLpAQNcCkgOKGCBzU0QBI+A8h5gBGIaI8t9j8HYO2AKBS5gUaILP0HmM",
+	"KbiK2QJAQBNIF+DZbHv2fMRb4bIpiBAmDFEAy09mkC3GE/yRLVB+FVM0cjanIckQiOVYixjlMA8XSw4o",
+	"Lzj8BcKTCbZgXkAG2CKmGui8SBBAmOVLORlazmakR5mTPJ3g2aTY2XkRkvwc4vhPyLEkStBsBFrrtmVF",
+	"lpN/oZCZ9iSf4LX6qLIIMngGKVIDjcFbDFDMMQRCyFGUwRymiKE8/hNFE8xxCCg6TzlB2NMBs2/2h69n",
+	"fIVn3wqK8usZSOESwCxDMJ9ggTGYS1pILlEErvTS5SRBgKE0SyBDgOOU0vgco4iTBgR8sHEwCjLIGMo5",
+	"ST3bnkxOfvj3s89w688v/D87Wz99+eHfk8m3aslkcv3vyeSH58+2ezd9/m1ntHf9PBgFbJmhYD+gLI/x",
+	"eTAKvm4RmMVbIYnQOcJb6CvL4RaD54L8Q7YM9stNMAoWYVItYHMaXdhF12LIPEJ5sL/D/2bzLQxTVGtD",
+	"E+jeUyfvDjh+QoIpy2GM7e1zyinT1DBAcCJWIonFfptgRRQUQBxZWzEkmMGYY57vtRgD3W4EaBEuAKRA",
+	"kw6dYN73DIYXRUbHwxDGJ6dxJf/WaOK/bAztVjGkqi9RfubGDq9xcBbJQ9wbdwSuFnG4ACnM6AQzAn47",
+	"PT0COfqjQJSBFLEFiThtgjnhPJDuTzD//y0wyxGMZvvg19en4udVHjM02wdHn05H4OjjiSyNUIJE8eHr",
+	"d69PX8vOM5gkM8OGFFdBtY/yLVFgs5wo4ltPTGKCG7PgK4FwkQb7nwMOVzAKBDzBKJAQBKMAJknwZdCC",
+	"CYTrFVM/9JKJn/aa7VXXTNZfjwI+uzhHkQTR7BBRX4JFzjjl8QEPMEyWNKbHBW4ePAhHp3HqOHd0L4Bw",
+	"BFicCs7CkQt1BT/jEsSbi3qOOc7TIAv2gwgytMVLK4xnuVwut96/34qiv57+9bff9tN0n9Lff3cS/znZ",
+	"GoBghKOp+qxEslWgEW2KOliI3SZFlMLzlqN5UaQQA04p8CxBQLUFMY7iELIYn4MIMRgnFMAzUrAqBvMC",
+	"D9z5GiY9zfK3nqUu6eACVhNZ4pogr9FHlgZ8INDiIxpi9UODK352UL+uzxEtEtYqJhUJqwOrsax39clF",
+	"nGWIb+w3ME7EHydFGCIUib+PEI74jL4MPcI4eNYBJn9axxcvsCf6onF4qRaUwZyt2JyiTWV7xjhmMUxk",
+	"mZERbGSAK0gnOEM5363qrOKkCTFAeU7yB7GRxcSqW7lSZA66stBG6svaeWe1qnFQRYcK7W7+uTwVZd8C",
+	"GEUxXwKYHFW4KMHo4zzY//yt0ftbDSNWCS7SM5TbJWeEJAji4PrLdR2OKib/QQn+nYvgHL5fhAzxKkeQ",
+	"ofckQkmTy2upw71vdG15gEqxBJyhhOBzfvAHoyCFX98hfM4Wwf7fX4yCNMb6565NGlW5cBijMODqpbcK",
+	"9MKbIhfTiCOEWTyPeRnLC1SlBrtrAs9QQrvW1pKbn30+2PpfNbXPW+bv6fjLD8//06pryr/XoxreP1GU",
+	"b0VoLuQRCQSAjMFwIeV2Zqt9Yl1CiMEZ4uK8FGDmccKQwunNYLRIbOXSKHzphTE/9bKogg4GV7awVR83",
+	"ZdotHgx1VsDWiKgVanRUil3yRjel1rsrfcKNLFX5YPCkgdUoKn9r7OgSl4zSjRjTs8bNLUZRWxHdw8Xh",
+	"JQf1vHNN3hmnGcnZiTFc/b85mgf7wf+zXVq/tpXpa/ut1VYi2jPfu2C+L9uYbz+JXxJzdZKf+Qz+9u8q",
+	"sT6/A5XgRS8K1d386eJPl5ueLiNjYPkd5bSVkC5lpd40us8Y/JPrXzRDIf9UxO3jEMyOPp3OjIkqg8uE",
+	"wGikLQZVMxYfBwG6IEUSCf6TRZChaCTsjjGV/OhsKVrTJWUoFUa7AuYRgOcwxpRxo2RY5DnCTHVfX33X",
+	"GN2q4PLvUkdlBV3F/OX5eiLaKubffm4rLrD28X1Ekjhcvo8pRZEsWfcsnxdJstz6o4CJXC7dWnJEzval",
+	"hRNq2r+CFKQxNYsQU5AJKO746N5tP6NbjVcHg0xXerZq729g0sMsWS9aLVkSQrfZhC+ysIvI25Q+y4i+",
+	"Qm7lDPaDvZ29l1s7u1s7u6c7O/vif//bZinZlBVEQlU1g1TLDJKs0g67Zq1ZjiB1MbUDzu7Pc5imkMUh",
+	"KLmlTR2SRfEBxN7gQ/NiuEnqUACW9jT1s7SniYIOw6Fucb2Kcbil/7lg1DhctrAMXc35Lmd3Eb9JkAig",
+	"ADLO10GYSxRxOhmEhRIIjQi7ROOiLOvYKZVGXv6+dfl7bxPyd7mF7lDAWks0371L0Vxi4zuV0K3NuVrk",
+	"kqyt3NJG8/ZibatY+x8CPQxhiYluNB/rhgazFCUoZCRf1fNEtSs7riFKy3WtCNSjgBY0QzhCUXM1/7lA",
+	"wjtFH0zznKQCu2orQb4cpvuofh/R78bG6q8ubOwRFa7LsooqUbuuKRvV9AT73HMrCgb/qzSF+q5oHP38",
+	"FzyHDB0qefqdOS4jNIfiGlJuWTeyGQFmCIHswnHsmZVIIQsXQug2FkWiGZ7lYSJuedrVjbVWzEA31d+c",
+	"1k64rhaWmt3SpkNF6exkKuVC3Sraq0fKHaJcDtOO8Hp9E93VFh1Cf2uX60FSkLVLbLBNkQ2pKrxuAlTW",
+	"tLG0xoZMIGW2fk/dxxZvVu4izfLE8XOFcgQwYUZUdyxrzFC6Fhu2QQpKlMI8h8u+ciVlU6WaKXgtIdNV",
+	"V0qczdrOuzdn8xKxJwovffRnjVmBVIH0h6lE25PW615VqbtauDBdbdPB5jo78cqb4ZvBC4QfJLo78NyJ",
+	"4HbM7jgw22iN0ddBKIWcLaCvnXzhfrHKoWvBqrPKaGroaxdW615WjtbSTBR9xMlSH7wDDg0l3Vq+PPKn",
+	"5cfDC64bfjuqtGlCbhwPcjF/gzhK2pz1RJ1y/7Qutwash+w7lSOaadVL9eyq5R0Mo9FQFhwlxXncoqVl",
+	"ok7qTIyAFGJuxd3Q/OTg9fmZ0tr8VHkHgTUaKunql6U8S/sZ5lstJA1TATftlgLcMFSo/tOz5dQwA4kO",
+	"V41GSbOuQ+FxNhaFMcF9GFltyuJguHeepSdQZVf10grCTHkH62801LLeUU6iImSdNo1MtqnbNvq5cdzg",
+	"TmeqPjxVH27c8Tga1O98Gk0qZgv3HZCrT+ud0HtZAWiRpjCP/9RWfsoga7oE3Nllz8vWyx5+MC1PySfX",
+	"jZ7RB8vFjKm47pJ6HjIsM0eUkRwBCOwLtwHanRh8ysi0oPYjlkqhdXNRFnf6A1fb5Ug+MTlo0X6MHcHY",
+	"sEC4DBNEjURjFtBoOto1ekGKPOFMLIKx+PcKoQvxR0owW4i/lgjyNl8cRv71FR89mSmkFr7sshJdZamN",
+	"rf9Tx1almSBcN54cNL0/wQD8AGbKBXwGtsBphXgyWWG9fFA9jAN5rY9qiCL+/idElIqTTJs54SWME3im",
+	"JJKCIjWa9EuvDTUXhbprgQvKe6oeh4iD0wB5ASk4QwiDFOYX6uZEvGKJCdbPdWIK5EOl8xxRavnJKyzU",
+	"3OONz7z+pNtRng+yZSFdrLnpsh/cFEgFxn6wEkOWS/9+sGo5K5OtNF9zJYPrXvQv6dMWimu+7azKG35s",
+	"iscPRkJ/BTHMWwS4kOB5fF7k8j5Hy9+h6MHfLyak0GZ8QFkOGTpfBvXrDv3E8RjNV5lnTq2mdROyPcyX",
+	"QZiScJcCjf5pJBlZUMNUWSoxdSyn3eZ1auOw9jP4jXtubBnPDauyflczUGixvmbklEqZEU2s0i6PlGqz",
+	"fnef1hyq13m3fZm5s9Zlpr9Ka71K2+NHcIbCVdu1siFOeIfe92HVrooZuZ/4CEhct0LNz7slBrlKIbQ3",
+	"WpWHNXhWxqUx/gdMEvVCqGs2+qnR9ZeRS3fhg/Hl40+Rl9pRgsH8HLHywmIEIAUQ/OPk44cthPlyRUDO",
+	"ddzTvi9gNqZ99ctY9cXvukFfFyo8aP678vq01nyN+1OJePU9vjRTfaMqiQdl3GRE5vN3cRo7fFpP1J6T",
+	"r+bkWywZWIHlvPQMzblOEKEwgbn0a+KDin0lxGgJwATr4wtSJYGMwaG8pxIvpvd2Ku/pYsxe7JW7K8YM",
+	"naO87wmNMmG2J/P5NBHTKk9rR1V5cjcqOxQOV+vahtI0UV/tYcep2JdmIvKHAV0xgwqAqsy19VtF/YL2",
+	"3LEhwdIVyjHYK1MHFiSJJOmUHVoEm3HfS6UqSQugp+Unh2lY1nSMvGIXGZml8p1W00ullTo0ThjKuDxe",
+	"5KhlAezdxQ+bOImVJCi7gZAUmJXoU4cRp8QxeMsAf6mfI4oYgHOGclFhSe0b22Dqw1OxBeZ6SgZvLbUG",
+	"hc76DqmotYOF2Lc4Ql/byBplXChAX5WWpIKBCG7EGZQcJVmC20KR+LgbP7rKiRxZ2WEmdrdOIGUfzyjK",
+	"L1H0SmgUrxYovKBF6kbQyW8HuyBUTQT1KeRU1REhIqnQNfwTE1yjUsi4zsBEWB1YDhguID4X764jxFAo",
+	"pK7qEgiqZTBnKBr4ZF5ccxE156mEe6ohqN6rdbSqXLG1tlt1b9zVkTc4yklKGIre5CRdh2x5X5Cpzkqc",
+	"oRsjWAG6Hn3K/T5qZNvRoII3V5POl1btfWxsncr5OnAlK0TUFbO9S5o7Q1woMWhTIr6NS8k/+x4+7xww",
+	"DXdmMBNXy9mC7bLWjWpWAtJmA2ntoMn1V4RR3uFqem7qge6isRkSzPhuRrl0GEoRgxFkcFz2qdPp318O",
+	"pFOzu8qxDdLcdRplrtqOuyZn84dkQqopBd5A4g0k3kDi2Bg3MJSYITZgMKmAM9RwYuLXNfQxHbmmIwhO",
+	"GcpGqgfoEuVLdQryL651DlaUMA2VsC/o7w07Fs089CazCjRxQOsLbSqY3YZexNlhLK45HE7fPNARiFR1",
+	"w0w0Br8sgXKoBdASLEpJjACYJBNs3SjqvkNdxC/ibKohKo+JWqk5LSrlHcyz0ZAvuEt9r5KcaGViwRkK",
+	"7E0pJ+Irg4hBAmgbb2jNXtP5ikg1qO1aWXqPNpgaV1nbFtPOBDZhlOFSTxwiwGKU915jNzd46KaZhyHN",
+	"6WcTMjLVpyzqEObO4BGk9IrkUYvbjqo1q3n4y4GMbApeQSwDdJ4hS04Qkc+kp5Cw3WrPDtfBLQI82sjq",
+	"IwGewakGqhQBq4VGBrSLO1TGWndRRRGmMYsvkYTNP9q814hVKeQ6HYZ4dcCb92VT80Krn1hvOSFt7lVj",
+	"nKYF4wfkGjQ+KF7j7b4ALZ913M7jzyFoejQRWx4i8u4ojEv/h7OHpTOpfzTbW5H9SaKHkRxx8+/qZ7Om",
+	"6ZrvX/Xy1N6+MkECToHTEvuM9GCkAW7SJ2nMjPFeb5pKN+F+tkB5rAz5pVs13hnTFCZJ0OoENxfoCLbD",
+	"pKAM5dtqYD4u7XeAibnp/aF+6FUQPzuMfbLerd6vEWFHY731YZ4XSbxI4kUSL5J4kcSLJA8qjocXSe5d",
+	"JPnxVkWSlWE8YB4u4kt0GNOLk/jPttcg8Z/msFMdwCVJihRRxwq9klKEsDopmueEHeMwR/IRme4tBq6u",
+	"z95pXBc2REDVZ5PJ+LOMpfqfz/9tfv3t+fNnzz7/9/tfT49ef4mf//szLtIL+ev5fw67vVQT5CbziykH",
+	"0Cylq0ava7Ou65bC1VgR7GmM8iOdIIl2PNwimsQlyZukSnb8GrU34tozvspeERcYiNodnDcY69kB3Vzn",
+	"xfUo+BcpcgyT/hSnOtwWxf248+t9k5yaoYPkXDUatc26jlsgZ+Osx5NQdTxuM3iuF+RDQQ5/AXEqXlIT",
+	"fo6NwRuSW+98+PRGgCIEFoxldH97e1GcjSMSXqB8HJJ0O9/GBYnO1H958yZnN+SqT+irOEn4OhvW3qD0",
+	"Wiavz7tcQ5FJuay/zUo6C8t1fvZ5PN3SIhT/829DF7jteWv7q9aux6wvG1JTvSlrMJE21XOFDvkxg38U",
+	"yGYutBCZvsQGczKU8TCtj3edlt+pnJ/VcvsotWs6rgQbTR9GbB99Sv9PQRj0njzek+d79+SpbIj1PHiq",
+	"XYd57jQ/399jx/DCP3jnxh39AuZRFx/uzA6B2cf8pIVDC4Bk1tMIUT5TwL8FxNsUKSwhGC7EhiqzMQ5k",
+	"0mIWeveoH3rFxc8OoVfXi2SoK71HRKOBbHqou4aLhHq4aaxY+QRS9hrPSR6iluv7GEsfWU5NMpi4nC0F",
+	"BBvv5pjK8csX4UgNOp7gt/JJQ4JgXu5y2dzy0pX+X9B6o1135l/LH9pMargjtJ5C1QHaKq04PiPrg52R",
+	"r+yGfR2decfSxflRuTDv9nBhHgX8AOjiQBvgTA2G2kSzPl8MphlhMAGFiKyiNlSVVQkPRplCOZrg85wU",
+	"2UDuJTCgUa5+aByLnx0SpKx/WK5DnfG+QniEWl7+HL1+b96+hryHOMtkdIaT/3kHwiTmq8zlg0uUx/Nl",
+	"VdBXUviAiEdwmqHUigygfpaRAURB15sw0+JmUXqakWzuPU5Pd4geswA6SM9NYvBUg+/Uo+6sSBcgG9BF",
+	"wSJyhXtCzc8s02UY5FZ3tW2s8fTG0UVdD3itNvSP5DWOMhLjlhsZpGpdWyMkGIvbmoGxr+gfyVSPX06q",
+	"WmgmZhd3nH71dn0j/OiF0jF+DrQlR4fMadAfxwAMQ5QxiReJjJhgqoZ4pbwLXSPI11k68hxXUDBhYIlY",
+	"aUJSo7wnUTxfdg6T8iYxilSPE0ayTHU4UWtdi5BTejWbVPVW3zK2UIV8hchFZQPV/PXXLM5bmiNZ54xZ",
+	"VGmo4vIIdC5idIlEUnqhzoslaolh1IStd4AgNaK80lFDyh9IGtTKGEB9kHZMmFjoV+VJQgWU707s04WC",
+	"XDQkq1fDCrNkCDEYBZqiglFgyCIYBXrB9Z/iWFdL4wzJNArM1PnfDvDXiNpUArgfrLlR7CntB27i7twj",
+	"9qT2gxtShYW0/aCNnOuxpdai5Mq67Qfdu7mySvvBEPpsW9z94EakqYlsP+jkETZl7ge9OdF3GBrr8AxC",
+	"eiBU0uMiKb2ommdWztMHSesLJyKxr2h5IVRQoYvVLjiThFw1h3sXU2EzUUOIkRGWwU3Ua3eu9YHZpNjZ",
+	"eRFeovxM/IX2ZYFxnNImu1xWf1b1J+8OZMGXmbTSKbC1NY+qB0Tkyla5NxA0UI8pF0P/0mshf3ddTeoG",
+	"EcLLu0NbB5IEIJvFkRpS27PxsmLIxstuCzavH2aYklibcqyVK1QpM+tkldY2Tq1K7B7u6tfjIQk0m2yl",
+	"NdW1J/3zikeQE0sxwe87FRZHwneZAWu9Z2KKVjbx4qvx2svx0qvPK6/VL7z8NVtnztmcJIi2H9yiWsdh",
+	"zfua+4+JdQasf95KmIzVSf3Sc5C/u4I8ywa1yzzrMHNnu3Le8OnT0p+Q/oT0J+T3d0L606P19PjRnx4r",
+	"To/XeU7yVwQzhFnz7OBzcMRh4e5y0lVkK0GXKAGIjwJ4a0kasv0ZomBBrsTSyxYlmciEN9IKqNvMZPrx",
+	"GZjHKImsxjFmKM9yxFCkzfm/nZ4eTV8fH3881ubjti+cQU6BRGryvJv2dRDgPpvJX7Pn1tbICKaIGwRI",
+	"LuLFMwKO37za+umn3R1J1wZSJ4wyHu+i6sgmyXisrxI+fnj16fj49YfT6aejw4PT13wWB82NAEIoNpBA",
+	"j9iVJAezo4PTV7+VG5QRYagcg4PazlXHoYxwq4PlFCJp9+zX16cz3pOcMWhiITLeyxypmmfwGYsIxPri",
+	"UXvlqBgyjICYya9XAat9/ypmCxE4Fy9VV1oe46qHpESNpk8f/vvDx39+mB6//p9Pr09O9VLLKyvTSQT/",
+	"z4l89wOiQkAEMSjwBeYGSjWoyGgzAiliCxKNOCLNTDPIFmNwqqL8yuWUvA5AjQY++ZjSAoEzxK4QkjhT",
+	"oHAUyYvksWXqL4mUG8brSx6Mgtr81jDSN0fbDzz9GPoJRjby94PHwyAcVCHBf9hEXxFjhCTP5988Ow5F",
+	"ufIJMweD+7S3HC1bPNcaq8VByhGkBHcNuXvt8ux5/TWDOEIRlwxeY5Yvm+ehFAmcd8/F2RZHKMhRArly",
+	"X6fMT8fvmjpKdf90OzZr3LpAf4Mg09GYqxCv5448Du7OA7km9fN/aQbDDmhFdYsEK3K8ail2ZEeVnuCy",
+	"b0wBpLRIB4fnLaG0p6tL7DnLsg6re6VRji5jt/B+rGr0vH9DSQrmcr0t4VrczVZbtrxgWGe6BqzSvcYU",
+	"lB42qqjTyUa3cbkvu+TSN1wEPLFSAzRJQkmJqo3ZULLA3D7SxvWZeB32+muWI0rdEcb46InSD2pfUcCn",
+	"CDOqb52hCGieZoSqQwrMDj4cznp7nmpQhXeriDwoXAOn4tP6y9P3dbAHaR5i8lNkDWNcvhw1xvmrUdex",
+	"o52NRaFY1Ju83XEsu70g8lxNYcajgeVIeolfoOXWJUzEk584F0G6eZdLmPAzTB6aKBED8PWe1cljppaU",
+	"gwkZyWfCN52RCZ79/PNsLATSKhDt5DDAmCSxKUmhtlamsLpMqriD9VTbDbv4E72nehkMZI1iDVutonYD",
+	"2KzlfE4da/4ZkX9G9L0/I7K2w3qPiOyOw54Q1T/d/wGRLas0Q3wuYM5ekTSDLD6Lk5i1pJITg4jGhkRC",
+	"uxdfG8pyGFsJRewPDxR9xBenlS+Vbt7OOr3ArtquOJ7u5iST52O3O3JFGowp0L0EbUcEUSEYozRm4pjL",
+	"c5JPcGytjkQsyRt5coVXl4YqQUMjAJtpGJt4WWDs4bqo6wWI1Sbr9Qy4a1McVZ7Yuq6VVT0Q108qsGyO",
+	"5ihHOJRMSMgUVD4TV0/vR2VHqXVEYDYnZAbiSmdlQZHuQ/K/YJwiBseiOx3PCQGiHBlXIitW8ZBXvs1H",
+	"yM73x/2eHldbKcLpsZPlI/s6oa3azBO8gd2sPtqyn9tq6y/X2/f0C/f79UYHSTSbyg8nECOHNFniOrLB",
+	"CVFVNU+hwLWwPRjSFKOUq9szfZyak0am+amxpwpqEp8uvdv3kM3TsMdryM5jbBFTRqStqGshFyhJ1RBy",
+	"8OlvquMDcVl9m2YkZydC5moRuKWT8m/CbOrGG/oaUxF/2aT65W2FgVcM30NOHRLAS35tKr9mUFAv1Zio",
+	"lneczI2GsuAoKc7jtmzzos7YIqSvu52R/I5QIeGoo8KU1lChyjuO31rDmgRZIYwamoaFp5c4mhrtRk6j",
+	"XqqnUS2vkXij0n7h6cgxAdQTWC4UycWQPDMYBQQjxbNXZaq6HjVsGc67nXOypQotqH4Xn+OQMpRya7Tj",
+	"YrbIaZtdTNYByPSjCIZSaZWQ7xG4ZYurP5V7D+E8ofSpbtO5ZdRqt5rJb+aIFTnmCTLfzvl7C4i1hqc0",
+	"NmnMoeAKUv1xIx/J994isYeRxmZikEjeYsm/x1KAEgMpaUmYf+W0JBw6yo6kQDoChAvQVzFFounSNJDz",
+	"5bBkOaJctVWAaPM+LdFa8T5SRv05KXBUsfuVJFM3bXWfF83LiGuXbtY0AJZRqRJ3HlS+TCn8GqdFauVp",
+	"lIiSFxRCUV7ASyRff+hV7KAYscAz8UEh7KI/CpjoG5D6R8rxxDLF4i4rI5TGIs4SByElObLotozC9Iwi",
+	"BGYYfWWz59r8qnAvL1KkKg+jS4hDvXgzMp9TxGa8RgNJchn2x9xJisfy0k6orzM5LT8jOYgZBTO+WLPn",
+	"XLiayQ02652xrtT0OeDuFRHXQ5XbIN62up9GPOqZngDfMqVRhKt7bUiTch88o1zE0zKNxKEyptbMJjBJ",
+	"1DhNIhh3MYeXXG8VuHZPUtbdjDOtgXF+Dyne4ruhEVUN6izRtt7HfnRezP2DEvxBOdE0WIFp5mIOqkSC",
+	"Z5do9fv6S8v3jrh192NmhYaonh1zFRyzdIDbHv+wiueTjHfRjg0wioJRkKOUXCLxR5bIGzBVEJJM6E98",
+	"yb503mYKPzyRMLvNO28FcHtaq1olgZulqEsvJAsUFC6MvqvFOqniUoXlOI1TRBlMMwel6Spx+QiY+dk8",
+	"Y8RVumghs5lYEVKuIL+2UGDwnQHVDUgl17ch1wgytMUHqvi9LpfL5db791tR9NfTv/72236a7lP6++9B",
+	"t2CyjpqtIJyaWRrRzVWjxbdmXYdQ7mwsUbBG4kdjHpY9wQJGQJUI/JO5Qrz6XIpwFcM3C5vCv+kOmtKs",
+	"KUOm1Os6A6Y4GsvCYzRftVU+mob1vdKgd3vUtu3jyHzqTvl2XDhW7m0jlJCV/k11BHmBja2+zJHf+wb2",
+	"wALgRsnepnmBGwnfVGE96Zso7pH4TbeDWfxrTgoHjzk4eitqOIvhsxcRZYwx3Aip8pAtDZEDbWkwi6fi",
+	"C+VErRIzS1PWQaaVRhcxdrzy+e9Y3tKIVV1mSCYV3+yMxJf1ZNQPPQ/xs8MYquvdF3Yf+GWdgl5f3LVC",
+	"fwfONy9cF3S1PW7obKRx0eql8Y6cx/hYymYtRiP5zJ4eMM4zXO4tWusrT0Z5+JELhPWtnRwEPIsxeHvy",
+	"ces//r6zCyRXeN4lG3AtSH3/Le76NkUhwREdgTTGBUN0BBakyCnXEiK4pOAZHaWjxSh63grbKhFFrNyh",
+	"8wHya14l3gSLx8fUvD6WJl2EzWMX+4kyd9oscnERlCP+zZDp+vMcYitFtIB2zefGpeAn1I0D94tzoVfw",
+	"quqraRlA1/Af8X1LWRM3Ul9DpNxSFdA6QaC+Za054/eG2e0gpwhVOm7e2kOpFbR+qpEhaUZJIlJK6yQe",
+	"0akF/QVXopm6eN3mP0lunsaolV/Tm7CRNmTdTX2qxaiKsUSH7habSoWY0IYXKxuoFTh9Z+/l1s7u1s7u",
+	"6c7Ovvjf/7ZJuRu4it/p5hZmUgVmcdI6o5iayYwAGp+PwWw3mlXmtRtVn6NNJtHfnk8m9Af9Lu3///++",
+	"/O35ZiYl7HW0PWesfZnsmk7pNnHDYFcxbSaCrZbpCdilHQdvpdn1QN8qQ+iWa5VdVnpWlaXXdWeqStVH",
+	"W8huUpA57TVPVwqIUUiMvgcsda8ZAuQJyII7j18W3H3MsuDeBmXBdfRefrDWFF5ZVNN0eeG1U61VNdVw",
+	"8HXnQJHoui1qgOr4V2oyYgsb0nioM6D8WOkIqH+XToCypIP+rSadno3NCZja8cP0ZfwXJfhEiE4OdiU8",
+	"FWStuTa9hEkc6YtTe643WSQOxVRKcGUuikqZyUJhlXZsmEozlxx1BAvq4AOiWHJjlQb7DM1JLs5fLhWr",
+	"B0XmDoAylDUOgKjosnTpWsH3resq9T1+38KBiMbgf1FOyuYpgpjfxsVsgqWYk0JcwCRZck0kJ5eD2aqB",
+	"1xBY0bBwmaKuXWLaDMx3INakdIKSv0r/p4I2DnhTeCTlI58T8cFFSbiTnIhlWrhHlBLxbkIvaM3BZ0Rc",
+	"L9Gf4ig+z986Ku3/uR4FNIFulJy8OzDKjs6gBF5Jo1M5EUCEBla24ZNSgUmrqbQidHlLnlp8Cpou5d96",
+	"uvxXV7hnWd3P/V9R2M1SFZacz5l1cBV27iMH4cvBOQjVWvAOLpN3y5ZtiAMPOxGZJvt6HjK+9ZPL+qN4",
+	"vqn4zldrx7f2PD4vymwSTz9B2e5DSVDmSg76IPKT7TyK/GQOfni3eSbEi1Dl4lXy1btJPbFzO6knbjSN",
+	"YZknXtwk84R15t9l4ondGyWe0EBzPswd9ITDWhlkW/nq3VdGir2OjBQ9EzaoCbbma7BWrZ5SoZ6YwWp6",
+	"g7wMjVFukpZBDebOymCvrWNRV+dpWDFAV+YG3fUGiRvsIYblbdhcvgXCTQG3n21hsxkWXKTdTKWwHlU3",
+	"MykMX6ZGIoV+9FZPrbAOqTkyK7Tux41mRdAEVM2JsMYOXTNLghr5lpIkvHzASRKUW+ApcZjIZZX0CDFB",
+	"wkrHvjKTmiM34RlK7MAxXdpxCDHMl8osrt/YyggsDGV0mkk4GJm+qwyr/WJekcKVZ+kdr9OHWkqUL4ag",
+	"RBhjy+WcibcYLFzIyz81J+GwP8FG6hefEhaKIi1UdCkYhjmhVH0AiUsCCp7FYzSuO7ZPsESakErPNEwo",
+	"et7bw32lKZcDOA0FMow9t1JmjLpWaYdsVGsmfh6hPESYOcXUFQjPTNfbRnr5pQnmn7oTxJcfrWG/UlFd",
+	"Aquqy8LuaMt3C3/h1yNEqmw3XPPj2JoyYmZVKbI0P13Y1PysGpVv501O0s73rasvAsqnnLkcs5oZjlu6",
+	"hMUFgnmRJMst/hhKGmKxcgHgp7WmNOu1mm2JGumTQQTtsz9vPlSVAqqxzUpDHQxTtC0VpBifb0coJdvc",
+	"s2pnd2d3d29nZ2enZsCp2OO3n3/bGb24fqaNN5XK58Fmn63W3qs2Hqqufqw7lNjUQk752lkqXaWw1Oys",
+	"4uu6UletO0YMYb6cLSQXwThpiZZQnhKikaI6KsmOSSFlI0xEwqAnrX/p2crfXZf9ugF3mV09Gdnq9maj",
+	"oNDTMT/1fFRBV1Zn0yIlmC1WT0k1u705aTiMjcT8NjYSVdJlIymbUMS4sNwjyrWUlk9Ue3N/cIXQxWq8",
+	"yFa3hxYFhcaK+amRogo6zreyxRLBHsQrW93ejBQUekbmp56RKuiQ8HWLoWxQsSuLB5YlJQPUZQ3uZ1WU",
+	"IdKHBiUl/LZDCeU3dk1YdR/Fv6Y/Ru8u7KmM+HP3XiMVL7IyeoxxGLFxL0RjWm0PL7g8i0IUyVfFl8pM",
+	"ObNlmJmMhVxQlM+2Z3zKM1BSgp28QjSEeCl/SMcOO7xmnPKQ8zFLlsZJTF1V2VDBLEMwFzGLpUA1E68T",
+	"ZuZlgouyqvdXbd4Ia0dbqkVaakRZ6gzQqFv0jZbK99qpmo+PluijJX7v0RLt/bBeuMRKz2HxEhsf7x8w",
+	"sXHk3TTvp87I2f8lrE6WeV9pOu82Hphjufe/3cw4alGu/ICxljSJQNtSqh4Vtt+NMmY5fYB5TzKfv3PH",
+	"eTlRPIDWgrDIbApUOx2fx5f8wCwy7uyh4RmDQ+mNLihob2djBisF8lTGprHVfbvU1vrL8hXKf6UhZTlk",
+	"6Hy5Au26mZ204wPByL72OeGo/zLokDBQlDZyU1BayVVRx2lXtqmxIlMx7EGGxoOdiUgXWMmIZNF1IweR",
+	"KT+WBnS9kXq+fJLGd+OU3sr2Ol7TmNNPDM3uK3tBLzGt94qQgk0tZJQrU6uwV6hS5Viper1bs3eYp5Yf",
+	"5/9E6KLypCY4KXAkToXmKkdwqVeFK9dGWtcm89J2atR1vfHeEzXqaYGo/OufKML679NFkas/3+Sx/OME",
+	"siJXf0qYhm3UCC6nZD7lIFmGMLusNIeVpV3PFKrNhO2lisN/QFzA3I1E0VyjkWv0HWg0NgKNxnLgN+gs",
+	"V3++h3m4CEbBQZbHifjNS/9RCFb3j0IMcFCcF5RxVKKMIX5cBKPgY8iI/OsDudSFhyiUfw7DtsRGxZhV",
+	"N2V1Rq/XDRQy3kGGKDslh25bqnGiKbFnJStSiLScsjgFx3OQFgmLswQZY4+IbamOZsiApLohTjb6WkJC",
+	"MGVkWrXAttfXrzzqLbqS0LZ3qaHxtxYz7rp45GbUPojk7TaGyZr1t6NBOy4dFuIXq5BZ9qlh832bCXld",
+	"dEqe0AOfZj9tBKF103NXi3aUuszTP67CqdVJJ1U6JTyeUIWRSgm9FbfKqlXe2hkPEKHxoEa+JhEHkBZC",
+	"l5oXie7G9VoEzYMqrd5Wow+s6T4oP8ynyj9qmVwbFaXltVbVmXOn3nagaqUvCzR8VoGGyxTV1Syr/CQk",
+	"WZsfIK+SN13arab2Hh6Ku1L1sKgWzq6eKahLva2mFboeBeLF+S/Ld+bxXhM6YY3kaWQoIFjZJGW8NhWA",
+	"rYQa5ghE8WUcCcsLzxEjxqfjNUOZrFoTMer0bDmtPaJrlusVqtd0nK+Opms51bSlM6q50AykRj5USYrq",
+	"l6FD8btOhLpQf/uBPeA8VTbw+iNORtTLTIvAZCiIh/xG80XbG026av+bLa9YbkaSOFzWtr7lp/DDl3+7",
+	"nBX2rvs4KWyO0NwKgGlAE0hbn6p1LPIm2YWAwXprRquPzTq5ga6XD7VaouKgfP2Z9HsZNmi+sqv9nKX+",
+	"iKXzXbFqULe1iBX9MvAMraXJciTIakuNZZefSNScxq3Z/v01j7/m+W6ueaztsN4tj91x2CVP/dP973js",
+	"h3tNYdZK5NoeXd9OSEIBwnw3R6VLef1pYK+LH5NCdhDHNXCbPIBlgV7jufWFtmPTbiNp6sSy5HfB/6na",
+	"+o7vkZoE1SOvTCclhARLIdQx1CtTBxYkieQNT9nB3B8NIgT7+BXgTsvPDSMOayoatZUijeCw8p3WV4uV",
+	"Vj3T7zQn9cDS7/Bbpo7IyDd9yKADHEuXIxWWqDtDmgp6k9mvNDp7mIbOYEj6cqh/ZDx/P9SERSOhEkSt",
+	"VqhhqxTXCK9eJ7nn4Rk8gpRekTxqexotz/vWxDO8Ehz+cgAyNY524koLKgJwmPcVnEeJJ9L6eMyKPCMy",
+	"X/NKsa0M2JkjusRhp3lVniPmih1FFQABJVIuipl8FYIogCErYFJpJjOccPYwG4GZmqnIdSInIaWlkGCM",
+	"QmZF8DLO+lGco5D7r6l7dwEVb9gCV1vCxVJCko9LWlQyUVcZcGVCfJtE9Tq7RJ9PjXPZddDJ2tJbj/A3",
+	"ywVTz7moxk/3GSg4wCrG80q2MkxnZVgTRRAGRt6t6vHwNk1RFCvnQH2xJr+j7rqDUWAaDbwGE13MHl5W",
+	"7AviZ4diJOtriyYKh/EViZJpw2GhWa4hrNfUGIyjunJGibOpO7+7tLs28ruLTbr6HeDqLO/1FjJBh5ax",
+	"a1+3M3y35Pw++HCIot5iVhMbTynT+7uVttQVRlFrFBDrrO5kDr5doOVIhIG8Fvnc6RgcABrj8wRV6vSt",
+	"WTnMBPMhZHKq1uzvdZrgxxehiFv/da4kCibBBVpOgpHMTqrzwsuat3gSSCWalck2xarpdJsUEJwswURm",
+	"rZkEq6hpcM74mvG2Vlhd8NW3AtV21/dqBltn7zREmAvkOLP4+qrQvOa6pzSX6NEtW/TQ6MKo5K3ybxNb",
+	"GHU+SlLVmtiaM9A1ZV4hvmsu0PKvVN1xEkwXsfAZgYAiQe4qh/EE/w6TOAJ6DEmBb/EIfCCM//OaXzFT",
+	"QdaHBNEPhImSgUgwkyhzQpuCMie0KurMCV22KRPrVtEiywULwWofknklr6OMjsWX2d7JbzEguZr+BDf2",
+	"shZnMcFbKM3Y0jmIQhvJK1jrGk+NdbqIdY0IUiPSa0UgKgTYUIsucTjBKcrPEcg42W/6lnGdxL5uS7qV",
+	"5NcWVCTlm+VzCZnVLd6wQzgu7yg7zSGmooE7LUGzTbnnqbzLr1pSADOtucaSkxQQbKw44vAQmSTHEyxW",
+	"rDSnXuknvQWOUC6zDJbDShk4GgNJNJDpN8EXmFxh9SC4zIJpZwRD4ODorTqK1DC8MwxDlLFG2rr7ygPG",
+	"8TktkTdVIOhbRGdleafoqO7gjG3tW2NaqQopViz4lQgwVyKmTtrM+QpEiME4oSr3lFgP8zG98Cnkep2Q",
+	"JfgGVvxlfJcRsXZbI2KRMxF6LOpKj9ZsY58jfNIiQX0EGRy70qiVtC3yUiKmAggWmUASj2AYY8ogDpHI",
+	"YukcTQRbEHpnsgS7eyNwphA+ljtubL5CP3/9MnbAHFPw06gGUEyF3im9S9AEc58qkAvjGKu781QCUmmA",
+	"N5j3TQLszvzmqisj4jdrO9huS/McQepafFleyqdQhqmBaQpZHILyOsveFtKuJjpqc7PB+V+p4qj2RjkS",
+	"IRNRLszf+mbE5rXLTOWll34QAH3NpH+NPilxJAKUiwy95rJDsMLRBPPaKytemu6UC7hoHKEcRQCKKyyI",
+	"GUIR56RiA6vGFvuG4BVMUfIKUp0TWO908TkBpYooK0/sYTtdLYgVQo7WHtpCWlvpF40gcqoFbbl2qF45",
+	"GHSPxElG5uCUW7zBG5hQNAKfsDiBbKsHrxfPIRLK/1UtBj+I6G8lb49gVNB2Y49OC2Ltf2wtpuBDYE7I",
+	"WAXIEBFTTf14gre2tib4PcRLULKbsRi1TlGUcZYBZUgYY4gASXyBgInwJZnYGQpF0H+Yn8Ush/nSvq2R",
+	"4dgnuKCIOxyKFMdjTCLUZHvPJXODZ3ESM/FYN0LcfpjEMi6WzHUOMVN0naNz9NW2bMYUPIsw3d3de3FS",
+	"nMlgV29Stv38P5+ZKCXccv4mZQP9Ztayav3Yw6rlkOxG1ploNk1JTE4zWF2mrFySHFjXLDUT5AKFF/JK",
+	"z4ifwy9jTErHV65hRf4pFBb8xyGCURJjdCIz0zUJ/WMmrSogUi05Yas8doI7qqEEqxbqD0wg//YYHFhZ",
+	"MylXRmAlIVvpVWCGFk+wMUN5XmQ64px8RxYJj2UuAdv207/v0I09GTMomWpwpmqahsw6m2ji62jUIUd1",
+	"9xI4uXQlmH6raviqlC6jev2BRP4YOJA2YM8ZMDRCrAI9fVPUlVLLatPua2MmwZuM7ytp5SjIC/wRt2c2",
+	"Oy5wFecEm8xsOjDXGPyyNBmA6gtEL2IZcdPuWMYLG+r2XeApp6Z6LrRmuZECajUdp2Ojad8AAkNZVpeX",
+	"gyXaESD4qI4nvtJ4Tzt8KPR8rPHVORnclUyy0yqTxCkiBXOlOVJVAM4Z0jl5IK4QXITCBPKZmfiYA6ek",
+	"wSg9IPXv0gdSlnRdMZVN2u/VquI7X2iVnNneduM7kCT2ekgS5dKW+Bh+ZSYoeqpMUwYPBuDWaj2FlgY1",
+	"s3d7q94uH01iVDV2Im1BlT3enG9WHpKQeqnIS0VeKvJS0cOSitbK+9/I+e/I919n7Xb5zVikl8K8FOal",
+	"sAcphbVFEP/ePZ46USUg8e5Q3h3qabtDid7ThlNUo7jy2nba5iDVrN3YhvM+VN6HyvtQPW4fKrP/vS+V",
+	"96XyvlTel8r7UnlfKu9L5X2pvC+V96Va35eqX5CvdaxcsqAMHvTo7FttKPHWLG/N8tasG1uz2raXWLmb",
+	"2q5kOpwy6LIkh0r8UBfjeIhGrFPbgFLP6yPnJYhzDD5KqWX2Mw9p8PPPM0nas7/8PHtoRqmW4Fi8is+M",
+	"M2O+rQavhvxIxUhTt9GsNNEMsdAMPDK8PdbbY7099tHZYxcoSXWAs2oQqhYXi8uY73egYlzps8mO/tYQ",
+	"knUnB9kcK7XKDEx7i7ZNyBVM02PzvUHLUYJbKu5lSam7Wx9p4yZWo2ECjJqSgaT8reHQJTVZxSrug6jm",
+	"GxOR51/qY5TBNGvzXBGVJTPUpiNhoMorpm0Zl4hqI6LKEfwg7Nl6tlMzI4NxZ5VGvqOygxrcrc87zLYH",
+	"gJ/DIgCZSpZUQTQs7WzlKHpLRohyPjDB0ujJV1wG5WTWPt6Y7dNh8nRaOlsMnPVw35VWtDWYJcIcpkjT",
+	"leX1p6cvTb5DHcB6RV50s2RZXw9h1dhVoyqaWmN7dkZA9Ldl/rbM35b52zJ/W+Zvy/xtmb8t87dl/rZs",
+	"5W1Ze/jptTT/zmCpt6P5NyH3mr9T8++DKK/5e83fa/4PVvMXuzgs8pgtTzj701m2aRweFDJ/q2CLvM8v",
+	"BydvX5XzXTAmyOwMwRzlzdavD45fH9ebX4u3snOi0j0wGIo3ayiFMUfFh4Ic/jI+KTJ+sv7Xi4gfx2kw",
+	"Coo8UUPQ/e1tXJDoTNQ0rtnFAOAVwSwnCThKIEbg2auj5zIlvMjxIvM2SSYhxVyIuRYqu5aJnHKUEoaS",
+	"pbINQHCoqgCkAAKV/gI8O/wFwpPnICURSgQ5oDylH+eq3gI7IuHYgC4nsS3dk7dzlCBI0RYmDFFZtZXE",
+	"IcIUbYnxtvnAMUtQyxyPX5+cchE7GAWXOrlOsDf+j/GOum7CMIuD/eDFeGe8K3ngQiz1tsw8JhKPKQ6t",
+	"IqxLu35M8Nso2A9+RewgSX4RjY90Yz5ODlPEUE6D/c/Oa9n5nCIGINOPn4l0TpEnMSeGYD/4o0Di6NEa",
+	"legTjCQxQQ7Mqgfk19ejlvD8lOQtX9dx+mu6dwJVA5AjVuQYyUD45jH8iNOSHMG0MBc/Cfoah1ydyxZx",
+	"CJNkCc4FoYlEpZJDmnQA6kr6jXiin0HM+Y3KYESl6DnjYspMNhQKu7z0tVNyUiLTnDjQKGsraKzdJrmR",
+	"VubNl5NkRM1T4EFBKEE2Z65OyCNu00VK+pkox4TZmJPHh3xlTeZgb0fnXRqDj1yZvIopqraKKdjhEOiM",
+	"TtIKUq5AqUaa1WpBh860fyOisnIsyDUz+JA+GTJngqz6WaVOEAZGDnG1qdBt9MJX+k2KnZ0XoWgl/kSj",
+	"8XgsEi9o2lGDGtWb13Go8yxHTL7jhZxaUrhFEd+jvFA7hTGSbSXoEiUaEDMZG4pxAwoF1z9OPn44gmwB",
+	"Sj8pWd8GoaODXnZJW5Qkl9IYLHJClvky+LfbllNCuR51v2rBiEziR0vXEWlPI1jlpdScQmqjaUZ4rTwW",
+	"ZgcfDmdjcGBMwmowa7Jipfcn+Acwu0DLGdgCPPGOoldLlxWUvYCX2ndB2M7M5uE+AHqMn8Wi32QkYTVk",
+	"RFKPGPYvq2GLCJhiwqb9gPxLLyj7jVkBt21/875vBPJvmyrkvtkYVYjh+A7ps6JQOCvMcJEk+mTQ7i0G",
+	"Y2JA4dxVHb8/3UBpxDDeFSu/8Ze+k4iI/sKwOQwhq3Vm00JdosEQ6rJODAUqN3dBkWw75pRBi7OtEnp0",
+	"iTC32duGcs4VQUSQPE7ljER/kOXxZZwglVxGEC4/aMrhxERjCgS/bz0YKTsw8Lhmp+OaXF9/EemHMoKp",
+	"lBX3dna0KK9yJAnfKpnfaPtfygJeDthld3nLUPouFlm7nQmD9b60c+XGiAo5Qx/+XNp9uUGYXuc5yV+p",
+	"kVrgEsujU1ByYGJ8yf2wJCy7dw9LFEeCUrKc8LzcICR5jkIGYMEWCDP1dRDmSFw2QOko+3Lnxd2DqmVE",
+	"DhrJ4z+lSKComyk/YkSZpYBzWH+8wyU+0CYnxBsCEoptGVV0Z6H8WFrz5y9VvfjzF75zaJGmIrlTwMm8",
+	"kfeZ07Kyoqi8wFwkFXaDz0FNTfvCbQJFzOemRG+ZyE/AX9Pptr+R/Bzi+E+BnesuFW+VfifYh+KSWlmz",
+	"xg5so4TMK7im7uHVRa8uenXRq4teXfTqolcXvbro1UWvLnp10auLXl1sUxchBjUVbPMq4/Y3UbG8lpfl",
+	"CWKoqT4einJLg1zeuv7oGC7TX76hIlpqNTqUNiPgCsbMOGCa2fOaM35kY5hwkhyBAieIck0kptahIY9/",
+	"rZlIQlaDtrBaFS70xISwLiehFB7hCtJQTNIYxyn3KNxxKClNnvzS7QNhE9tSbD259J5rfh9c8+XOyzuF",
+	"tUpvRoBBX8Uhz+H5j3uERyNR7QGhdShrXbnFrdDFj/3MkewcQCwXgAvDFYQMOWhGfQyPj+XYuE3Z1kbH",
+	"exKhpD+NzkmBPYf2HPo+OPRj53m/IrZphide9DdZ3hEvfqxMT5DiLyRarljsLTH5v+l1V49ABLMUdFIi",
+	"/pV4XKnxPc+lbzIHTcVCCT5PMADf+H8AmAQkmwT7YBLAKJoEI10qrD+ifNsMYVWr+ES8/r8iGCfLScCr",
+	"rif4Cyfe3SpIJ4hZSsAVQhfJUgEo7AcwxiI5x0AAc8SxxjElh3bB+dIGb68GXkEzhCMN0XBAqBwIRS4I",
+	"ODnYQLyoAnGMaJGi3jDkKCWXaCUY5QevbVLs9XbjH5Rgsbc+Zpafd+WpxnXVd1ps1IdwlIvNIk4CqC40",
+	"1LVdlQv5k92f7Pehe/10j/CE4tJWvQEtskjcu0QyEFaBzdNVyiCT2Nv98Q7lkBRFMZRpaIh6EQsxmHWc",
+	"hvKyW19cPgXJ6ZNYlI0LT4VDW3yVI8jQU5edVglMAgm1bcItk+X7FWWgaNqH9SEtzudJYIlKQjSqyCOT",
+	"QAdN47XmRBch22R7GKbInNnXDkFqDVA5Q1dPGDcP5LZ8xhrj8yq4e4PA5Xg9eXewGlIlaXYCmkDKCz5P",
+	"giwn0ST4YsNXk7nUTpOeBgIw2NhqNZDs9bcXTcHEKVoWK6OyqZEfqcCaZ0S23dWS2mj4vJ1UpHro0/B3",
+	"+ZxJfXTvxd4ub3ddEw4HSGAPUARsWnPUYSfodGf3IUCkXtd6OdTLofcj9+nwDjF7cHKqglRtEUDyRyus",
+	"Pj0JVZ3rZk0AvLmE2v/ifFvbSFY6X9M3OUkf//25d+T2jtzekds7cntHbu/I7R25vSO3d+T2jtwbceT2",
+	"DtzeDOEdXTbrUE4BgxcIg7Ol48L5dvXiMvdZh2b8XnHxQztRmteMvWbsNWOvGXvN2GvGXjP2mrHXjL1m",
+	"/J1qxpZriteNvW7sdeON6MblrjKZd6pzL9OI3kxJ7hd12Ydb9sqlVy69cumVS69ceuXSK5deufTKpb92",
+	"9aqlj5vVX5UzxGyyaLYEWu6puK0RWvmj1bBVofPxlb1+6PVDrx96/dDrh14/9Pqh1w+9fuj1Q68fev3w",
+	"HvTDVZGVh+mI299UkItObfFItrkrRdExXBmKw6ucXuX0KqdXOb3K6VVOr3J6ldOrnF7l9CqnVzm9yrl5",
+	"ldOKgLhZZXP7m/5gjwSwj0nhdIykZ+p1V6+7et3V665ed/W6q9ddve7qdVevu3rd1euuXnfdUNQgqbJa",
+	"6lZ/nXUUZISuyO7wHWqht5cjQoglUiBsZghwBPoXzIBLFDLyvsgEZeVNWDP6voRljej7m4513yfKvQ9v",
+	"79/O38nb+Upce0VxJq59xTj4YCLcO0H2Ue0fSFR7iAHBWxFKoUkJeJsW5O1vsnHvfPDfpz3ZMZRZG5+e",
+	"fkPp6X1een9o38kJ+GAS0vtM9EOOuFW55/0RNfyIuv1U+H3VN5/93p8Dd34OPM209+ua81Ymuvcc9oYc",
+	"dvN59w+iSN1aciVBgzUwk7wYiG5bhkNX6v2KIdGVeP9YZIlXYM1zkvYFrCu9fBO2p5hl/ib55f2h6Q/N",
+	"u1We7t+O6VPJP6ZU8usKJO3J4z/m8iNeLrlTuWSFMNLMbN44+PslNFfIlTVW1ndVrTGmc56nxJEKfW9n",
+	"7+XO7s7ujvi/rozo7RelfTOZN0Sgt2lGcpdMDhYQRwlqYiQWPU7EdyrQyX6/iW4lqrYMVvilAdlyzrbs",
+	"fpQU57ECHKVnKOI+n2E2xgWJzsYhSW9wM3yPGdn7GhVuNQm7v5j2Ytqjv5geAZI/nmztzln6ZO2PIVn7",
+	"2rfaKsDjdggxzJc5SRJSsM6A+69Ey2Pd0gfd96+E/Csh/0rogb4Seggu7ZK3As1cvWu7d21/tK7tNVq2",
+	"5I0WSeJLh5ix/Y1v5B5echWZo5c9Svzj3chu7kZWXXDvTuZV7VuGtUZw9+9W5tgB37l7WRUjfc6AUT91",
+	"8nZY+23KgBXwO22VDjLy3liejd4bG31qXllDmFKXU9Z9yJyWaUEZ9sSxwiArqFDbF6gkKre0KPudiC49",
+	"XnsP9Jl69A5B/fl2q19QjeA8G/ds/F6k4Z/uEyDvL/QI/YUGHZXt7kL+pNzsGfQQT0GH9nJLbh83gsl7",
+	"gfjD+H7Pvgfl0NEFsHfseByOHesf1q0XLgylWQIZ6u/fcWp6eD8P7+fh/Ty8n4f38+jp5wEMt/UOH97h",
+	"44k4fJREvUoQKRv2EUiGeYJo6cR7hNyjR4heQ+8a4vXvu1VnDeU9OB+Ryp7wziIu1KxzfozWU1YfuReJ",
+	"nsY6ts8KwXm3Es+C758FP23/koF8rLfDyR2Ltt7x5F6u3Hqx+p4OKCVFes7vOf/9Ct8/PQjIvG/Ko/dN",
+	"GXrM9nRW8afsxs+vh3ySdilNd+HNcmMgvXuLP+EfyDn6kP1c3JB7h5fH6PAySAKwL5x0SJ4/CsJgp+fL",
+	"oWr5P7Kl93jxHi/e48V7vHiPl1aPF81bgWSu3tHFO7o8WkeXGi07xI2aJNElZvT2Z6nIHN6P5S79WKoL",
+	"7t1XvH59y7DWCO7+vVYcO+A7d1apYqTPGTDqp04+Pp+UCvidFksHGXkXFM9G742NPjXPkyFMqcvR5D5k",
+	"Tu9gclfXYv35dqtjSY3gPBv3bPxepOGf7hMg7z3yCL1HBh2V7c4i/qTc7Bn0EE9Bh/ZyS74gN4LJu374",
+	"w/h+z74H5fHRBbB39Hgcjh7rH9b2hcsCJekcQVbk3fFMfkNJ+ka38z4d3qfD+3R4nw7v09Hq08H5JdCM",
+	"1Xt0eI+OR+vRUaFkh3hRkSDahYvevhyWpOE9Oe7Sk8NeaO/H4ZXlW4a1Qm7378XRoP7v3IfDxsdqrj/q",
+	"ozg+Pu8NC/hOS2ODeLznhmec98Q4n5rfxrqMqMtn4+5lS++xcVd3VX15dau/RoXQPOv2rPseZN6f7g8c",
+	"76nxCD011j4c2700/Nm4uVPn4Z16DQ3llrwzBsPjPTP8wXufJ92D8stoB9d7ZTwOr4z1Dmb70iQnCeqV",
+	"YuaYJMhnlvE+Gd4nw/tkeJ+MHj4ZnLP6fDLeKeMJOGVUSdkhYFSliA4Jo7dfhi1ueMeMu3TMqCy298zw",
+	"yvItw1qlt/t3zWjS/3fum1FBSA/mP+qlQz4+9wwb+k5rY5OCvIOG55/3xT+fmofG+uyoy0fjHuRM76Rx",
+	"V9dVvTl2q5dGldg8A/cM/D4E4J/uER7vqPEIHTUGnJHtrhr+iNzk4fMAT7+mvnJL7ho3gMg7bPgj+F6P",
+	"vAflsdEBr3fZeBwuG+se0faVCgcwDhGLUd7ps3Ei252Kdt5lw7tseJcN77LhXTZaXTYUXwWCsXqPDe+x",
+	"8Wg9NiqU7JAuKhJEu3DR213DkjS8t8ZdemvYC+2dNbyifMuwVsjt/n01GtT/nbtq2PhYzfVHfRTHx+en",
+	"YQHfaWRsEI/30vCM854Y51Nz0liXEXW5aNy9bOk9NO7qjqovr2510KgQmmfdnnXfg8z70/2B470zHqF3",
+	"xtqHY7tvhj8bN3fqPLxTr6Gh3JJfxmB4vFeGP3jv86R7UE4Z7eB6n4zH4ZOx3sHML010tpNON4yDJDk0",
+	"7bwbhnfD8G4Y3g3jgbphNFbvVQtG5nHCUC4Qwfn+EnD2FgGCQQLPUGI4BRQxpdKM8NqCcv1ndvDhcDYG",
+	"B2GIMsb3gBrMmqxY6f0J/gHMLtByBrbAR5wsFb0aoYRKyl7ASyS/Ku58ys0DLtBSj/GzWPSbjAQoEnfB",
+	"YiAx7F9WwxYRMMWETfsB+ZdeUPYbswJu2/7mfd8I5N82Vch9szGqEMPxHdJnRSHABG/NcJEk+mSArHYx",
+	"KAYEXFuujt+fbiBIIQsXfC79vvGXvpOIiP7CsDkMIat1ZtNCXaLBEOqyTgwFKsRLpZ/EnDJocbZVQo8u",
+	"EQbxXB/vOcJMqWRaJ5AzEv1BlseXcYLOETWiDT9oyuHERGMKBL9vPRgpOzDw9DBi3LNnm5FTvVeb92p7",
+	"tF5tJRUrvw6lmVm6mmkSfBkFX7eKmE9ISdrKsFdV3La/kfwc4vhPgY3rLj3uo9WwQ6FzmF3tT9zc7c3r",
+	"h14/9Pqh1w+9fuj1Q68fev3Q64deP/T6odcPvX5o64cQg5ratSkdcftblpN/oZB1aot3pyE6hlMAel3T",
+	"65pe1/S6ptc1va7pdU2va3pd0+uaXtf0uub340OsFKEn+OSyResFpeq3eX13+5tu0yMmiFaAH5H+6xgp",
+	"Kmfho5XcNFqJRqaPVOIZ/y3Dakjt/qOUVKj+O49QYrHTdY6n0Uoz63d4ytymMK/R2vkesELYPnqKZ+b3",
+	"wMyfWuSUwQyyK4TK984kB4ZXQV9hmiWSuQoqs14PLiA+twhTPRmUBs794PMEA/CN/weASUCySbAPJgGM",
+	"okkw0qXCNibKt3lvq0ZadUUV3h3TFCbJJOCV1xP8hRP9bhWYtzjMkdgeMb0ANP4TCZXCAAfzcBFfipvI",
+	"gQBmOacqFiO6rQY7jOnFSfwncoO9u7Pza1yFea8K8wliFvbEDUYskpcPhDCFMV9WiEMnSGogXia/RN9i",
+	"BWok4RSQluC+qIJ7GFNhB7Zo8NYBjan6asQrOKE74HxZhfM1Xg/MHKXkEvWAtPzm9ejxxx3qJd60Bh0y",
+	"2PXSjpd27lh1/el+QHn6gYZe7u09INRakS2uRD36GiIUAWgoE/xREAafaIik4VJwe6wkLwT3j6PUJfkK",
+	"ZLokDCFcTAIpzArhdUcLryNZFZ3BI0jpFckj2SIUYvRbZlqUgqYlDE2ChtDJO/8oZEwjufyLFDmGSa2N",
+	"JYZeO0Tn2myk74J2XiqBac6yYyrqSzWBV1F5O95skpNDwjBFNmY4CcmaFFEKz2N8bqo5eci6CKXEFFuL",
+	"sVtbjHVQreT5blzvljK/+oTmVb+jnJpZvdj5ceeFwtL1qCdf0ptXrpfE5b3E7lrfMHZLQbvWB8RH6/JS",
+	"613LM4rmjDzDWu/kR4A8hihf3dN8UhG+HoZE7EDt9yQcO8KUDRSO13bx2LZkHD5eRqhDuJZn8aHV1Pt7",
+	"fA/+HhtSJ97imMWQIXD4ywHIFAkpWm/KyMqlUknc8sehLYlrsRfm50g1k39XWrl1gWNElzgEIcHz+LzI",
+	"UVSBaQUwMGQFTFyw5GLY0oTrUg5+R3k8XxqP0TW+60LCeqJ1YwOvK1c3Sb+ylpYMDGghxJZ5kSRLKRDv",
+	"9RghNgE/spzw/nxTnSFuKLCEay/Tepn26ToRNbYUh0Zvq1WORHcpJB/ghsyrnpiFpMAM5Sh6RDLwE7Gt",
+	"sjoFcRHkRpIkuhRzO4PhRZFlJInDWBwKrXXNQEt9m25/ExVLZx/nN/t8rPNV7xqtqz7Rwzpuf5ONayPY",
+	"0YVdxZ2z7PV6ea32rTNVDai7tBPKlkZtQPLDgzqKOr/harH9jZfqhgsEE7b4U/1KyHmM2xWed6L6djIT",
+	"iLGP5cBGDLtNc6b6oBy905QosKL4oxbivNjlxa5Nw0ouEK7jLlZgX8I44SeRl2m+Z5lG8CwABUlbkotk",
+	"2zIuP8kQhlmsGLp1PnUF6T/SzXyMfh8Xw8fF8HExfFwMHxfDx8XwcTF8XAwfF+P242JoKdWHxfAhGB9t",
+	"MApDxK0R+nWLFfblduthqwrXrr/5kPxeHfTqoFcHvTro1UGvDnp10KuDXh306qBXB706eIfqYHtA/pup",
+	"hNVw/N0xCY+Mn/jjDcnv4whqsvJhBL0ryr3Fj71rB2Cb5tcJInjXD+Q0nJVHXBpY6+XWgu9d4+D4NKMd",
+	"NmPx9jjqRqsMnI/l9LpNKVqhojt+j7VjfHRCf0b4GOM3DU44kJ91hSZ8jDzt1sIJakq6tWiCOz2iCfLI",
+	"fAaQhxeYb88dmK9czIcRl++FMy5fXyi/07B8vU71tqh8Grf+kPeH/N0qgg9AwXqSIfmeYGC7oRJUe1i7",
+	"pyxA9QtF1zxUVaAEmkAdEu3SFRFtZ4whJm3hINTqtY6+Il5bGZCtGaxtNWDVUG2bi6PWPGEf2Olu6ey3",
+	"FDhtXTB82DQvV9zTaf6gIp85IX2Sgc+eYMywQXIHv3TVL+67Hk5+Em38q0nvJuvdZL2brHeT9W6y3k3W",
+	"u8l6N1nvJnv7brJCPvU+st5H9tH6yEoKbn0vKar7KGlrvJRsUdj8M0mv/3n9z+t/Xv/z+p/X/7z+5/U/",
+	"r/95/c/rf17/uxP9r/2B5FAdsIxyveJd5CcZSvXOPYhUCFf/IvKmLyLNTvLPIb23ym2j9d7fQlZQ1vch",
+	"5BN8X1iLgL3qmBh1GgQfBf+/TZHz8AxCyjHR6RpoaM8/KfR89o757FN7T7g2/+p6Sfh4ZVjLKgCThFwZ",
+	"tYCX2Bo+KRjlG0xY42ldW3BJpWK8Vzmh9KPVWCr4bhl1DhOKRi3K/q29fBSzdaQ8XPMRX1ZNvlh//oiu",
+	"7CSJrS8gbZigRtVAiGT/4yJZ9apQrBQv+6zLgPD8h9G+9a7Aai2Kt63HBbr+y+pXk7/mEDPAB9ekxTWl",
+	"Gj3deMbbYk7bW+7VsKbW8XBSQsoTRtqQ3vi1Z18g2/D8dN5c9pN6Wl9dCu7nhSAvBN2hsvnT3YPhH1s+",
+	"wseW64uX7c8svXT5cKTLfs9CFeZqrzZLCVFkzxaS3ltmXlra4tp+KU80hbNSNNCC1xclF3S8JXWD1Psh",
+	"KYzSGJvy6kwqsu2mJrPx56dG2FCbSqDlXh6jrmnsuaW3qGtC4Z+iennrPgSdB/UOtQmmf4T6OB6hrimP",
+	"ya9zuKSwVeRJsB8sGMvo/va2EgDGuCDR2TgkaXD95fr/DgBj2d9awtEDAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
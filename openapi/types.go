@@ -15,6 +15,22 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for AllowVerb.
+const (
+	AllowVerbAll    AllowVerb = "all"
+	AllowVerbDelete AllowVerb = "delete"
+	AllowVerbRead   AllowVerb = "read"
+	AllowVerbWrite  AllowVerb = "write"
+)
+
+// Defines values for AnalysisRunResult.
+const (
+	AnalysisRunResultFailed    AnalysisRunResult = "Failed"
+	AnalysisRunResultPending   AnalysisRunResult = "Pending"
+	AnalysisRunResultSkipped   AnalysisRunResult = "Skipped"
+	AnalysisRunResultSucceeded AnalysisRunResult = "Succeeded"
+)
+
 // Defines values for BackupStatusModelState.
 const (
 	BackupStatusModelStateDeleting  BackupStatusModelState = "Deleting"
@@ -76,6 +92,13 @@ const (
 	ProjectStatusModelStateStopping             ProjectStatusModelState = "Stopping"
 )
 
+// Defines values for RollbackStrategy.
+const (
+	RollbackStrategyFailed RollbackStrategy = "Failed"
+	RollbackStrategyNone   RollbackStrategy = "None"
+	RollbackStrategyStep   RollbackStrategy = "Step"
+)
+
 // Defines values for RotationSettingsModelDayOfWeek.
 const (
 	RotationSettingsModelDayOfWeekFriday    RotationSettingsModelDayOfWeek = "Friday"
@@ -103,6 +126,100 @@ const (
 	RotationSettingsModelMonthSeptember RotationSettingsModelMonth = "September"
 )
 
+// Defines values for UpdateStrategyType.
+const (
+	UpdateStrategyTypeCanaryRollout UpdateStrategyType = "CanaryRollout"
+	UpdateStrategyTypeImmediate     UpdateStrategyType = "Immediate"
+)
+
+// Defines values for CanaryrolloutstatusConditionsStatus.
+const (
+	CanaryrolloutstatusConditionsStatusFalse   CanaryrolloutstatusConditionsStatus = "False"
+	CanaryrolloutstatusConditionsStatusTrue    CanaryrolloutstatusConditionsStatus = "True"
+	CanaryrolloutstatusConditionsStatusUnknown CanaryrolloutstatusConditionsStatus = "Unknown"
+)
+
+// Defines values for CanaryrollouttemplatestatusConditionsStatus.
+const (
+	CanaryrollouttemplatestatusConditionsStatusFalse   CanaryrollouttemplatestatusConditionsStatus = "False"
+	CanaryrollouttemplatestatusConditionsStatusTrue    CanaryrollouttemplatestatusConditionsStatus = "True"
+	CanaryrollouttemplatestatusConditionsStatusUnknown CanaryrollouttemplatestatusConditionsStatus = "Unknown"
+)
+
+// Defines values for ServicetierstatusConditionsStatus.
+const (
+	ServicetierstatusConditionsStatusFalse   ServicetierstatusConditionsStatus = "False"
+	ServicetierstatusConditionsStatusTrue    ServicetierstatusConditionsStatus = "True"
+	ServicetierstatusConditionsStatusUnknown ServicetierstatusConditionsStatus = "Unknown"
+)
+
+// Allow defines model for Allow.
+type Allow struct {
+	// Resource The resource or set of resources to grant access to. If the value
+	// begins with a slash (`/`), then the value denotes a resource path.
+	// Otherwise, the value denotes a scope in the hierarchy of the DBaaS
+	// resources that this access rule entry grants access to, of the form
+	// `<organization>`, `<organization>/<project>`, or
+	// `<organization>/<project>/<database>`. In either case, parameterized
+	// path segments of the form `{organization}` or `{user}` may appear
+	// that are resolved when the role template is assigned to a user.
+	Resource string `cty:"resource" hcl:"resource" json:"resource" tfsdk:"resource"`
+
+	// Sla The SLA to constrain access to. This constraint only applies to
+	// projects and resources contained within projects, such as databases
+	// and backups.
+	Sla *string `cty:"sla" hcl:"sla" json:"sla,omitempty" tfsdk:"sla"`
+
+	// Verb The verb to grant access to with this access rule entry, which maps
+	// to HTTP request methods as follows:
+	//
+	//
+	// - `read`: GET
+	// - `write`: PUT, POST
+	// - `delete`: DELETE
+	//
+	//
+	// `all` denotes that the request method is unconstrained for this
+	// access rule entry.
+	Verb AllowVerb `cty:"verb" hcl:"verb" json:"verb" tfsdk:"verb"`
+}
+
+// AllowVerb The verb to grant access to with this access rule entry, which maps
+// to HTTP request methods as follows:
+//
+// - `read`: GET
+// - `write`: PUT, POST
+// - `delete`: DELETE
+//
+// `all` denotes that the request method is unconstrained for this
+// access rule entry.
+type AllowVerb string
+
+// AnalysisRun defines model for AnalysisRun.
+type AnalysisRun struct {
+	// EndTime Analysis end time is the analysis completion time.
+	EndTime *string `cty:"end_time" hcl:"end_time" json:"endTime,omitempty" tfsdk:"end_time"`
+
+	// Message The human readable message indicating details about the analysis run.
+	Message *string `cty:"message" hcl:"message" json:"message,omitempty" tfsdk:"message"`
+
+	// Name The name of the analysis.
+	Name string `cty:"name" hcl:"name" json:"name" tfsdk:"name"`
+
+	// Result The result of the analysis run.
+	Result AnalysisRunResult `cty:"result" hcl:"result" json:"result" tfsdk:"result"`
+
+	// StartTime Analysis start time is the initial time when the analysis run was
+	// performed without an error.
+	StartTime *string `cty:"start_time" hcl:"start_time" json:"startTime,omitempty" tfsdk:"start_time"`
+}
+
+// AnalysisRunResult The result of the analysis run.
+type AnalysisRunResult string
+
+// AnyType defines model for AnyType.
+type AnyType = JsonValue
+
 // BackupCreateModel defines model for BackupCreateModel.
 type BackupCreateModel struct {
 	// Organization The organization that the backup belongs to
@@ -249,6 +366,110 @@ type BackupStatusModelState string
 // BackupStatusModelRetainedAs defines model for BackupStatusModel.RetainedAs.
 type BackupStatusModelRetainedAs string
 
+// Canary The configuration for the canary rollout update strategy
+type Canary struct {
+	// TemplateRef The reference to the canary rollout template
+	TemplateRef TemplateRef `cty:"template_ref" hcl:"template_ref" json:"templateRef" tfsdk:"template_ref"`
+}
+
+// CanaryRolloutModel defines model for CanaryRolloutModel.
+type CanaryRolloutModel struct {
+	// Name The name of the resource
+	Name string `cty:"name" hcl:"name" json:"name" tfsdk:"name"`
+
+	// Description Human-readable description of the resource
+	Description *string `cty:"description" hcl:"description" json:"description,omitempty" tfsdk:"description"`
+
+	// ResourceVersion The version of the resource. When specified in a `PUT` request payload, indicates that the resoure should be updated, and is used by the system to guard against concurrent updates.
+	ResourceVersion *string `json:"resourceVersion,omitempty" tfsdk:"-"`
+
+	// Spec The specification of the canary rollout
+	Spec CanaryRolloutSpec `cty:"spec" hcl:"spec" json:"spec" tfsdk:"spec"`
+
+	// Status The status of the canary rollout
+	Status *CanaryRolloutStatus `cty:"status" hcl:"status" json:"status,omitempty" tfsdk:"status"`
+}
+
+// CanaryRolloutSpec The specification of the canary rollout
+type CanaryRolloutSpec struct {
+	// StepBackoffLimit Specifies the number of retries before declaring a step and this canary
+	// rollout as failed. Defaults to 20.
+	StepBackoffLimit *int32 `cty:"step_backoff_limit" hcl:"step_backoff_limit" json:"stepBackoffLimit,omitempty" tfsdk:"step_backoff_limit"`
+
+	// Patch The patch to apply to the target resources, as a JSON-encoded object.
+	Patch AnyType `cty:"patch" hcl:"patch" json:"patch" tfsdk:"patch"`
+
+	// RolloutTemplate The reference to the canary rollout template
+	RolloutTemplate RolloutTemplate `cty:"rollout_template" hcl:"rollout_template" json:"rolloutTemplate" tfsdk:"rollout_template"`
+
+	// Selector The label selector used to match target resources
+	Selector *CanaryrolloutspecSelector `cty:"selector" hcl:"selector" json:"selector,omitempty" tfsdk:"selector"`
+}
+
+// CanaryRolloutStatus The status of the canary rollout
+type CanaryRolloutStatus struct {
+	// Conditions Conditions holds the conditions for the canary rollout.
+	Conditions *[]CanaryrolloutstatusConditions `cty:"conditions" hcl:"conditions" json:"conditions,omitempty" tfsdk:"conditions"`
+
+	// CurrentStepFailures The number of reconciliation failure count for the current step. It is
+	// reset after step completion.
+	CurrentStepFailures *int32 `cty:"current_step_failures" hcl:"current_step_failures" json:"currentStepFailures,omitempty" tfsdk:"current_step_failures"`
+
+	// CurrentStepIndex The step index which the rollout is currently on.
+	CurrentStepIndex *int32 `cty:"current_step_index" hcl:"current_step_index" json:"currentStepIndex,omitempty" tfsdk:"current_step_index"`
+
+	// LastObservedConfigChecksum The SHA1 checksum of rollout configuration used in the last
+	// reconciliation attempt. If a checksum change is detected, the rollout is
+	// restarted.
+	LastObservedConfigChecksum *string `cty:"last_observed_config_checksum" hcl:"last_observed_config_checksum" json:"lastObservedConfigChecksum,omitempty" tfsdk:"last_observed_config_checksum"`
+
+	// LastPromotedFromIndex The step index which last promoted targets.
+	LastPromotedFromIndex *int32 `cty:"last_promoted_from_index" hcl:"last_promoted_from_index" json:"lastPromotedFromIndex,omitempty" tfsdk:"last_promoted_from_index"`
+
+	// LastPromotedTargets Targets to which the change is being promoted by the last promote step.
+	LastPromotedTargets *[]LastPromotedTargets `cty:"last_promoted_targets" hcl:"last_promoted_targets" json:"lastPromotedTargets,omitempty" tfsdk:"last_promoted_targets"`
+
+	// ObservedGeneration The generation observed by the controller from metadata.generation.
+	ObservedGeneration *int64 `cty:"observed_generation" hcl:"observed_generation" json:"observedGeneration,omitempty" tfsdk:"observed_generation"`
+}
+
+// CanaryRolloutTemplateModel defines model for CanaryRolloutTemplateModel.
+type CanaryRolloutTemplateModel struct {
+	// Name The name of the resource
+	Name string `cty:"name" hcl:"name" json:"name" tfsdk:"name"`
+
+	// Description Human-readable description of the resource
+	Description *string `cty:"description" hcl:"description" json:"description,omitempty" tfsdk:"description"`
+
+	// ResourceVersion The version of the resource. When specified in a `PUT` request payload, indicates that the resoure should be updated, and is used by the system to guard against concurrent updates.
+	ResourceVersion *string `json:"resourceVersion,omitempty" tfsdk:"-"`
+
+	// Spec The specification of the canary rollout template
+	Spec CanaryRolloutTemplateSpec `cty:"spec" hcl:"spec" json:"spec" tfsdk:"spec"`
+
+	// Status The status of the canary rollout template
+	Status *CanaryRolloutTemplateStatus `cty:"status" hcl:"status" json:"status,omitempty" tfsdk:"status"`
+}
+
+// CanaryRolloutTemplateSpec The specification of the canary rollout template
+type CanaryRolloutTemplateSpec struct {
+	// Analysis Analysis performed after every promotion step.
+	Analysis *[]CanaryrollouttemplatespecAnalysis `cty:"analysis" hcl:"analysis" json:"analysis,omitempty" tfsdk:"analysis"`
+
+	// SkipDisabled Skip disabled target resources. By default a change is promoted to all
+	// matching resources.
+	SkipDisabled *bool `cty:"skip_disabled" hcl:"skip_disabled" json:"skipDisabled,omitempty" tfsdk:"skip_disabled"`
+
+	// Steps Canary rollout steps for this template.
+	Steps []Steps `cty:"steps" hcl:"steps" json:"steps" tfsdk:"steps"`
+}
+
+// CanaryRolloutTemplateStatus The status of the canary rollout template
+type CanaryRolloutTemplateStatus struct {
+	// Conditions Conditions holds the conditions for the service tier.
+	Conditions *[]CanaryrollouttemplatestatusConditions `cty:"conditions" hcl:"conditions" json:"conditions,omitempty" tfsdk:"conditions"`
+}
+
 // DatabaseCreateUpdateModel defines model for DatabaseCreateUpdateModel.
 type DatabaseCreateUpdateModel struct {
 	// Organization The organization that the database belongs to
@@ -320,6 +541,48 @@ type DatabasePropertiesModel struct {
 	ProductVersion *string `cty:"product_version" hcl:"product_version" json:"productVersion,omitempty" tfsdk:"product_version"`
 }
 
+// DatabaseQuotaModel defines model for DatabaseQuotaModel.
+type DatabaseQuotaModel struct {
+	// Name The name of the resource
+	Name string `cty:"name" hcl:"name" json:"name" tfsdk:"name"`
+
+	// Description Human-readable description of the resource
+	Description *string `cty:"description" hcl:"description" json:"description,omitempty" tfsdk:"description"`
+
+	// ResourceVersion The version of the resource. When specified in a `PUT` request payload, indicates that the resoure should be updated, and is used by the system to guard against concurrent updates.
+	ResourceVersion *string `json:"resourceVersion,omitempty" tfsdk:"-"`
+
+	// Spec The specification of the database quota
+	Spec DatabaseQuotaSpec `cty:"spec" hcl:"spec" json:"spec" tfsdk:"spec"`
+
+	// Status The status of the database quota
+	Status *DatabaseQuotaStatus `cty:"status" hcl:"status" json:"status,omitempty" tfsdk:"status"`
+}
+
+// DatabaseQuotaSpec The specification of the database quota
+type DatabaseQuotaSpec struct {
+	// Hard The set of desired hard limits for each named resource.
+	Hard *map[string]IntOrString `cty:"hard" hcl:"hard" json:"hard,omitempty" tfsdk:"hard"`
+
+	// Scope The scope of databases that the quota applies to
+	Scope *Scope `cty:"scope" hcl:"scope" json:"scope,omitempty" tfsdk:"scope"`
+}
+
+// DatabaseQuotaStatus The status of the database quota
+type DatabaseQuotaStatus struct {
+	// LastEnforced The information about objects on which this quota has been enforced.
+	// It is cleared by the quota controller after a successful
+	// reconciliation.
+	LastEnforced *[]LastEnforced `cty:"last_enforced" hcl:"last_enforced" json:"lastEnforced,omitempty" tfsdk:"last_enforced"`
+
+	// ObservedGeneration The last observed generation.
+	ObservedGeneration *int64 `cty:"observed_generation" hcl:"observed_generation" json:"observedGeneration,omitempty" tfsdk:"observed_generation"`
+
+	// Used The current observed total usage of the named resources per scoped
+	// group.
+	Used *map[string]map[string]IntOrString `cty:"used" hcl:"used" json:"used,omitempty" tfsdk:"used"`
+}
+
 // DatabaseStatusModel defines model for DatabaseStatusModel.
 type DatabaseStatusModel struct {
 	// SqlEndpoint The endpoint for SQL clients to connect to
@@ -364,6 +627,60 @@ type DatabaseStatusModel struct {
 //   - `RotatingCertificates` - TLS certificates rotation is in progress for this database
 type DatabaseStatusModelState string
 
+// DbaasAccessRuleModel The rule specifying access for the user
+type DbaasAccessRuleModel struct {
+	// Allow List of access rule entries in the form `<verb>:<resource specifier>[:<SLA>]` that specify requests to allow
+	Allow *[]string `cty:"allow" hcl:"allow" json:"allow,omitempty" tfsdk:"allow"`
+
+	// Deny List of access rule entries in the form `<verb>:<resource specifier>` that specify requests to deny
+	Deny *[]string `cty:"deny" hcl:"deny" json:"deny,omitempty" tfsdk:"deny"`
+}
+
+// DbaasUserCreateUpdateModel defines model for DbaasUserCreateUpdateModel.
+type DbaasUserCreateUpdateModel struct {
+	// Organization The organization that the user belongs to
+	Organization string `cty:"organization" hcl:"organization" json:"organization" tfsdk:"organization"`
+
+	// Name The name of the user
+	Name string `cty:"name" hcl:"name" json:"name" tfsdk:"name"`
+
+	// Labels User-defined labels attached to the resource that can be used for filtering
+	Labels *map[string]string `cty:"labels" hcl:"labels" json:"labels,omitempty" tfsdk:"labels"`
+
+	// Roles List of roles for user
+	Roles *[]RoleModel `cty:"roles" hcl:"roles" json:"roles,omitempty" tfsdk:"roles"`
+
+	// Password The password for the user
+	Password *string `cty:"password" hcl:"password" json:"password,omitempty" tfsdk:"password"`
+
+	// ResourceVersion The version of the resource. When specified in a `PUT` request payload, indicates that the resoure should be updated, and is used by the system to guard against concurrent updates.
+	ResourceVersion *string `json:"resourceVersion,omitempty" tfsdk:"-"`
+
+	// AccessRule The rule specifying access for the user
+	AccessRule DbaasAccessRuleModel `cty:"access_rule" hcl:"access_rule" json:"accessRule" tfsdk:"access_rule"`
+}
+
+// DbaasUserModel defines model for DbaasUserModel.
+type DbaasUserModel struct {
+	// Organization The organization that the user belongs to
+	Organization string `cty:"organization" hcl:"organization" json:"organization" tfsdk:"organization"`
+
+	// Name The name of the user
+	Name string `cty:"name" hcl:"name" json:"name" tfsdk:"name"`
+
+	// Labels User-defined labels attached to the resource that can be used for filtering
+	Labels *map[string]string `cty:"labels" hcl:"labels" json:"labels,omitempty" tfsdk:"labels"`
+
+	// Roles List of roles for user
+	Roles *[]RoleModel `cty:"roles" hcl:"roles" json:"roles,omitempty" tfsdk:"roles"`
+
+	// ResourceVersion The version of the resource. When specified in a `PUT` request payload, indicates that the resoure should be updated, and is used by the system to guard against concurrent updates.
+	ResourceVersion *string `json:"resourceVersion,omitempty" tfsdk:"-"`
+
+	// AccessRule The rule specifying access for the user
+	AccessRule DbaasAccessRuleModel `cty:"access_rule" hcl:"access_rule" json:"accessRule" tfsdk:"access_rule"`
+}
+
 // ErrorContent defines model for ErrorContent.
 type ErrorContent struct {
 	// Code Application-level error code that describes how the error should be handled and how the `detail` field should be interpreted:
@@ -391,6 +708,75 @@ type ExpandedListEntry struct {
 	Ref *string `json:"$ref,omitempty"`
 }
 
+// Features defines model for Features.
+type Features struct {
+	// Name The name of the resource.
+	Name string `cty:"name" hcl:"name" json:"name" tfsdk:"name"`
+
+	// Namespace The namespace of the resource. When not specified, the current
+	// namespace is assumed.
+	Namespace *string `cty:"namespace" hcl:"namespace" json:"namespace,omitempty" tfsdk:"namespace"`
+
+	// Revision Revision of the Helm feature used by this revision of the service tier.
+	Revision *string `cty:"revision" hcl:"revision" json:"revision,omitempty" tfsdk:"revision"`
+}
+
+// FieldSelector The field selector used to select databases
+type FieldSelector struct {
+	// MatchExpressions The list of field selector requirements, which are composed with `AND`.
+	MatchExpressions *[]DatabasequotaspecScopeFieldselectorMatchExpressions `cty:"match_expressions" hcl:"match_expressions" json:"matchExpressions,omitempty" tfsdk:"match_expressions"`
+
+	// MatchFields The field selector requirements as a map where each key-value pair is
+	// equivalent to an element of `matchExpressions` with `operator` set to
+	// `==`. The requirements are composed with `AND`.
+	MatchFields *map[string]string `cty:"match_fields" hcl:"match_fields" json:"matchFields,omitempty" tfsdk:"match_fields"`
+}
+
+// HelmFeatureModel defines model for HelmFeatureModel.
+type HelmFeatureModel struct {
+	// Name The name of the resource
+	Name string `cty:"name" hcl:"name" json:"name" tfsdk:"name"`
+
+	// Description Human-readable description of the resource
+	Description *string `cty:"description" hcl:"description" json:"description,omitempty" tfsdk:"description"`
+
+	// ResourceVersion The version of the resource. When specified in a `PUT` request payload, indicates that the resoure should be updated, and is used by the system to guard against concurrent updates.
+	ResourceVersion *string `json:"resourceVersion,omitempty" tfsdk:"-"`
+
+	// Spec The specification of the Helm feature
+	Spec HelmFeatureSpec `cty:"spec" hcl:"spec" json:"spec" tfsdk:"spec"`
+
+	// Status The status of the Helm feature
+	Status *HelmFeatureStatus `cty:"status" hcl:"status" json:"status,omitempty" tfsdk:"status"`
+}
+
+// HelmFeatureSpec The specification of the Helm feature
+type HelmFeatureSpec struct {
+	// ChartCompatibility The Helm chart version compatibility constraint for the Helm feature.
+	ChartCompatibility *string `cty:"chart_compatibility" hcl:"chart_compatibility" json:"chartCompatibility,omitempty" tfsdk:"chart_compatibility"`
+
+	// Optional Whether the Helm feature is optional and does not emit an error
+	// if the Helm chart or product version is incompatible.
+	Optional *bool `cty:"optional" hcl:"optional" json:"optional,omitempty" tfsdk:"optional"`
+
+	// Parameters The parameter definitions referenced in values. For example, parameter
+	// named `foo` is referenced using `<< .meta.params.foo >>` template.
+	Parameters *map[string]Parameters `cty:"parameters" hcl:"parameters" json:"parameters,omitempty" tfsdk:"parameters"`
+
+	// ProductCompatibility The NuoDB product version compatibility constraint for the
+	// Helm feature.
+	ProductCompatibility *string `cty:"product_compatibility" hcl:"product_compatibility" json:"productCompatibility,omitempty" tfsdk:"product_compatibility"`
+
+	// Values The Helm values to apply, as a JSON-encoded object. The values may contain references to parameters.
+	Values *AnyType `cty:"values" hcl:"values" json:"values,omitempty" tfsdk:"values"`
+}
+
+// HelmFeatureStatus The status of the Helm feature
+type HelmFeatureStatus struct {
+	// History The revision history of the Helm feature
+	History *HelmfeaturestatusHistory `cty:"history" hcl:"history" json:"history,omitempty" tfsdk:"history"`
+}
+
 // ImportSourceModel defines model for ImportSourceModel.
 type ImportSourceModel struct {
 	// BackupHandle The existing backup handle to import
@@ -400,6 +786,9 @@ type ImportSourceModel struct {
 	BackupPlugin string `cty:"backup_plugin" hcl:"backup_plugin" json:"backupPlugin" tfsdk:"backup_plugin"`
 }
 
+// IntOrString An integer or string value
+type IntOrString = IntOrStringValue
+
 // ItemList defines model for ItemList.
 type ItemList struct {
 	// Offset The offset at which items are being listed, based on the user request
@@ -457,6 +846,61 @@ type JsonPatchOperation struct {
 // JsonPatchOperationOp defines model for JsonPatchOperation.Op.
 type JsonPatchOperationOp string
 
+// LastEnforced defines model for LastEnforced.
+type LastEnforced struct {
+	// EnforceTimestamp Timestamp is a timestamp representing the server time when this quota was
+	// enforced on a selected object.
+	EnforceTimestamp string `cty:"enforce_timestamp" hcl:"enforce_timestamp" json:"enforceTimestamp" tfsdk:"enforce_timestamp"`
+
+	// ObjectGeneration The generation that the object had at the time of quota enforcement.
+	ObjectGeneration *int64 `cty:"object_generation" hcl:"object_generation" json:"objectGeneration,omitempty" tfsdk:"object_generation"`
+
+	// ObjectRef The reference to the object that the quota was enforced on
+	ObjectRef ObjectRef `cty:"object_ref" hcl:"object_ref" json:"objectRef" tfsdk:"object_ref"`
+}
+
+// LastPromotedTargets defines model for LastPromotedTargets.
+type LastPromotedTargets struct {
+	// AnalysisRun Information about performed analysis run against the target.
+	AnalysisRun *[]AnalysisRun `cty:"analysis_run" hcl:"analysis_run" json:"analysisRun,omitempty" tfsdk:"analysis_run"`
+
+	// ApiGroup APIGroup is the group for the resource being referenced.
+	ApiGroup string `cty:"api_group" hcl:"api_group" json:"apiGroup" tfsdk:"api_group"`
+
+	// Kind Kind is the type of resource being referenced.
+	Kind string `cty:"kind" hcl:"kind" json:"kind" tfsdk:"kind"`
+
+	// Name Name is the name of resource being referenced
+	Name string `cty:"name" hcl:"name" json:"name" tfsdk:"name"`
+}
+
+// LoginRequestModel defines model for LoginRequestModel.
+type LoginRequestModel struct {
+	// LimitAllow The allow rule entries to use for the token, which cannot exceed the access of the current user
+	LimitAllow *[]string `json:"limitAllow,omitempty"`
+
+	// ExtraDeny Extra deny rules entries to append to the access rule to further restrict access granted by the token
+	ExtraDeny *[]string `json:"extraDeny,omitempty"`
+
+	// ExpiresIn Requested seconds, minutes, hours or days (s,m,h,d) when token should expire
+	ExpiresIn *string `json:"expiresIn,omitempty"`
+
+	// ExpiresAtTime Requested timestamp when token should expire (in ISO-8601 format)
+	ExpiresAtTime *string `json:"expiresAtTime,omitempty"`
+}
+
+// LoginResponseModel defines model for LoginResponseModel.
+type LoginResponseModel struct {
+	// Token The authentication/authorization token
+	Token *string `json:"token,omitempty"`
+
+	// ExpiresAtTime The token expiration time
+	ExpiresAtTime *string `json:"expiresAtTime,omitempty"`
+
+	// AccessRule The rule specifying access for the user
+	AccessRule *DbaasAccessRuleModel `cty:"access_rule" hcl:"access_rule" json:"accessRule,omitempty" tfsdk:"access_rule"`
+}
+
 // MaintenanceModel defines model for MaintenanceModel.
 type MaintenanceModel struct {
 	// ExpiresAtTime The time at which the project or database will be disabled
@@ -469,6 +913,37 @@ type MaintenanceModel struct {
 	IsDisabled *bool `cty:"is_disabled" hcl:"is_disabled" json:"isDisabled,omitempty" tfsdk:"is_disabled"`
 }
 
+// ObjectRef The reference to the object that the quota was enforced on
+type ObjectRef struct {
+	// ApiGroup APIGroup is the group for the resource being referenced.
+	ApiGroup string `cty:"api_group" hcl:"api_group" json:"apiGroup" tfsdk:"api_group"`
+
+	// Kind Kind is the type of resource being referenced.
+	Kind string `cty:"kind" hcl:"kind" json:"kind" tfsdk:"kind"`
+
+	// Name Name is the name of resource being referenced
+	Name string `cty:"name" hcl:"name" json:"name" tfsdk:"name"`
+}
+
+// Parameters defines model for Parameters.
+type Parameters struct {
+	// Default The parameter's default value.
+	Default *string `cty:"default" hcl:"default" json:"default,omitempty" tfsdk:"default"`
+
+	// Description The parameter's description.
+	Description *string `cty:"description" hcl:"description" json:"description,omitempty" tfsdk:"description"`
+
+	// JsonSchema A JSONSchema used to validate the parameter's value.
+	JsonSchema *string `cty:"json_schema" hcl:"json_schema" json:"jsonSchema,omitempty" tfsdk:"json_schema"`
+}
+
+// Pause Pause the rollout before proceeding to the next step
+type Pause struct {
+	// Duration The duration for which the rollout is paused. Zero duration means wait
+	// until manually approved.
+	Duration *string `cty:"duration" hcl:"duration" json:"duration,omitempty" tfsdk:"duration"`
+}
+
 // ProjectModel defines model for ProjectModel.
 type ProjectModel struct {
 	// Organization The organization that the project belongs to
@@ -541,6 +1016,25 @@ type ProjectStatusModel struct {
 //   - `RotatingCertificates` - TLS certificates rotation is in progress for this domain
 type ProjectStatusModelState string
 
+// PromoteTo Promote the change to the target resources
+type PromoteTo struct {
+	// LimitCount Limit the promotion to certain number of the matching resources. The
+	// supplied limit is cumulative accross promote steps (i.e. total number of
+	// targets to be promoted).
+	LimitCount *int32 `cty:"limit_count" hcl:"limit_count" json:"limitCount,omitempty" tfsdk:"limit_count"`
+
+	// LimitPercentage Limit the promotion to certain percentage of the matching resources. The
+	// supplied limit is cumulative accross promote steps (i.e. total percentage
+	// of targets to be promoted).
+	LimitPercentage *int32 `cty:"limit_percentage" hcl:"limit_percentage" json:"limitPercentage,omitempty" tfsdk:"limit_percentage"`
+
+	// LabelSelector The label selector used to match target resources
+	LabelSelector *CanaryrollouttemplatespecStepsPromotetoLabelSelector `cty:"label_selector" hcl:"label_selector" json:"labelSelector,omitempty" tfsdk:"label_selector"`
+
+	// Rollback The rollback configuration for the promotion step
+	Rollback *Rollback `cty:"rollback" hcl:"rollback" json:"rollback,omitempty" tfsdk:"rollback"`
+}
+
 // RestoreFromModel defines model for RestoreFromModel.
 type RestoreFromModel struct {
 	// Backup The name of the backup to restore the database from. If a fully-qualified name is not supplied, then the organization, project, or name of the database being created is assumed.
@@ -566,6 +1060,58 @@ type RetentionModel struct {
 	Settings *RotationSettingsModel `cty:"settings" hcl:"settings" json:"settings,omitempty" tfsdk:"settings"`
 }
 
+// RoleModel defines model for RoleModel.
+type RoleModel struct {
+	// Name The name of the role template
+	Name string `cty:"name" hcl:"name" json:"name" tfsdk:"name"`
+
+	// Params The parameters to apply to the role template. These parameters take precedence over the `organization` and `user`/`name` properties of the user and any user labels, which are implicitly used to resolve parameters appearing in the `allow` entries of the role template.
+	Params *map[string]string `cty:"params" hcl:"params" json:"params,omitempty" tfsdk:"params"`
+}
+
+// RoleTemplateModel defines model for RoleTemplateModel.
+type RoleTemplateModel struct {
+	// Name The name of the resource
+	Name string `cty:"name" hcl:"name" json:"name" tfsdk:"name"`
+
+	// Description Human-readable description of the resource
+	Description *string `cty:"description" hcl:"description" json:"description,omitempty" tfsdk:"description"`
+
+	// ResourceVersion The version of the resource. When specified in a `PUT` request payload, indicates that the resoure should be updated, and is used by the system to guard against concurrent updates.
+	ResourceVersion *string `json:"resourceVersion,omitempty" tfsdk:"-"`
+
+	// Spec The specification of the role template
+	Spec   RoleTemplateSpec    `cty:"spec" hcl:"spec" json:"spec" tfsdk:"spec"`
+	Status *RoleTemplateStatus `json:"status,omitempty" tfsdk:"-"`
+}
+
+// RoleTemplateSpec The specification of the role template
+type RoleTemplateSpec struct {
+	// Allow List of access rule entries to allow.
+	Allow *[]Allow `cty:"allow" hcl:"allow" json:"allow,omitempty" tfsdk:"allow"`
+}
+
+// RoleTemplateStatus defines model for RoleTemplateStatus.
+type RoleTemplateStatus = map[string]interface{}
+
+// Rollback The rollback configuration for the promotion step
+type Rollback struct {
+	// BackoffLimit Specifies the number of retries before giving up on rollback. Defaults to 20.
+	BackoffLimit *int32 `cty:"backoff_limit" hcl:"backoff_limit" json:"backoffLimit,omitempty" tfsdk:"backoff_limit"`
+
+	// Strategy The rollback strategy.
+	Strategy RollbackStrategy `cty:"strategy" hcl:"strategy" json:"strategy" tfsdk:"strategy"`
+}
+
+// RollbackStrategy The rollback strategy.
+type RollbackStrategy string
+
+// RolloutTemplate The reference to the canary rollout template
+type RolloutTemplate struct {
+	// Name Name of the referent
+	Name string `cty:"name" hcl:"name" json:"name" tfsdk:"name"`
+}
+
 // RotationSettingsModel defines model for RotationSettingsModel.
 type RotationSettingsModel struct {
 	// DayOfWeek The day of the week used to promote backup to weekly
@@ -593,6 +1139,19 @@ type RotationSettingsModelDayOfWeek string
 // RotationSettingsModelMonth The month of the year used to promote backup to yearly
 type RotationSettingsModelMonth string
 
+// Scope The scope of databases that the quota applies to
+type Scope struct {
+	// GroupByLabels The label keys on which the selected databases are divided into
+	// groups.
+	GroupByLabels *[]string `cty:"group_by_labels" hcl:"group_by_labels" json:"groupByLabels,omitempty" tfsdk:"group_by_labels"`
+
+	// FieldSelector The field selector used to select databases
+	FieldSelector *FieldSelector `cty:"field_selector" hcl:"field_selector" json:"fieldSelector,omitempty" tfsdk:"field_selector"`
+
+	// LabelSelector The label selector used to select databases
+	LabelSelector *DatabasequotaspecScopeLabelSelector `cty:"label_selector" hcl:"label_selector" json:"labelSelector,omitempty" tfsdk:"label_selector"`
+}
+
 // SelectorModel defines model for SelectorModel.
 type SelectorModel struct {
 	// Scope The scope that the backup policy applies to
@@ -608,6 +1167,60 @@ type SelectorModel struct {
 	Labels *map[string]string `cty:"labels" hcl:"labels" json:"labels,omitempty" tfsdk:"labels"`
 }
 
+// ServiceTierModel defines model for ServiceTierModel.
+type ServiceTierModel struct {
+	// Name The name of the resource
+	Name string `cty:"name" hcl:"name" json:"name" tfsdk:"name"`
+
+	// Description Human-readable description of the resource
+	Description *string `cty:"description" hcl:"description" json:"description,omitempty" tfsdk:"description"`
+
+	// ResourceVersion The version of the resource. When specified in a `PUT` request payload, indicates that the resoure should be updated, and is used by the system to guard against concurrent updates.
+	ResourceVersion *string `json:"resourceVersion,omitempty" tfsdk:"-"`
+
+	// Spec The specification of the service tier
+	Spec ServiceTierSpec `cty:"spec" hcl:"spec" json:"spec" tfsdk:"spec"`
+
+	// Status The status of the service tier
+	Status *ServiceTierStatus `cty:"status" hcl:"status" json:"status,omitempty" tfsdk:"status"`
+}
+
+// ServiceTierSpec The specification of the service tier
+type ServiceTierSpec struct {
+	// Features The list of Helm features enabled for this service tier.
+	Features *[]Features `cty:"features" hcl:"features" json:"features,omitempty" tfsdk:"features"`
+
+	// UpdateStrategy The strategy used to roll out changes to the service tier
+	UpdateStrategy *UpdateStrategy `cty:"update_strategy" hcl:"update_strategy" json:"updateStrategy,omitempty" tfsdk:"update_strategy"`
+}
+
+// ServiceTierStatus The status of the service tier
+type ServiceTierStatus struct {
+	// Conditions Conditions holds the conditions for the service tier.
+	Conditions *[]ServicetierstatusConditions `cty:"conditions" hcl:"conditions" json:"conditions,omitempty" tfsdk:"conditions"`
+
+	// History The revision history of the service tier
+	History *ServicetierstatusHistory `cty:"history" hcl:"history" json:"history,omitempty" tfsdk:"history"`
+}
+
+// Steps defines model for Steps.
+type Steps struct {
+	// Analysis Analysis performed after the promotion step
+	Analysis *CanaryrollouttemplatespecStepsAnalysis `cty:"analysis" hcl:"analysis" json:"analysis,omitempty" tfsdk:"analysis"`
+
+	// Pause Pause the rollout before proceeding to the next step
+	Pause *Pause `cty:"pause" hcl:"pause" json:"pause,omitempty" tfsdk:"pause"`
+
+	// PromoteTo Promote the change to the target resources
+	PromoteTo *PromoteTo `cty:"promote_to" hcl:"promote_to" json:"promoteTo,omitempty" tfsdk:"promote_to"`
+}
+
+// TemplateRef The reference to the canary rollout template
+type TemplateRef struct {
+	// Name Name of the referent
+	Name string `cty:"name" hcl:"name" json:"name" tfsdk:"name"`
+}
+
 // UpdateDbaPasswordModel defines model for UpdateDbaPasswordModel.
 type UpdateDbaPasswordModel struct {
 	// Current The current DBA password, which must be supplied for verification purposes
@@ -620,50 +1233,367 @@ type UpdateDbaPasswordModel struct {
 	Resync *bool `json:"resync,omitempty"`
 }
 
-// GetAllBackupPoliciesParams defines parameters for GetAllBackupPolicies.
-type GetAllBackupPoliciesParams struct {
-	// Offset The offset at which to list items
-	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+// UpdateStrategy The strategy used to roll out changes to the service tier
+type UpdateStrategy struct {
+	// Type The service tier update strategy type. Defaults to Immediate.
+	Type UpdateStrategyType `cty:"type" hcl:"type" json:"type" tfsdk:"type"`
 
-	// Cursor The cursor at which to list items, which represents the last item returned. If specified, all items returned must be lexicographically greater than the supplied value. For expanded payloads, the `$ref` value is compared to the cursor.
-	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	// Canary The configuration for the canary rollout update strategy
+	Canary *Canary `cty:"canary" hcl:"canary" json:"canary,omitempty" tfsdk:"canary"`
+}
 
-	// Limit The number of items to return. If payload expansion was enabled and `limit` was not specified, the default of 20 is used. Otherwise, the default is 0 to indicate that all items should be returned.
-	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+// UpdateStrategyType The service tier update strategy type. Defaults to Immediate.
+type UpdateStrategyType string
 
-	// Expand Whether to expand payload fields. If `expand=true`, then all payload fields are expanded. If `expand=<field>,...` is supplied, then the value is interpreted as a comma-separated list of top-level fields to expand. If `expand.<field>=<JSONPath expression>` is supplied, then the JSONPath expression is used to resolve the user-supplied field.
-	Expand *string `form:"expand,omitempty" json:"expand,omitempty"`
+// CanaryrolloutspecSelector The label selector used to match target resources
+type CanaryrolloutspecSelector struct {
+	// MatchExpressions matchExpressions is a list of label selector requirements. The requirements are ANDed.
+	MatchExpressions *[]CanaryrolloutspecSelectorMatchExpressions `cty:"match_expressions" hcl:"match_expressions" json:"matchExpressions,omitempty" tfsdk:"match_expressions"`
 
-	// LabelFilter Comma-separated list of filters to apply based on labels, which are composed using `AND`. Acceptable filter expressions are:
-	// * `key` - Only return resources that have label with specified key
-	// * `key=value` - Only return resources that have label with specified key set to value
-	// * `!key` - Only return resources that do _not_ have label with specified key
-	// * `key!=value` - Only return resources that do _not_ have label with specified key set to value
-	LabelFilter *string `form:"labelFilter,omitempty" json:"labelFilter,omitempty"`
+	// MatchLabels matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+	// map is equivalent to an element of matchExpressions, whose key field is "key", the
+	// operator is "In", and the values array contains only "value". The requirements are ANDed.
+	MatchLabels *map[string]string `cty:"match_labels" hcl:"match_labels" json:"matchLabels,omitempty" tfsdk:"match_labels"`
+}
 
-	// FieldFilter Comma-separated list of filters to apply based on fields, which are composed using `AND`. Acceptable filter expressions are:
-	// * `fieldPath` - Only return resources that have a non-`null` value at the specified field path
-	// * `fieldPath=value` - Only return resources that have a matching value at the specified field path
-	// * `!fieldPath` - Only return resources that do have a `null` value at the specified field path
-	// * `fieldPath!=value` - Only return resources that do _not_ have a matching value at the specified field path
-	FieldFilter *string `form:"fieldFilter,omitempty" json:"fieldFilter,omitempty"`
+// CanaryrolloutspecSelectorMatchExpressions defines model for canaryrolloutspec_selector_MatchExpressions.
+type CanaryrolloutspecSelectorMatchExpressions struct {
+	// Key key is the label key that the selector applies to.
+	Key string `cty:"key" hcl:"key" json:"key" tfsdk:"key"`
 
-	// ListAccessible Whether to return any accessible sub-resources even if the current user does not have access privileges to list all resources at this level
-	ListAccessible *bool `form:"listAccessible,omitempty" json:"listAccessible,omitempty"`
+	// Operator operator represents a key's relationship to a set of values.
+	// Valid operators are In, NotIn, Exists and DoesNotExist.
+	Operator string `cty:"operator" hcl:"operator" json:"operator" tfsdk:"operator"`
+
+	// Values values is an array of string values. If the operator is In or NotIn,
+	// the values array must be non-empty. If the operator is Exists or DoesNotExist,
+	// the values array must be empty. This array is replaced during a strategic
+	// merge patch.
+	Values *[]string `cty:"values" hcl:"values" json:"values,omitempty" tfsdk:"values"`
 }
 
-// GetBackupPoliciesParams defines parameters for GetBackupPolicies.
-type GetBackupPoliciesParams struct {
-	// Offset The offset at which to list items
-	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+// CanaryrolloutstatusConditions defines model for canaryrolloutstatus_Conditions.
+type CanaryrolloutstatusConditions struct {
+	// LastTransitionTime lastTransitionTime is the last time the condition transitioned from one status to another.
+	// This should be when the underlying condition changed.  If that is not known, then using the time when the API field changed is acceptable.
+	LastTransitionTime string `cty:"last_transition_time" hcl:"last_transition_time" json:"lastTransitionTime" tfsdk:"last_transition_time"`
 
-	// Cursor The cursor at which to list items, which represents the last item returned. If specified, all items returned must be lexicographically greater than the supplied value. For expanded payloads, the `$ref` value is compared to the cursor.
-	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	// Message message is a human readable message indicating details about the transition.
+	// This may be an empty string.
+	Message string `cty:"message" hcl:"message" json:"message" tfsdk:"message"`
 
-	// Limit The number of items to return. If payload expansion was enabled and `limit` was not specified, the default of 20 is used. Otherwise, the default is 0 to indicate that all items should be returned.
-	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+	// ObservedGeneration observedGeneration represents the .metadata.generation that the condition was set based upon.
+	// For instance, if .metadata.generation is currently 12, but the .status.conditions[x].observedGeneration is 9, the condition is out of date
+	// with respect to the current state of the instance.
+	ObservedGeneration *int64 `cty:"observed_generation" hcl:"observed_generation" json:"observedGeneration,omitempty" tfsdk:"observed_generation"`
 
-	// Expand Whether to expand payload fields. If `expand=true`, then all payload fields are expanded. If `expand=<field>,...` is supplied, then the value is interpreted as a comma-separated list of top-level fields to expand. If `expand.<field>=<JSONPath expression>` is supplied, then the JSONPath expression is used to resolve the user-supplied field.
+	// Reason reason contains a programmatic identifier indicating the reason for the condition's last transition.
+	// Producers of specific condition types may define expected values and meanings for this field,
+	// and whether the values are considered a guaranteed API.
+	// The value should be a CamelCase string.
+	// This field may not be empty.
+	Reason string `cty:"reason" hcl:"reason" json:"reason" tfsdk:"reason"`
+
+	// Status status of the condition, one of True, False, Unknown.
+	Status CanaryrolloutstatusConditionsStatus `cty:"status" hcl:"status" json:"status" tfsdk:"status"`
+
+	// Type type of condition in CamelCase or in foo.example.com/CamelCase.
+	// ---
+	// Many .condition.type values are consistent across resources like Available, but because arbitrary conditions can be
+	// useful (see .node.status.conditions), the ability to deconflict is important.
+	// The regex it matches is (dns1123SubdomainFmt/)?(qualifiedNameFmt)
+	Type string `cty:"type" hcl:"type" json:"type" tfsdk:"type"`
+}
+
+// CanaryrolloutstatusConditionsStatus status of the condition, one of True, False, Unknown.
+type CanaryrolloutstatusConditionsStatus string
+
+// CanaryrollouttemplatespecAnalysis defines model for canaryrollouttemplatespec_Analysis.
+type CanaryrollouttemplatespecAnalysis struct {
+	// ExecutionDeadlineSeconds Optional deadline in seconds for executing this analaysis. Analysis runs
+	// that exceed the specified deadline are interrupted and retried later.
+	// Defaults to 60s.
+	ExecutionDeadlineSeconds *int32 `cty:"execution_deadline_seconds" hcl:"execution_deadline_seconds" json:"executionDeadlineSeconds,omitempty" tfsdk:"execution_deadline_seconds"`
+
+	// Interval Interval in which the analysis is run. Defaults to 60s.
+	Interval *string `cty:"interval" hcl:"interval" json:"interval,omitempty" tfsdk:"interval"`
+
+	// Name The analysis name.
+	Name string `cty:"name" hcl:"name" json:"name" tfsdk:"name"`
+
+	// RunOnDisabled Run the analysis on disabled targets. By default the analysis is skipped
+	// on disabled resources.
+	RunOnDisabled *bool `cty:"run_on_disabled" hcl:"run_on_disabled" json:"runOnDisabled,omitempty" tfsdk:"run_on_disabled"`
+
+	// CheckStatusCondition The status condition to check on the target resources
+	CheckStatusCondition *CanaryrollouttemplatespecAnalysisCheckStatusCondition `cty:"check_status_condition" hcl:"check_status_condition" json:"checkStatusCondition,omitempty" tfsdk:"check_status_condition"`
+}
+
+// CanaryrollouttemplatespecAnalysisCheckStatusCondition The status condition to check on the target resources
+type CanaryrollouttemplatespecAnalysisCheckStatusCondition struct {
+	// Status The required condition status.
+	Status string `cty:"status" hcl:"status" json:"status" tfsdk:"status"`
+
+	// Timeout A timeout after which an analysis is declared as failed.
+	Timeout string `cty:"timeout" hcl:"timeout" json:"timeout" tfsdk:"timeout"`
+
+	// Type The condition type to perform analysis on.
+	Type string `cty:"type" hcl:"type" json:"type" tfsdk:"type"`
+}
+
+// CanaryrollouttemplatespecStepsAnalysis Analysis performed after the promotion step
+type CanaryrollouttemplatespecStepsAnalysis struct {
+	// ExecutionDeadlineSeconds Optional deadline in seconds for executing this analaysis. Analysis runs
+	// that exceed the specified deadline are interrupted and retried later.
+	// Defaults to 60s.
+	ExecutionDeadlineSeconds *int32 `cty:"execution_deadline_seconds" hcl:"execution_deadline_seconds" json:"executionDeadlineSeconds,omitempty" tfsdk:"execution_deadline_seconds"`
+
+	// Interval Interval in which the analysis is run. Defaults to 60s.
+	Interval *string `cty:"interval" hcl:"interval" json:"interval,omitempty" tfsdk:"interval"`
+
+	// Name The analysis name.
+	Name string `cty:"name" hcl:"name" json:"name" tfsdk:"name"`
+
+	// RunOnDisabled Run the analysis on disabled targets. By default the analysis is skipped
+	// on disabled resources.
+	RunOnDisabled *bool `cty:"run_on_disabled" hcl:"run_on_disabled" json:"runOnDisabled,omitempty" tfsdk:"run_on_disabled"`
+
+	// CheckStatusCondition The status condition to check on the target resources
+	CheckStatusCondition *CanaryrollouttemplatespecStepsAnalysisCheckStatusCondition `cty:"check_status_condition" hcl:"check_status_condition" json:"checkStatusCondition,omitempty" tfsdk:"check_status_condition"`
+}
+
+// CanaryrollouttemplatespecStepsAnalysisCheckStatusCondition The status condition to check on the target resources
+type CanaryrollouttemplatespecStepsAnalysisCheckStatusCondition struct {
+	// Status The required condition status.
+	Status string `cty:"status" hcl:"status" json:"status" tfsdk:"status"`
+
+	// Timeout A timeout after which an analysis is declared as failed.
+	Timeout string `cty:"timeout" hcl:"timeout" json:"timeout" tfsdk:"timeout"`
+
+	// Type The condition type to perform analysis on.
+	Type string `cty:"type" hcl:"type" json:"type" tfsdk:"type"`
+}
+
+// CanaryrollouttemplatespecStepsPromotetoLabelSelector The label selector used to match target resources
+type CanaryrollouttemplatespecStepsPromotetoLabelSelector struct {
+	// MatchExpressions matchExpressions is a list of label selector requirements. The requirements are ANDed.
+	MatchExpressions *[]CanaryrollouttemplatespecStepsPromotetoLabelselectorMatchExpressions `cty:"match_expressions" hcl:"match_expressions" json:"matchExpressions,omitempty" tfsdk:"match_expressions"`
+
+	// MatchLabels matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+	// map is equivalent to an element of matchExpressions, whose key field is "key", the
+	// operator is "In", and the values array contains only "value". The requirements are ANDed.
+	MatchLabels *map[string]string `cty:"match_labels" hcl:"match_labels" json:"matchLabels,omitempty" tfsdk:"match_labels"`
+}
+
+// CanaryrollouttemplatespecStepsPromotetoLabelselectorMatchExpressions defines model for canaryrollouttemplatespec_steps_promoteto_labelselector_MatchExpressions.
+type CanaryrollouttemplatespecStepsPromotetoLabelselectorMatchExpressions struct {
+	// Key key is the label key that the selector applies to.
+	Key string `cty:"key" hcl:"key" json:"key" tfsdk:"key"`
+
+	// Operator operator represents a key's relationship to a set of values.
+	// Valid operators are In, NotIn, Exists and DoesNotExist.
+	Operator string `cty:"operator" hcl:"operator" json:"operator" tfsdk:"operator"`
+
+	// Values values is an array of string values. If the operator is In or NotIn,
+	// the values array must be non-empty. If the operator is Exists or DoesNotExist,
+	// the values array must be empty. This array is replaced during a strategic
+	// merge patch.
+	Values *[]string `cty:"values" hcl:"values" json:"values,omitempty" tfsdk:"values"`
+}
+
+// CanaryrollouttemplatestatusConditions defines model for canaryrollouttemplatestatus_Conditions.
+type CanaryrollouttemplatestatusConditions struct {
+	// LastTransitionTime lastTransitionTime is the last time the condition transitioned from one status to another.
+	// This should be when the underlying condition changed.  If that is not known, then using the time when the API field changed is acceptable.
+	LastTransitionTime string `cty:"last_transition_time" hcl:"last_transition_time" json:"lastTransitionTime" tfsdk:"last_transition_time"`
+
+	// Message message is a human readable message indicating details about the transition.
+	// This may be an empty string.
+	Message string `cty:"message" hcl:"message" json:"message" tfsdk:"message"`
+
+	// ObservedGeneration observedGeneration represents the .metadata.generation that the condition was set based upon.
+	// For instance, if .metadata.generation is currently 12, but the .status.conditions[x].observedGeneration is 9, the condition is out of date
+	// with respect to the current state of the instance.
+	ObservedGeneration *int64 `cty:"observed_generation" hcl:"observed_generation" json:"observedGeneration,omitempty" tfsdk:"observed_generation"`
+
+	// Reason reason contains a programmatic identifier indicating the reason for the condition's last transition.
+	// Producers of specific condition types may define expected values and meanings for this field,
+	// and whether the values are considered a guaranteed API.
+	// The value should be a CamelCase string.
+	// This field may not be empty.
+	Reason string `cty:"reason" hcl:"reason" json:"reason" tfsdk:"reason"`
+
+	// Status status of the condition, one of True, False, Unknown.
+	Status CanaryrollouttemplatestatusConditionsStatus `cty:"status" hcl:"status" json:"status" tfsdk:"status"`
+
+	// Type type of condition in CamelCase or in foo.example.com/CamelCase.
+	// ---
+	// Many .condition.type values are consistent across resources like Available, but because arbitrary conditions can be
+	// useful (see .node.status.conditions), the ability to deconflict is important.
+	// The regex it matches is (dns1123SubdomainFmt/)?(qualifiedNameFmt)
+	Type string `cty:"type" hcl:"type" json:"type" tfsdk:"type"`
+}
+
+// CanaryrollouttemplatestatusConditionsStatus status of the condition, one of True, False, Unknown.
+type CanaryrollouttemplatestatusConditionsStatus string
+
+// DatabasequotaspecScopeLabelSelector The label selector used to select databases
+type DatabasequotaspecScopeLabelSelector struct {
+	// MatchExpressions matchExpressions is a list of label selector requirements. The requirements are ANDed.
+	MatchExpressions *[]DatabasequotaspecScopeLabelselectorMatchExpressions `cty:"match_expressions" hcl:"match_expressions" json:"matchExpressions,omitempty" tfsdk:"match_expressions"`
+
+	// MatchLabels matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+	// map is equivalent to an element of matchExpressions, whose key field is "key", the
+	// operator is "In", and the values array contains only "value". The requirements are ANDed.
+	MatchLabels *map[string]string `cty:"match_labels" hcl:"match_labels" json:"matchLabels,omitempty" tfsdk:"match_labels"`
+}
+
+// DatabasequotaspecScopeFieldselectorMatchExpressions defines model for databasequotaspec_scope_fieldselector_MatchExpressions.
+type DatabasequotaspecScopeFieldselectorMatchExpressions struct {
+	// Key The path of the field to apply the selector requirement to.
+	Key string `cty:"key" hcl:"key" json:"key" tfsdk:"key"`
+
+	// Operator The operator to apply to the field value. One of `=`, `==`, and `!=`.
+	Operator string `cty:"operator" hcl:"operator" json:"operator" tfsdk:"operator"`
+
+	// Value The value to compare to.
+	Value *string `cty:"value" hcl:"value" json:"value,omitempty" tfsdk:"value"`
+}
+
+// DatabasequotaspecScopeLabelselectorMatchExpressions defines model for databasequotaspec_scope_labelselector_MatchExpressions.
+type DatabasequotaspecScopeLabelselectorMatchExpressions struct {
+	// Key key is the label key that the selector applies to.
+	Key string `cty:"key" hcl:"key" json:"key" tfsdk:"key"`
+
+	// Operator operator represents a key's relationship to a set of values.
+	// Valid operators are In, NotIn, Exists and DoesNotExist.
+	Operator string `cty:"operator" hcl:"operator" json:"operator" tfsdk:"operator"`
+
+	// Values values is an array of string values. If the operator is In or NotIn,
+	// the values array must be non-empty. If the operator is Exists or DoesNotExist,
+	// the values array must be empty. This array is replaced during a strategic
+	// merge patch.
+	Values *[]string `cty:"values" hcl:"values" json:"values,omitempty" tfsdk:"values"`
+}
+
+// HelmfeaturestatusHistory The revision history of the Helm feature
+type HelmfeaturestatusHistory struct {
+	// Revisions Resource revisions.
+	Revisions *[]HelmfeaturestatusHistoryRevisions `cty:"revisions" hcl:"revisions" json:"revisions,omitempty" tfsdk:"revisions"`
+}
+
+// HelmfeaturestatusHistoryRevisions defines model for helmfeaturestatus_history_Revisions.
+type HelmfeaturestatusHistoryRevisions struct {
+	// CreationTimestamp A timestamp representing the server time when this version was created.
+	CreationTimestamp string `cty:"creation_timestamp" hcl:"creation_timestamp" json:"creationTimestamp" tfsdk:"creation_timestamp"`
+
+	// Generation A sequence number representing a specific generation of the desired
+	// state stored in the revision.
+	Generation int64 `cty:"generation" hcl:"generation" json:"generation" tfsdk:"generation"`
+
+	// Spec The encoded versioned resource desired state.
+	Spec string `cty:"spec" hcl:"spec" json:"spec" tfsdk:"spec"`
+}
+
+// ServicetierstatusConditions defines model for servicetierstatus_Conditions.
+type ServicetierstatusConditions struct {
+	// LastTransitionTime lastTransitionTime is the last time the condition transitioned from one status to another.
+	// This should be when the underlying condition changed.  If that is not known, then using the time when the API field changed is acceptable.
+	LastTransitionTime string `cty:"last_transition_time" hcl:"last_transition_time" json:"lastTransitionTime" tfsdk:"last_transition_time"`
+
+	// Message message is a human readable message indicating details about the transition.
+	// This may be an empty string.
+	Message string `cty:"message" hcl:"message" json:"message" tfsdk:"message"`
+
+	// ObservedGeneration observedGeneration represents the .metadata.generation that the condition was set based upon.
+	// For instance, if .metadata.generation is currently 12, but the .status.conditions[x].observedGeneration is 9, the condition is out of date
+	// with respect to the current state of the instance.
+	ObservedGeneration *int64 `cty:"observed_generation" hcl:"observed_generation" json:"observedGeneration,omitempty" tfsdk:"observed_generation"`
+
+	// Reason reason contains a programmatic identifier indicating the reason for the condition's last transition.
+	// Producers of specific condition types may define expected values and meanings for this field,
+	// and whether the values are considered a guaranteed API.
+	// The value should be a CamelCase string.
+	// This field may not be empty.
+	Reason string `cty:"reason" hcl:"reason" json:"reason" tfsdk:"reason"`
+
+	// Status status of the condition, one of True, False, Unknown.
+	Status ServicetierstatusConditionsStatus `cty:"status" hcl:"status" json:"status" tfsdk:"status"`
+
+	// Type type of condition in CamelCase or in foo.example.com/CamelCase.
+	// ---
+	// Many .condition.type values are consistent across resources like Available, but because arbitrary conditions can be
+	// useful (see .node.status.conditions), the ability to deconflict is important.
+	// The regex it matches is (dns1123SubdomainFmt/)?(qualifiedNameFmt)
+	Type string `cty:"type" hcl:"type" json:"type" tfsdk:"type"`
+}
+
+// ServicetierstatusConditionsStatus status of the condition, one of True, False, Unknown.
+type ServicetierstatusConditionsStatus string
+
+// ServicetierstatusHistory The revision history of the service tier
+type ServicetierstatusHistory struct {
+	// Revisions Resource revisions.
+	Revisions *[]ServicetierstatusHistoryRevisions `cty:"revisions" hcl:"revisions" json:"revisions,omitempty" tfsdk:"revisions"`
+}
+
+// ServicetierstatusHistoryRevisions defines model for servicetierstatus_history_Revisions.
+type ServicetierstatusHistoryRevisions struct {
+	// CreationTimestamp A timestamp representing the server time when this version was created.
+	CreationTimestamp string `cty:"creation_timestamp" hcl:"creation_timestamp" json:"creationTimestamp" tfsdk:"creation_timestamp"`
+
+	// Generation A sequence number representing a specific generation of the desired
+	// state stored in the revision.
+	Generation int64 `cty:"generation" hcl:"generation" json:"generation" tfsdk:"generation"`
+
+	// Spec The encoded versioned resource desired state.
+	Spec string `cty:"spec" hcl:"spec" json:"spec" tfsdk:"spec"`
+}
+
+// GetAllBackupPoliciesParams defines parameters for GetAllBackupPolicies.
+type GetAllBackupPoliciesParams struct {
+	// Offset The offset at which to list items
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor The cursor at which to list items, which represents the last item returned. If specified, all items returned must be lexicographically greater than the supplied value. For expanded payloads, the `$ref` value is compared to the cursor.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit The number of items to return. If payload expansion was enabled and `limit` was not specified, the default of 20 is used. Otherwise, the default is 0 to indicate that all items should be returned.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Expand Whether to expand payload fields. If `expand=true`, then all payload fields are expanded. If `expand=<field>,...` is supplied, then the value is interpreted as a comma-separated list of top-level fields to expand. If `expand.<field>=<JSONPath expression>` is supplied, then the JSONPath expression is used to resolve the user-supplied field.
+	Expand *string `form:"expand,omitempty" json:"expand,omitempty"`
+
+	// LabelFilter Comma-separated list of filters to apply based on labels, which are composed using `AND`. Acceptable filter expressions are:
+	// * `key` - Only return resources that have label with specified key
+	// * `key=value` - Only return resources that have label with specified key set to value
+	// * `!key` - Only return resources that do _not_ have label with specified key
+	// * `key!=value` - Only return resources that do _not_ have label with specified key set to value
+	LabelFilter *string `form:"labelFilter,omitempty" json:"labelFilter,omitempty"`
+
+	// FieldFilter Comma-separated list of filters to apply based on fields, which are composed using `AND`. Acceptable filter expressions are:
+	// * `fieldPath` - Only return resources that have a non-`null` value at the specified field path
+	// * `fieldPath=value` - Only return resources that have a matching value at the specified field path
+	// * `!fieldPath` - Only return resources that do have a `null` value at the specified field path
+	// * `fieldPath!=value` - Only return resources that do _not_ have a matching value at the specified field path
+	FieldFilter *string `form:"fieldFilter,omitempty" json:"fieldFilter,omitempty"`
+
+	// ListAccessible Whether to return any accessible sub-resources even if the current user does not have access privileges to list all resources at this level
+	ListAccessible *bool `form:"listAccessible,omitempty" json:"listAccessible,omitempty"`
+}
+
+// GetBackupPoliciesParams defines parameters for GetBackupPolicies.
+type GetBackupPoliciesParams struct {
+	// Offset The offset at which to list items
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor The cursor at which to list items, which represents the last item returned. If specified, all items returned must be lexicographically greater than the supplied value. For expanded payloads, the `$ref` value is compared to the cursor.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit The number of items to return. If payload expansion was enabled and `limit` was not specified, the default of 20 is used. Otherwise, the default is 0 to indicate that all items should be returned.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Expand Whether to expand payload fields. If `expand=true`, then all payload fields are expanded. If `expand=<field>,...` is supplied, then the value is interpreted as a comma-separated list of top-level fields to expand. If `expand.<field>=<JSONPath expression>` is supplied, then the JSONPath expression is used to resolve the user-supplied field.
 	Expand *string `form:"expand,omitempty" json:"expand,omitempty"`
 
 	// LabelFilter Comma-separated list of filters to apply based on labels, which are composed using `AND`. Acceptable filter expressions are:
@@ -894,6 +1824,222 @@ type DeleteBackupParams struct {
 // PatchBackupApplicationJSONPatchPlusJSONBody defines parameters for PatchBackup.
 type PatchBackupApplicationJSONPatchPlusJSONBody = []JsonPatchOperation
 
+// GetCanaryRolloutsParams defines parameters for GetCanaryRollouts.
+type GetCanaryRolloutsParams struct {
+	// Offset The offset at which to list items
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor The cursor at which to list items, which represents the last item returned. If specified, all items returned must be lexicographically greater than the supplied value. For expanded payloads, the `$ref` value is compared to the cursor.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit The number of items to return. If payload expansion was enabled and `limit` was not specified, the default of 20 is used. Otherwise, the default is 0 to indicate that all items should be returned.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Expand Whether to expand payload fields. If `expand=true`, then all payload fields are expanded. If `expand=<field>,...` is supplied, then the value is interpreted as a comma-separated list of top-level fields to expand. If `expand.<field>=<JSONPath expression>` is supplied, then the JSONPath expression is used to resolve the user-supplied field.
+	Expand *string `form:"expand,omitempty" json:"expand,omitempty"`
+}
+
+// DeleteCanaryRolloutParams defines parameters for DeleteCanaryRollout.
+type DeleteCanaryRolloutParams struct {
+	// TimeoutSeconds The number of seconds to wait for the operation to be finalized, unless 0 is specified which indicates not to wait
+	TimeoutSeconds *int32 `form:"timeoutSeconds,omitempty" json:"timeoutSeconds,omitempty"`
+}
+
+// PatchCanaryRolloutApplicationJSONPatchPlusJSONBody defines parameters for PatchCanaryRollout.
+type PatchCanaryRolloutApplicationJSONPatchPlusJSONBody = []JsonPatchOperation
+
+// PatchCanaryRolloutParams defines parameters for PatchCanaryRollout.
+type PatchCanaryRolloutParams struct {
+	// UpdateStatus Whether to update the status of the resource
+	UpdateStatus *bool `form:"updateStatus,omitempty" json:"updateStatus,omitempty"`
+}
+
+// CreateCanaryRolloutParams defines parameters for CreateCanaryRollout.
+type CreateCanaryRolloutParams struct {
+	// UpdateStatus Whether to update the status of the resource
+	UpdateStatus *bool `form:"updateStatus,omitempty" json:"updateStatus,omitempty"`
+}
+
+// GetCanaryRolloutTemplatesParams defines parameters for GetCanaryRolloutTemplates.
+type GetCanaryRolloutTemplatesParams struct {
+	// Offset The offset at which to list items
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor The cursor at which to list items, which represents the last item returned. If specified, all items returned must be lexicographically greater than the supplied value. For expanded payloads, the `$ref` value is compared to the cursor.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit The number of items to return. If payload expansion was enabled and `limit` was not specified, the default of 20 is used. Otherwise, the default is 0 to indicate that all items should be returned.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Expand Whether to expand payload fields. If `expand=true`, then all payload fields are expanded. If `expand=<field>,...` is supplied, then the value is interpreted as a comma-separated list of top-level fields to expand. If `expand.<field>=<JSONPath expression>` is supplied, then the JSONPath expression is used to resolve the user-supplied field.
+	Expand *string `form:"expand,omitempty" json:"expand,omitempty"`
+}
+
+// DeleteCanaryRolloutTemplateParams defines parameters for DeleteCanaryRolloutTemplate.
+type DeleteCanaryRolloutTemplateParams struct {
+	// TimeoutSeconds The number of seconds to wait for the operation to be finalized, unless 0 is specified which indicates not to wait
+	TimeoutSeconds *int32 `form:"timeoutSeconds,omitempty" json:"timeoutSeconds,omitempty"`
+}
+
+// PatchCanaryRolloutTemplateApplicationJSONPatchPlusJSONBody defines parameters for PatchCanaryRolloutTemplate.
+type PatchCanaryRolloutTemplateApplicationJSONPatchPlusJSONBody = []JsonPatchOperation
+
+// PatchCanaryRolloutTemplateParams defines parameters for PatchCanaryRolloutTemplate.
+type PatchCanaryRolloutTemplateParams struct {
+	// UpdateStatus Whether to update the status of the resource
+	UpdateStatus *bool `form:"updateStatus,omitempty" json:"updateStatus,omitempty"`
+}
+
+// CreateCanaryRolloutTemplateParams defines parameters for CreateCanaryRolloutTemplate.
+type CreateCanaryRolloutTemplateParams struct {
+	// UpdateStatus Whether to update the status of the resource
+	UpdateStatus *bool `form:"updateStatus,omitempty" json:"updateStatus,omitempty"`
+}
+
+// GetDatabaseQuotasParams defines parameters for GetDatabaseQuotas.
+type GetDatabaseQuotasParams struct {
+	// Offset The offset at which to list items
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor The cursor at which to list items, which represents the last item returned. If specified, all items returned must be lexicographically greater than the supplied value. For expanded payloads, the `$ref` value is compared to the cursor.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit The number of items to return. If payload expansion was enabled and `limit` was not specified, the default of 20 is used. Otherwise, the default is 0 to indicate that all items should be returned.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Expand Whether to expand payload fields. If `expand=true`, then all payload fields are expanded. If `expand=<field>,...` is supplied, then the value is interpreted as a comma-separated list of top-level fields to expand. If `expand.<field>=<JSONPath expression>` is supplied, then the JSONPath expression is used to resolve the user-supplied field.
+	Expand *string `form:"expand,omitempty" json:"expand,omitempty"`
+}
+
+// DeleteDatabaseQuotaParams defines parameters for DeleteDatabaseQuota.
+type DeleteDatabaseQuotaParams struct {
+	// TimeoutSeconds The number of seconds to wait for the operation to be finalized, unless 0 is specified which indicates not to wait
+	TimeoutSeconds *int32 `form:"timeoutSeconds,omitempty" json:"timeoutSeconds,omitempty"`
+}
+
+// PatchDatabaseQuotaApplicationJSONPatchPlusJSONBody defines parameters for PatchDatabaseQuota.
+type PatchDatabaseQuotaApplicationJSONPatchPlusJSONBody = []JsonPatchOperation
+
+// PatchDatabaseQuotaParams defines parameters for PatchDatabaseQuota.
+type PatchDatabaseQuotaParams struct {
+	// UpdateStatus Whether to update the status of the resource
+	UpdateStatus *bool `form:"updateStatus,omitempty" json:"updateStatus,omitempty"`
+}
+
+// CreateDatabaseQuotaParams defines parameters for CreateDatabaseQuota.
+type CreateDatabaseQuotaParams struct {
+	// UpdateStatus Whether to update the status of the resource
+	UpdateStatus *bool `form:"updateStatus,omitempty" json:"updateStatus,omitempty"`
+}
+
+// GetHelmFeaturesParams defines parameters for GetHelmFeatures.
+type GetHelmFeaturesParams struct {
+	// Offset The offset at which to list items
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor The cursor at which to list items, which represents the last item returned. If specified, all items returned must be lexicographically greater than the supplied value. For expanded payloads, the `$ref` value is compared to the cursor.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit The number of items to return. If payload expansion was enabled and `limit` was not specified, the default of 20 is used. Otherwise, the default is 0 to indicate that all items should be returned.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Expand Whether to expand payload fields. If `expand=true`, then all payload fields are expanded. If `expand=<field>,...` is supplied, then the value is interpreted as a comma-separated list of top-level fields to expand. If `expand.<field>=<JSONPath expression>` is supplied, then the JSONPath expression is used to resolve the user-supplied field.
+	Expand *string `form:"expand,omitempty" json:"expand,omitempty"`
+}
+
+// DeleteHelmFeatureParams defines parameters for DeleteHelmFeature.
+type DeleteHelmFeatureParams struct {
+	// TimeoutSeconds The number of seconds to wait for the operation to be finalized, unless 0 is specified which indicates not to wait
+	TimeoutSeconds *int32 `form:"timeoutSeconds,omitempty" json:"timeoutSeconds,omitempty"`
+}
+
+// PatchHelmFeatureApplicationJSONPatchPlusJSONBody defines parameters for PatchHelmFeature.
+type PatchHelmFeatureApplicationJSONPatchPlusJSONBody = []JsonPatchOperation
+
+// PatchHelmFeatureParams defines parameters for PatchHelmFeature.
+type PatchHelmFeatureParams struct {
+	// UpdateStatus Whether to update the status of the resource
+	UpdateStatus *bool `form:"updateStatus,omitempty" json:"updateStatus,omitempty"`
+}
+
+// CreateHelmFeatureParams defines parameters for CreateHelmFeature.
+type CreateHelmFeatureParams struct {
+	// UpdateStatus Whether to update the status of the resource
+	UpdateStatus *bool `form:"updateStatus,omitempty" json:"updateStatus,omitempty"`
+}
+
+// GetRoleTemplatesParams defines parameters for GetRoleTemplates.
+type GetRoleTemplatesParams struct {
+	// Offset The offset at which to list items
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor The cursor at which to list items, which represents the last item returned. If specified, all items returned must be lexicographically greater than the supplied value. For expanded payloads, the `$ref` value is compared to the cursor.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit The number of items to return. If payload expansion was enabled and `limit` was not specified, the default of 20 is used. Otherwise, the default is 0 to indicate that all items should be returned.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Expand Whether to expand payload fields. If `expand=true`, then all payload fields are expanded. If `expand=<field>,...` is supplied, then the value is interpreted as a comma-separated list of top-level fields to expand. If `expand.<field>=<JSONPath expression>` is supplied, then the JSONPath expression is used to resolve the user-supplied field.
+	Expand *string `form:"expand,omitempty" json:"expand,omitempty"`
+}
+
+// DeleteRoleTemplateParams defines parameters for DeleteRoleTemplate.
+type DeleteRoleTemplateParams struct {
+	// TimeoutSeconds The number of seconds to wait for the operation to be finalized, unless 0 is specified which indicates not to wait
+	TimeoutSeconds *int32 `form:"timeoutSeconds,omitempty" json:"timeoutSeconds,omitempty"`
+}
+
+// PatchRoleTemplateApplicationJSONPatchPlusJSONBody defines parameters for PatchRoleTemplate.
+type PatchRoleTemplateApplicationJSONPatchPlusJSONBody = []JsonPatchOperation
+
+// PatchRoleTemplateParams defines parameters for PatchRoleTemplate.
+type PatchRoleTemplateParams struct {
+	// UpdateStatus Whether to update the status of the resource
+	UpdateStatus *bool `form:"updateStatus,omitempty" json:"updateStatus,omitempty"`
+}
+
+// CreateRoleTemplateParams defines parameters for CreateRoleTemplate.
+type CreateRoleTemplateParams struct {
+	// UpdateStatus Whether to update the status of the resource
+	UpdateStatus *bool `form:"updateStatus,omitempty" json:"updateStatus,omitempty"`
+}
+
+// GetServiceTiersParams defines parameters for GetServiceTiers.
+type GetServiceTiersParams struct {
+	// Offset The offset at which to list items
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor The cursor at which to list items, which represents the last item returned. If specified, all items returned must be lexicographically greater than the supplied value. For expanded payloads, the `$ref` value is compared to the cursor.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit The number of items to return. If payload expansion was enabled and `limit` was not specified, the default of 20 is used. Otherwise, the default is 0 to indicate that all items should be returned.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Expand Whether to expand payload fields. If `expand=true`, then all payload fields are expanded. If `expand=<field>,...` is supplied, then the value is interpreted as a comma-separated list of top-level fields to expand. If `expand.<field>=<JSONPath expression>` is supplied, then the JSONPath expression is used to resolve the user-supplied field.
+	Expand *string `form:"expand,omitempty" json:"expand,omitempty"`
+}
+
+// DeleteServiceTierParams defines parameters for DeleteServiceTier.
+type DeleteServiceTierParams struct {
+	// TimeoutSeconds The number of seconds to wait for the operation to be finalized, unless 0 is specified which indicates not to wait
+	TimeoutSeconds *int32 `form:"timeoutSeconds,omitempty" json:"timeoutSeconds,omitempty"`
+}
+
+// PatchServiceTierApplicationJSONPatchPlusJSONBody defines parameters for PatchServiceTier.
+type PatchServiceTierApplicationJSONPatchPlusJSONBody = []JsonPatchOperation
+
+// PatchServiceTierParams defines parameters for PatchServiceTier.
+type PatchServiceTierParams struct {
+	// UpdateStatus Whether to update the status of the resource
+	UpdateStatus *bool `form:"updateStatus,omitempty" json:"updateStatus,omitempty"`
+}
+
+// CreateServiceTierParams defines parameters for CreateServiceTier.
+type CreateServiceTierParams struct {
+	// UpdateStatus Whether to update the status of the resource
+	UpdateStatus *bool `form:"updateStatus,omitempty" json:"updateStatus,omitempty"`
+}
+
 // GetAllDatabasesParams defines parameters for GetAllDatabases.
 type GetAllDatabasesParams struct {
 	// Offset The offset at which to list items
@@ -1078,6 +2224,91 @@ type DeleteProjectParams struct {
 // PatchProjectApplicationJSONPatchPlusJSONBody defines parameters for PatchProject.
 type PatchProjectApplicationJSONPatchPlusJSONBody = []JsonPatchOperation
 
+// GetAllUsersParams defines parameters for GetAllUsers.
+type GetAllUsersParams struct {
+	// Offset The offset at which to list items
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor The cursor at which to list items, which represents the last item returned. If specified, all items returned must be lexicographically greater than the supplied value. For expanded payloads, the `$ref` value is compared to the cursor.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit The number of items to return. If payload expansion was enabled and `limit` was not specified, the default of 20 is used. Otherwise, the default is 0 to indicate that all items should be returned.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Expand Whether to expand payload fields. If `expand=true`, then all payload fields are expanded. If `expand=<field>,...` is supplied, then the value is interpreted as a comma-separated list of top-level fields to expand. If `expand.<field>=<JSONPath expression>` is supplied, then the JSONPath expression is used to resolve the user-supplied field.
+	Expand *string `form:"expand,omitempty" json:"expand,omitempty"`
+
+	// LabelFilter Comma-separated list of filters to apply based on labels, which are composed using `AND`. Acceptable filter expressions are:
+	// * `key` - Only return resources that have label with specified key
+	// * `key=value` - Only return resources that have label with specified key set to value
+	// * `!key` - Only return resources that do _not_ have label with specified key
+	// * `key!=value` - Only return resources that do _not_ have label with specified key set to value
+	LabelFilter *string `form:"labelFilter,omitempty" json:"labelFilter,omitempty"`
+
+	// FieldFilter Comma-separated list of filters to apply based on fields, which are composed using `AND`. Acceptable filter expressions are:
+	// * `fieldPath` - Only return resources that have a non-`null` value at the specified field path
+	// * `fieldPath=value` - Only return resources that have a matching value at the specified field path
+	// * `!fieldPath` - Only return resources that do have a `null` value at the specified field path
+	// * `fieldPath!=value` - Only return resources that do _not_ have a matching value at the specified field path
+	FieldFilter *string `form:"fieldFilter,omitempty" json:"fieldFilter,omitempty"`
+
+	// ListAccessible Whether to return any accessible sub-resources even if the current user does not have access privileges to list all resources at this level
+	ListAccessible *bool `form:"listAccessible,omitempty" json:"listAccessible,omitempty"`
+}
+
+// GetUsersParams defines parameters for GetUsers.
+type GetUsersParams struct {
+	// Offset The offset at which to list items
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor The cursor at which to list items, which represents the last item returned. If specified, all items returned must be lexicographically greater than the supplied value. For expanded payloads, the `$ref` value is compared to the cursor.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit The number of items to return. If payload expansion was enabled and `limit` was not specified, the default of 20 is used. Otherwise, the default is 0 to indicate that all items should be returned.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Expand Whether to expand payload fields. If `expand=true`, then all payload fields are expanded. If `expand=<field>,...` is supplied, then the value is interpreted as a comma-separated list of top-level fields to expand. If `expand.<field>=<JSONPath expression>` is supplied, then the JSONPath expression is used to resolve the user-supplied field.
+	Expand *string `form:"expand,omitempty" json:"expand,omitempty"`
+
+	// LabelFilter Comma-separated list of filters to apply based on labels, which are composed using `AND`. Acceptable filter expressions are:
+	// * `key` - Only return resources that have label with specified key
+	// * `key=value` - Only return resources that have label with specified key set to value
+	// * `!key` - Only return resources that do _not_ have label with specified key
+	// * `key!=value` - Only return resources that do _not_ have label with specified key set to value
+	LabelFilter *string `form:"labelFilter,omitempty" json:"labelFilter,omitempty"`
+
+	// FieldFilter Comma-separated list of filters to apply based on fields, which are composed using `AND`. Acceptable filter expressions are:
+	// * `fieldPath` - Only return resources that have a non-`null` value at the specified field path
+	// * `fieldPath=value` - Only return resources that have a matching value at the specified field path
+	// * `!fieldPath` - Only return resources that do have a `null` value at the specified field path
+	// * `fieldPath!=value` - Only return resources that do _not_ have a matching value at the specified field path
+	FieldFilter *string `form:"fieldFilter,omitempty" json:"fieldFilter,omitempty"`
+
+	// ListAccessible Whether to return any accessible sub-resources even if the current user does not have access privileges to list all resources at this level
+	ListAccessible *bool `form:"listAccessible,omitempty" json:"listAccessible,omitempty"`
+}
+
+// DeleteUserParams defines parameters for DeleteUser.
+type DeleteUserParams struct {
+	// TimeoutSeconds The number of seconds to wait for the operation to be finalized, unless 0 is specified which indicates not to wait
+	TimeoutSeconds *int32 `form:"timeoutSeconds,omitempty" json:"timeoutSeconds,omitempty"`
+}
+
+// PatchUserApplicationJSONPatchPlusJSONBody defines parameters for PatchUser.
+type PatchUserApplicationJSONPatchPlusJSONBody = []JsonPatchOperation
+
+// PatchUserParams defines parameters for PatchUser.
+type PatchUserParams struct {
+	// AllowCrossOrganizationAccess Whether to allow the user to have access outside of its organization
+	AllowCrossOrganizationAccess *bool `form:"allowCrossOrganizationAccess,omitempty" json:"allowCrossOrganizationAccess,omitempty"`
+}
+
+// CreateUserParams defines parameters for CreateUser.
+type CreateUserParams struct {
+	// AllowCrossOrganizationAccess Whether to allow the user to have access outside of its organization
+	AllowCrossOrganizationAccess *bool `form:"allowCrossOrganizationAccess,omitempty" json:"allowCrossOrganizationAccess,omitempty"`
+}
+
 // PatchBackupPolicyApplicationJSONPatchPlusJSONRequestBody defines body for PatchBackupPolicy for application/json-patch+json ContentType.
 type PatchBackupPolicyApplicationJSONPatchPlusJSONRequestBody = PatchBackupPolicyApplicationJSONPatchPlusJSONBody
 
@@ -1093,6 +2324,42 @@ type PatchBackupApplicationJSONPatchPlusJSONRequestBody = PatchBackupApplication
 // CreateOrUpdateBackupJSONRequestBody defines body for CreateOrUpdateBackup for application/json ContentType.
 type CreateOrUpdateBackupJSONRequestBody = BackupModel
 
+// PatchCanaryRolloutApplicationJSONPatchPlusJSONRequestBody defines body for PatchCanaryRollout for application/json-patch+json ContentType.
+type PatchCanaryRolloutApplicationJSONPatchPlusJSONRequestBody = PatchCanaryRolloutApplicationJSONPatchPlusJSONBody
+
+// CreateCanaryRolloutJSONRequestBody defines body for CreateCanaryRollout for application/json ContentType.
+type CreateCanaryRolloutJSONRequestBody = CanaryRolloutModel
+
+// PatchCanaryRolloutTemplateApplicationJSONPatchPlusJSONRequestBody defines body for PatchCanaryRolloutTemplate for application/json-patch+json ContentType.
+type PatchCanaryRolloutTemplateApplicationJSONPatchPlusJSONRequestBody = PatchCanaryRolloutTemplateApplicationJSONPatchPlusJSONBody
+
+// CreateCanaryRolloutTemplateJSONRequestBody defines body for CreateCanaryRolloutTemplate for application/json ContentType.
+type CreateCanaryRolloutTemplateJSONRequestBody = CanaryRolloutTemplateModel
+
+// PatchDatabaseQuotaApplicationJSONPatchPlusJSONRequestBody defines body for PatchDatabaseQuota for application/json-patch+json ContentType.
+type PatchDatabaseQuotaApplicationJSONPatchPlusJSONRequestBody = PatchDatabaseQuotaApplicationJSONPatchPlusJSONBody
+
+// CreateDatabaseQuotaJSONRequestBody defines body for CreateDatabaseQuota for application/json ContentType.
+type CreateDatabaseQuotaJSONRequestBody = DatabaseQuotaModel
+
+// PatchHelmFeatureApplicationJSONPatchPlusJSONRequestBody defines body for PatchHelmFeature for application/json-patch+json ContentType.
+type PatchHelmFeatureApplicationJSONPatchPlusJSONRequestBody = PatchHelmFeatureApplicationJSONPatchPlusJSONBody
+
+// CreateHelmFeatureJSONRequestBody defines body for CreateHelmFeature for application/json ContentType.
+type CreateHelmFeatureJSONRequestBody = HelmFeatureModel
+
+// PatchRoleTemplateApplicationJSONPatchPlusJSONRequestBody defines body for PatchRoleTemplate for application/json-patch+json ContentType.
+type PatchRoleTemplateApplicationJSONPatchPlusJSONRequestBody = PatchRoleTemplateApplicationJSONPatchPlusJSONBody
+
+// CreateRoleTemplateJSONRequestBody defines body for CreateRoleTemplate for application/json ContentType.
+type CreateRoleTemplateJSONRequestBody = RoleTemplateModel
+
+// PatchServiceTierApplicationJSONPatchPlusJSONRequestBody defines body for PatchServiceTier for application/json-patch+json ContentType.
+type PatchServiceTierApplicationJSONPatchPlusJSONRequestBody = PatchServiceTierApplicationJSONPatchPlusJSONBody
+
+// CreateServiceTierJSONRequestBody defines body for CreateServiceTier for application/json ContentType.
+type CreateServiceTierJSONRequestBody = ServiceTierModel
+
 // PatchDatabaseApplicationJSONPatchPlusJSONRequestBody defines body for PatchDatabase for application/json-patch+json ContentType.
 type PatchDatabaseApplicationJSONPatchPlusJSONRequestBody = PatchDatabaseApplicationJSONPatchPlusJSONBody
 
@@ -1102,12 +2369,21 @@ type CreateDatabaseJSONRequestBody = DatabaseCreateUpdateModel
 // UpdateDbaPasswordJSONRequestBody defines body for UpdateDbaPassword for application/json ContentType.
 type UpdateDbaPasswordJSONRequestBody = UpdateDbaPasswordModel
 
+// LoginJSONRequestBody defines body for Login for application/json ContentType.
+type LoginJSONRequestBody = LoginRequestModel
+
 // PatchProjectApplicationJSONPatchPlusJSONRequestBody defines body for PatchProject for application/json-patch+json ContentType.
 type PatchProjectApplicationJSONPatchPlusJSONRequestBody = PatchProjectApplicationJSONPatchPlusJSONBody
 
 // CreateProjectJSONRequestBody defines body for CreateProject for application/json ContentType.
 type CreateProjectJSONRequestBody = ProjectModel
 
+// PatchUserApplicationJSONPatchPlusJSONRequestBody defines body for PatchUser for application/json-patch+json ContentType.
+type PatchUserApplicationJSONPatchPlusJSONRequestBody = PatchUserApplicationJSONPatchPlusJSONBody
+
+// CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
+type CreateUserJSONRequestBody = DbaasUserCreateUpdateModel
+
 // AsItemListItems0 returns the union data inside the ItemList_Items_Item as a ItemListItems0
 func (t ItemList_Items_Item) AsItemListItems0() (ItemListItems0, error) {
 	var body ItemListItems0